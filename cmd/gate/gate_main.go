@@ -3,13 +3,17 @@ package main
 import (
 	"ThreeKingdoms/internal/gate/interfaces"
 	gategrpc "ThreeKingdoms/internal/gate/interfaces/grpc"
+	"ThreeKingdoms/internal/shared/gateroute"
 	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	sharedmongo "ThreeKingdoms/internal/shared/infrastructure/mongo"
 	"ThreeKingdoms/internal/shared/logs"
+	"ThreeKingdoms/internal/shared/security"
 	"ThreeKingdoms/internal/shared/serverconfig"
 	"ThreeKingdoms/internal/shared/session"
 	"ThreeKingdoms/internal/shared/transport/grpc"
 	transporthttp "ThreeKingdoms/internal/shared/transport/http"
 	"ThreeKingdoms/internal/shared/transport/ws"
+	"ThreeKingdoms/internal/shared/transport/ws/middlewares"
 	"ThreeKingdoms/modules/kit/logx"
 	"context"
 	"errors"
@@ -30,6 +34,9 @@ func main() {
 	if err := logs.Init("gate", serverconfig.Conf.Log); err != nil {
 		panic(err)
 	}
+	if err := logs.InitAudit("gate", serverconfig.Conf.Log); err != nil {
+		panic(err)
+	}
 	logs.Info("conf", zap.Any("conf", serverconfig.Conf))
 
 	serverConfig := serverconfig.Conf.GateServer
@@ -39,9 +46,11 @@ func main() {
 	}
 	gateServerAddr := fmt.Sprintf("%s:%d", gateHost, serverConfig.Port)
 
-	sessMgr := session.NewSessMgr()
+	sessMgr := session.NewSessMgr(time.Duration(serverConfig.ResumeGrace) * time.Second)
 	baseLogger := logx.NewZapLogger(logs.Logger())
 	wsRouter := ws.NewRouter(baseLogger)
+	wsLimiter := middlewares.NewRateLimiter(serverConfig.RateLimit, baseLogger)
+	wsRouter.Use(wsLimiter.Middleware())
 
 	loginServerHost := serverconfig.Conf.LoginServer.Host
 	if loginServerHost == "" {
@@ -71,7 +80,45 @@ func main() {
 		_ = playerConn.Close()
 	}()
 
-	accountModule := interfaces.New(sessMgr, accountClient, playerClient)
+	gateGRPCHost := serverConfig.Host
+	if gateGRPCHost == "" || gateGRPCHost == "0.0.0.0" {
+		gateGRPCHost = "127.0.0.1"
+	}
+	gateGRPCPort := serverConfig.GRPCPort
+	if gateGRPCPort <= 0 {
+		gateGRPCPort = serverConfig.Port + 10000
+	}
+	gateGRPCAddr := fmt.Sprintf("%s:%d", gateGRPCHost, gateGRPCPort)
+	gateID := serverConfig.AdvertiseAddr
+	if gateID == "" {
+		gateID = gateGRPCAddr
+	}
+
+	var registry gateroute.Registry = gateroute.NewMemoryRegistry()
+	if serverConfig.Registry == gateroute.RegistryMongo {
+		mongoClient, err := sharedmongo.Open(serverconfig.Conf.MongoDB, logs.Logger())
+		if err != nil {
+			logs.Fatal("open mongodb failed", zap.Error(err))
+		}
+		defer func() {
+			_ = mongoClient.Disconnect(context.Background())
+		}()
+		mongoRegistry := gateroute.NewMongoRegistry(mongoClient.Database(serverconfig.Conf.MongoDB.Database))
+		if err := mongoRegistry.EnsureIndexes(context.Background()); err != nil {
+			logs.Fatal("ensure gate registry indexes failed", zap.Error(err))
+		}
+		registry = mongoRegistry
+	}
+	member := gateroute.NewMember(gateID, registry)
+	defer func() {
+		_ = member.Close()
+	}()
+	// 上次异常退出时没来得及删的记录，推送会一直发到这里却找不到会话
+	if err := member.Reset(context.Background()); err != nil {
+		logs.Fatal("reset gate registry failed", zap.Error(err))
+	}
+
+	accountModule := interfaces.New(sessMgr, member, accountClient, playerClient, serverConfig.Admin, wsLimiter)
 	wsModules := []ws.Registrar{
 		accountModule,
 	}
@@ -88,18 +135,22 @@ func main() {
 	}
 
 	wsServer := ws.NewServer(wsRouter, baseLogger)
+	sec := ws.Security{KeyExchange: serverConfig.KeyExchange, BindToken: serverConfig.BindToken}
+	if sec.KeyExchange {
+		sec.SignKey, err = security.ParseSignKey(serverConfig.KexSignKey)
+		if err != nil {
+			logs.Fatal("parse kex sign key failed", zap.Error(err))
+		}
+	}
+	wsServer.Security(sec)
+	wsServer.Keepalive(ws.Keepalive{
+		PingInterval: time.Duration(serverConfig.PingInterval) * time.Second,
+		PongWait:     time.Duration(serverConfig.PongWait) * time.Second,
+		IdleTimeout:  time.Duration(serverConfig.IdleTimeout) * time.Second,
+	})
 	httpServer.Engine().Any("/ws", gin.WrapH(wsServer))
 	httpServer.Engine().Any("/ws/*any", gin.WrapH(wsServer))
 
-	gateGRPCHost := serverConfig.Host
-	if gateGRPCHost == "" || gateGRPCHost == "0.0.0.0" {
-		gateGRPCHost = "127.0.0.1"
-	}
-	gateGRPCPort := serverConfig.GRPCPort
-	if gateGRPCPort <= 0 {
-		gateGRPCPort = serverConfig.Port + 10000
-	}
-	gateGRPCAddr := fmt.Sprintf("%s:%d", gateGRPCHost, gateGRPCPort)
 	grpcLis, err := net.Listen("tcp", gateGRPCAddr)
 	if err != nil {
 		logs.Fatal("listen gate grpc failed", zap.Error(err))
@@ -124,7 +175,7 @@ func main() {
 		}
 	}()
 	go func() {
-		logs.Info("gate grpc server started", zap.String("addr", gateGRPCAddr))
+		logs.Info("gate grpc server started", zap.String("addr", gateGRPCAddr), zap.String("gateId", gateID))
 		if err := grpcServer.Serve(grpcLis); err != nil {
 			errCh <- fmt.Errorf("gate grpc serve failed: %w", err)
 			return
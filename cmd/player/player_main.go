@@ -7,6 +7,8 @@ import (
 	playermongo "ThreeKingdoms/internal/player/infra/persistence/mongodb"
 	sharedactor "ThreeKingdoms/internal/shared/actor"
 	"ThreeKingdoms/internal/shared/gameconfig"
+	"ThreeKingdoms/internal/shared/gateroute"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
 	sharedmongo "ThreeKingdoms/internal/shared/infrastructure/mongo"
 	"ThreeKingdoms/internal/shared/logs"
@@ -22,6 +24,7 @@ import (
 	"syscall"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -63,13 +66,12 @@ func main() {
 	}()
 	db := mongoClient.Database(serverconfig.Conf.MongoDB.Database)
 	managerPIDRegistry := sharedactor.NewPIDRegistry()
-	pusherAddr := pusherServiceAddr(serverconfig.Conf.GateServer)
-	pusherConn, pusher, err := transportgrpc.DialGatePushService(pusherAddr)
+	pusher, closePusher, err := newGatePusher(serverconfig.Conf.GateServer, db)
 	if err != nil {
-		logs.Fatal("dial gate push service failed", zap.Error(err), zap.String("addr", pusherAddr))
+		logs.Fatal("dial gate push service failed", zap.Error(err))
 	}
 	defer func() {
-		_ = pusherConn.Close()
+		_ = closePusher()
 	}()
 
 	worldRepo := worldmongo.NewWorldRepository(db)
@@ -83,7 +85,10 @@ func main() {
 	}
 
 	allianceRepo := alliancemongo.NewAllianceRepository(db)
-	allianceRT := allianceactor.NewRuntime(worldRT.ActorSystem(), allianceRepo, worldID, 0)
+	if err := allianceRepo.EnsureIndexes(context.Background()); err != nil {
+		logs.Warn("ensure alliance indexes failed", zap.Error(err))
+	}
+	allianceRT := allianceactor.NewRuntime(worldRT.ActorSystem(), allianceRepo, managerPIDRegistry, worldID, 0)
 	defer allianceRT.Shutdown()
 	managerPIDRegistry.RegisterManagerPID(sharedactor.ManagerPIDAlliance, allianceRT.AllianceActorID())
 
@@ -140,7 +145,24 @@ func main() {
 	}
 }
 
+// newGatePusher 单个 gate 时直接连它，多个 gate 时按路由表把推送发到 uid 所在的 gate
+func newGatePusher(cfg serverconfig.GateServerConfig, db *mongo.Database) (gatepb.GatePushServiceClient, func() error, error) {
+	if cfg.Registry == gateroute.RegistryMongo {
+		router := gateroute.NewRouter(gateroute.NewMongoRegistry(db))
+		return router, router.Close, nil
+	}
+	addr := pusherServiceAddr(cfg)
+	conn, pusher, err := transportgrpc.DialGatePushService(addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s: %w", addr, err)
+	}
+	return pusher, conn.Close, nil
+}
+
 func pusherServiceAddr(cfg serverconfig.GateServerConfig) string {
+	if cfg.AdvertiseAddr != "" {
+		return cfg.AdvertiseAddr
+	}
 	host := cfg.Host
 	if host == "" || host == "0.0.0.0" {
 		host = "127.0.0.1"
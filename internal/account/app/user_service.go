@@ -89,6 +89,32 @@ func (s *UserService) Login(ctx context.Context, req *accountpb.LoginRequest) (*
 	}, nil
 }
 
+// Logout 记录登出，登出时间早于最后一次登录（已经在别处重新登录）时忽略
+func (s *UserService) Logout(ctx context.Context, req *accountpb.LogoutRequest) (*accountpb.LogoutReply, error) {
+	ll, err := s.llRepo.GetLoginLast(ctx, int(req.Uid))
+	switch {
+	case err == nil:
+	case errors.Is(err, domain.ErrLastLoginNotFound):
+		return &accountpb.LogoutReply{Result: ok()}, nil
+	default:
+		return nil, ErrUnavailable.WithReason(ReasonLoginLastReadFail).WithCause(err)
+	}
+
+	logoutTime := time.UnixMilli(req.LogoutTime)
+	if req.LogoutTime <= 0 {
+		logoutTime = time.Now()
+	}
+	if ll.LoginTime.After(logoutTime) {
+		return &accountpb.LogoutReply{Result: ok()}, nil
+	}
+	ll.LogoutTime = &logoutTime
+	ll.IsLogout = 1
+	if err = s.llRepo.Save(ctx, ll); err != nil {
+		return nil, ErrUnavailable.WithReason(ReasonLoginLastWriteFail).WithCause(err)
+	}
+	return &accountpb.LogoutReply{Result: ok()}, nil
+}
+
 func (s *UserService) Register(ctx context.Context, req *accountpb.RegisterRequest) (*accountpb.RegisterReply, error) {
 	user, err := s.userRepo.GetUserByUserName(ctx, req.Username)
 	if err != nil && errors.Is(err, domain.ErrSystemUnavailable) {
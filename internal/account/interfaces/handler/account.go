@@ -50,3 +50,14 @@ func (a *Account) Register(ctx context.Context, req *accountpb.RegisterRequest)
 	}
 	return resp, nil
 }
+
+func (a *Account) Logout(ctx context.Context, req *accountpb.LogoutRequest) (*accountpb.LogoutReply, error) {
+	ctx = tracex.WithSpanID(ctx, "account")
+
+	resp, err := a.userService.Logout(ctx, req)
+	if err != nil {
+		logx.ReportSysError(ctx, a.log, logx.NewSysLog("account logout tech error", err))
+		return nil, toRPCError(err)
+	}
+	return resp, nil
+}
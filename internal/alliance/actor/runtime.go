@@ -3,6 +3,7 @@ package actor
 import (
 	"ThreeKingdoms/internal/alliance/actors"
 	"ThreeKingdoms/internal/alliance/service/port"
+	sharedactor "ThreeKingdoms/internal/shared/actor"
 	"ThreeKingdoms/internal/shared/transport"
 	"context"
 	"errors"
@@ -47,6 +48,7 @@ type Runtime struct {
 func NewRuntime(
 	system *protoactor.ActorSystem,
 	repo port.AllianceRepository,
+	resolver sharedactor.ManagerPIDResolver,
 	worldID int,
 	askTimeout time.Duration,
 ) *Runtime {
@@ -60,7 +62,7 @@ func NewRuntime(
 	}
 	root := system.Root
 	managerProps := protoactor.PropsFromProducer(func() protoactor.Actor {
-		return actors.NewManagerActor(repo, worldID)
+		return actors.NewManagerActor(repo, resolver, worldID)
 	})
 	manager := root.Spawn(managerProps)
 
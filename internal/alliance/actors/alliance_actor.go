@@ -4,6 +4,7 @@ import (
 	"ThreeKingdoms/internal/alliance/dc"
 	"ThreeKingdoms/internal/alliance/entity"
 	"ThreeKingdoms/internal/alliance/service/port"
+	sharedactor "ThreeKingdoms/internal/shared/actor"
 	"ThreeKingdoms/internal/shared/actor/messages"
 	"context"
 	"sort"
@@ -27,6 +28,7 @@ type AllianceActor struct {
 	allianceID            *AllianceID
 	worldID               entity.WorldID
 	managerPID            *actor.PID
+	resolver              sharedactor.ManagerPIDResolver
 	dc                    *dc.AllianceDC
 	entity                *entity.AllianceEntity
 	dispatcher            *Dispatcher
@@ -34,6 +36,9 @@ type AllianceActor struct {
 	pendingSummaryVersion uint64
 	pendingSummary        messages.Alliance
 	hasPendingSummary     bool
+	// logMark 已经推送过的最新一条动态
+	logMark    entity.AllianceLogState
+	hasLogMark bool
 }
 
 type flushTick struct{}
@@ -44,12 +49,13 @@ type persistedSummaryReady struct {
 	Version uint64
 }
 
-func NewAllianceActor(allianceID AllianceID, worldID entity.WorldID, managerPID *actor.PID, repo port.AllianceRepository) *AllianceActor {
+func NewAllianceActor(allianceID AllianceID, worldID entity.WorldID, managerPID *actor.PID, repo port.AllianceRepository, resolver sharedactor.ManagerPIDResolver) *AllianceActor {
 	return &AllianceActor{
 		state:      None,
 		allianceID: &allianceID,
 		worldID:    worldID,
 		managerPID: managerPID,
+		resolver:   resolver,
 		dc:         dc.NewAllianceDC(repo),
 		dispatcher: NewDispatcher(),
 	}
@@ -84,6 +90,7 @@ func (a *AllianceActor) Receive(ctx actor.Context) {
 		if a.state != Online {
 			return
 		}
+		AS.RefreshStats(a.entity)
 		summary := a.summaryFromEntity()
 		version, err := a.dc.Tick()
 		if err != nil {
@@ -127,6 +134,7 @@ func (a *AllianceActor) Receive(ctx actor.Context) {
 			ctx.Respond("alliance not online")
 			return
 		}
+		a.refreshActive(ctx, msg.PlayerID())
 		a.dispatcher.Dispatch(ctx, a, msg)
 	default:
 		return
@@ -167,6 +175,7 @@ func (a *AllianceActor) init(actorCtx actor.Context) {
 
 	a.state = Online
 	a.entity = e
+	a.logMark, a.hasLogMark = a.lastLog()
 	a.startFlushLoop(actorCtx)
 	a.publishBootstrapSummary(actorCtx)
 }
@@ -183,6 +192,71 @@ func (a *AllianceActor) DC() *dc.AllianceDC {
 	return a.dc
 }
 
+func (a *AllianceActor) PlayerPID() *actor.PID {
+	if a == nil || a.resolver == nil {
+		return nil
+	}
+	pid, ok := a.resolver.ResolveManagerPID(sharedactor.ManagerPIDPlayer)
+	if !ok {
+		return nil
+	}
+	return pid
+}
+
+func (a *AllianceActor) WorldPID() *actor.PID {
+	if a == nil || a.resolver == nil {
+		return nil
+	}
+	pid, ok := a.resolver.ResolveManagerPID(sharedactor.ManagerPIDWorld)
+	if !ok {
+		return nil
+	}
+	return pid
+}
+
+// refreshActive 刷新发起人的活跃时间，并检查盟主是否需要自动让位
+func (a *AllianceActor) refreshActive(ctx actor.Context, playerID int) {
+	if a.entity == nil || a.entity.LenMembers() == 0 {
+		return
+	}
+	now := time.Now()
+	AS.Touch(a.entity, playerID, now)
+	if AS.AutoAbdicate(a.entity, now) {
+		ctx.Logger().Info("alliance chairman abdicated", "alliance_id", a.allianceID)
+		a.commit(ctx)
+	}
+}
+
+// commit 写操作后同步落库，并立即刷新 manager 的联盟列表
+func (a *AllianceActor) commit(ctx actor.Context) {
+	a.pushNewLogs(ctx)
+	AS.RefreshStats(a.entity)
+	if err := a.dc.FlushSync(context.TODO()); err != nil {
+		ctx.Logger().Error("alliance flush failed", "alliance_id", a.allianceID, "err", err)
+		return
+	}
+	if a.managerPID == nil {
+		return
+	}
+	a.hasPendingSummary = false
+	ctx.Send(a.managerPID, &messages.AllianceSummaryUpsert{
+		WorldId: int(a.worldID),
+		Version: a.dc.Version(),
+		Summary: a.summaryFromEntity(),
+	})
+}
+
+// release 联盟不存在时通知 manager 移除摘要并停止本 actor
+func (a *AllianceActor) release(ctx actor.Context) {
+	if a.managerPID == nil {
+		return
+	}
+	ctx.Send(a.managerPID, &messages.AllianceDismissed{
+		WorldId:    int(a.worldID),
+		AllianceId: int(*a.allianceID),
+	})
+}
+
 func (a *AllianceActor) startFlushLoop(ctx actor.Context) {
 	if a.flushStop != nil {
 		return
@@ -218,7 +292,8 @@ func (a *AllianceActor) stopFlushLoop() {
 }
 
 func (a *AllianceActor) publishBootstrapSummary(ctx actor.Context) {
-	if a == nil || a.managerPID == nil {
+	// 尚未创建或已解散的联盟不进入列表
+	if a == nil || a.managerPID == nil || a.entity == nil || a.entity.LenMembers() == 0 {
 		return
 	}
 	ctx.Send(a.managerPID, &messages.AllianceSummaryUpsert{
@@ -249,12 +324,20 @@ func (a *AllianceActor) summaryFromEntity() messages.Alliance {
 			Title: toAllianceSummaryTitle(major.Title),
 		})
 	}
+	buildings := make(map[int]entity.SysBuildingState, a.entity.LenBuildings())
+	a.entity.ForEachBuildings(func(k int, v entity.SysBuildingState) {
+		buildings[k] = v
+	})
 	return messages.Alliance{
-		Id:     int32(a.entity.Id()),
-		Name:   a.entity.Name(),
-		Cnt:    int32(a.entity.LenMembers()),
-		Notice: a.entity.Notice(),
-		Major:  majors,
+		Id:        int32(a.entity.Id()),
+		Name:      a.entity.Name(),
+		Cnt:       int32(a.entity.LenMembers()),
+		Notice:    a.entity.Notice(),
+		Major:     majors,
+		Buildings: buildingsOf(buildings),
+		Limit:     int32(a.entity.MemberLimit()),
+		Power:     int32(a.entity.Power()),
+		Territory: int32(a.entity.Territory()),
 	}
 }
 
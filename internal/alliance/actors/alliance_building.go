@@ -0,0 +1,81 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"sort"
+	"time"
+)
+
+// allianceBonus 占领系统建筑带来的加成合计
+type allianceBonus struct {
+	Yield  int
+	Speed  int
+	Member int
+}
+
+// SetBuilding 记录系统建筑的得失
+func (s *AllianceService) SetBuilding(e *entity.AllianceEntity, b messages.SysBuilding, add bool) bool {
+	pos := _map.ToPosition(b.Pos.X, b.Pos.Y)
+	if !add {
+		return e.DelBuildings(pos)
+	}
+	return e.PutBuildings(pos, entity.SysBuildingState{
+		Pos:        pos,
+		X:          b.Pos.X,
+		Y:          b.Pos.Y,
+		Kind:       b.Kind,
+		Level:      b.Level,
+		OccupyTime: time.Now(),
+	})
+}
+
+// Bonus 按占领的系统建筑累加配置的加成
+func (s *AllianceService) Bonus(e *entity.AllianceEntity) allianceBonus {
+	out := allianceBonus{}
+	if e == nil {
+		return out
+	}
+	e.ForEachBuildings(func(k int, v entity.SysBuildingState) {
+		b := basic.BasicConf.SysBuild.BonusOf(v.Kind)
+		out.Yield += b.Yield
+		out.Speed += b.Speed
+		out.Member += b.Member
+	})
+	return out
+}
+
+// buildingsOf 按格子下标排序，保证列表稳定
+func buildingsOf(buildings map[int]entity.SysBuildingState) []messages.SysBuilding {
+	keys := make([]int, 0, len(buildings))
+	for k := range buildings {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	out := make([]messages.SysBuilding, 0, len(keys))
+	for _, k := range keys {
+		v := buildings[k]
+		out = append(out, messages.SysBuilding{
+			Pos:   messages.Pos{X: v.X, Y: v.Y},
+			Kind:  v.Kind,
+			Level: v.Level,
+		})
+	}
+	return out
+}
+
+func toPBSysBuildings(in []messages.SysBuilding) []*playerpb.SysBuilding {
+	out := make([]*playerpb.SysBuilding, 0, len(in))
+	for _, v := range in {
+		out = append(out, &playerpb.SysBuilding{
+			X:     int32(v.Pos.X),
+			Y:     int32(v.Pos.Y),
+			Kind:  int32(v.Kind),
+			Level: int32(v.Level),
+		})
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// diplomacyState 当前生效的外交状态，到期视为中立
+func diplomacyState(v entity.RelationState, now time.Time) messages.DiplomacyState {
+	if !v.Until.After(now) {
+		return messages.DIPLOMACY_NEUTRAL
+	}
+	return messages.DiplomacyState(v.State)
+}
+
+// canChangeDiplomacy 宣战和互不侵犯只能在中立时提出，停战只能在宣战期间提出
+func canChangeDiplomacy(cur, next messages.DiplomacyState) bool {
+	switch next {
+	case messages.DIPLOMACY_WAR, messages.DIPLOMACY_NAP:
+		return cur == messages.DIPLOMACY_NEUTRAL
+	case messages.DIPLOMACY_TRUCE:
+		return cur == messages.DIPLOMACY_WAR
+	default:
+		return false
+	}
+}
+
+func diplomacyDuration(state messages.DiplomacyState) time.Duration {
+	conf := basic.BasicConf.Diplomacy
+	seconds := 0
+	switch state {
+	case messages.DIPLOMACY_WAR:
+		seconds = conf.WarDuration
+	case messages.DIPLOMACY_TRUCE:
+		seconds = conf.TruceDuration
+	case messages.DIPLOMACY_NAP:
+		seconds = conf.NapDuration
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// ProposeDiplomacy 宣战立即生效；停战和互不侵犯记为待对方确认的提议，
+// 对方已提出相同的提议时直接生效。返回需要同步给对方联盟的消息
+func (s *AllianceService) ProposeDiplomacy(e *entity.AllianceEntity, req *messages.HAProposeDiplomacy, now time.Time) (*messages.AADiplomacy, error) {
+	if req.TargetId <= 0 || req.TargetId == int(e.Id()) {
+		return nil, fmt.Errorf("diplomacy target invalid")
+	}
+	if req.TargetName == "" {
+		return nil, errAllianceNotFound
+	}
+	rel, ok := e.GetRelations(entity.AllianceID(req.TargetId))
+	if !ok {
+		rel = entity.RelationState{AllianceId: entity.AllianceID(req.TargetId)}
+	}
+	rel.Name = req.TargetName
+	if !canChangeDiplomacy(diplomacyState(rel, now), req.State) {
+		return nil, fmt.Errorf("diplomacy state invalid")
+	}
+
+	out := &messages.AADiplomacy{FromId: int(e.Id()), FromName: e.Name(), State: req.State}
+	if req.State == messages.DIPLOMACY_WAR || (rel.Incoming && messages.DiplomacyState(rel.Proposal) == req.State) {
+		out.Until = now.Add(diplomacyDuration(req.State))
+		s.applyDiplomacy(e, rel, req.State, out.Until, req.PlayerId)
+		return out, nil
+	}
+	rel.Proposal = int8(req.State)
+	rel.Incoming = false
+	e.PutRelations(rel.AllianceId, rel)
+	out.Propose = true
+	return out, nil
+}
+
+// AcceptDiplomacy 同意对方的提议，提议失效（如停战前宣战已到期）时拒绝
+func (s *AllianceService) AcceptDiplomacy(e *entity.AllianceEntity, req *messages.HAAcceptDiplomacy, now time.Time) (*messages.AADiplomacy, error) {
+	rel, ok := e.GetRelations(entity.AllianceID(req.TargetId))
+	if !ok || !rel.Incoming || rel.Proposal == 0 {
+		return nil, fmt.Errorf("proposal not found")
+	}
+	state := messages.DiplomacyState(rel.Proposal)
+	if !canChangeDiplomacy(diplomacyState(rel, now), state) {
+		return nil, fmt.Errorf("diplomacy state invalid")
+	}
+	until := now.Add(diplomacyDuration(state))
+	s.applyDiplomacy(e, rel, state, until, req.PlayerId)
+	return &messages.AADiplomacy{FromId: int(e.Id()), FromName: e.Name(), State: state, Until: until}, nil
+}
+
+// ReceiveDiplomacy 记录对方联盟同步过来的提议或变更
+func (s *AllianceService) ReceiveDiplomacy(e *entity.AllianceEntity, req *messages.AADiplomacy, now time.Time) bool {
+	rel, ok := e.GetRelations(entity.AllianceID(req.FromId))
+	if !ok {
+		rel = entity.RelationState{AllianceId: entity.AllianceID(req.FromId)}
+	}
+	rel.Name = req.FromName
+	if !req.Propose {
+		s.applyDiplomacy(e, rel, req.State, req.Until, 0)
+		return true
+	}
+	if !canChangeDiplomacy(diplomacyState(rel, now), req.State) {
+		return false
+	}
+	rel.Proposal = int8(req.State)
+	rel.Incoming = true
+	return e.PutRelations(rel.AllianceId, rel)
+}
+
+// applyDiplomacy 修改外交状态并清掉提议，记录到联盟动态
+func (s *AllianceService) applyDiplomacy(e *entity.AllianceEntity, rel entity.RelationState, state messages.DiplomacyState, until time.Time, opID int) {
+	rel.State = int8(state)
+	rel.Until = until
+	rel.Proposal = 0
+	rel.Incoming = false
+	e.PutRelations(rel.AllianceId, rel)
+
+	kind := messages.ALLIANCE_LOG_WAR
+	switch state {
+	case messages.DIPLOMACY_TRUCE:
+		kind = messages.ALLIANCE_LOG_TRUCE
+	case messages.DIPLOMACY_NAP:
+		kind = messages.ALLIANCE_LOG_NAP
+	}
+	s.appendLog(e, entity.AllianceLogState{
+		Kind:       int8(kind),
+		OpId:       opID,
+		OpName:     s.memberName(e, opID),
+		TargetId:   int(rel.AllianceId),
+		TargetName: rel.Name,
+		Ctime:      time.Now(),
+	})
+}
+
+// Diplomacies 有生效状态或待确认提议的外交关系，按联盟 id 排序
+func (s *AllianceService) Diplomacies(e *entity.AllianceEntity, now time.Time) []messages.Diplomacy {
+	out := make([]messages.Diplomacy, 0, e.LenRelations())
+	e.ForEachRelations(func(k entity.AllianceID, v entity.RelationState) {
+		state := diplomacyState(v, now)
+		if state == messages.DIPLOMACY_NEUTRAL && v.Proposal == 0 {
+			return
+		}
+		until := int64(0)
+		if state != messages.DIPLOMACY_NEUTRAL {
+			until = v.Until.UnixMilli()
+		}
+		out = append(out, messages.Diplomacy{
+			AllianceId: int(k),
+			Name:       v.Name,
+			State:      state,
+			Until:      until,
+			Proposal:   messages.DiplomacyState(v.Proposal),
+			Incoming:   v.Incoming,
+		})
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].AllianceId < out[j].AllianceId })
+	return out
+}
+
+// sendDiplomacy 经 manager 通知对方联盟，生效的变更同时同步给 world
+func (a *AllianceActor) sendDiplomacy(ctx actor.Context, targetID int, msg *messages.AADiplomacy) {
+	msg.AllianceBaseMessage = messages.AllianceBaseMessage{WorldId: int(a.worldID), AllianceId: targetID}
+	if a.managerPID != nil {
+		ctx.Send(a.managerPID, msg)
+	}
+	if msg.Propose {
+		return
+	}
+	if worldPID := a.WorldPID(); worldPID != nil {
+		ctx.Send(worldPID, &messages.AWDiplomacy{
+			WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(a.worldID)},
+			AllianceId:       msg.FromId,
+			TargetId:         targetID,
+			State:            msg.State,
+			Until:            msg.Until,
+		})
+	}
+}
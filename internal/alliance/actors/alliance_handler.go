@@ -1,13 +1,23 @@
 package actors
 
 import (
+	"ThreeKingdoms/internal/alliance/entity"
 	"ThreeKingdoms/internal/shared/actor/messages"
+	"context"
+	"errors"
+	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
 
 type AllianceHandler struct{}
 
+var (
+	errAllianceNotFound  = errors.New("alliance not found")
+	errPlayerUnreachable = errors.New("player unreachable")
+	errPlayerInAlliance  = errors.New("player already in alliance")
+)
+
 func (h AllianceHandler) HandleHAAllianceInfo(ctx actor.Context, a *AllianceActor, req *messages.HAAllianceInfo) {
 	resp := &messages.AHAllianceInfo{}
 	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
@@ -42,6 +52,405 @@ func (h AllianceHandler) HandleHAAllianceApplyList(ctx actor.Context, a *Allianc
 	ctx.Respond(resp)
 }
 
+func (h AllianceHandler) HandleHACreateAlliance(ctx actor.Context, a *AllianceActor, req *messages.HACreateAlliance) {
+	resp := &messages.AHCreateAlliance{}
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		resp.Reason = "alliance not found"
+		ctx.Respond(resp)
+		return
+	}
+	if !req.Deadline.IsZero() && time.Now().After(req.Deadline) {
+		resp.Reason = "alliance create timeout"
+		ctx.Respond(resp)
+		return
+	}
+	if err := AS.Create(a, req); err != nil {
+		resp.Reason = err.Error()
+		ctx.Respond(resp)
+		return
+	}
+	a.commit(ctx)
+	resp.OK = true
+	resp.Alliance = a.summaryFromEntity()
+	ctx.Respond(resp)
+}
+
+func (h AllianceHandler) HandleHAJoinAlliance(ctx actor.Context, a *AllianceActor, req *messages.HAJoinAlliance) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	err := AS.Apply(a, req)
+	if err == nil {
+		a.commit(ctx)
+	}
+	h.respond(ctx, err)
+}
+
+// HandleHAVerifyApply 审核申请，通过时先确认玩家仍未加入其他联盟
+func (h AllianceHandler) HandleHAVerifyApply(ctx actor.Context, a *AllianceActor, req *messages.HAVerifyApply) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	item, err := AS.CheckVerify(a, req)
+	if err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	if req.Decide == messages.ALLIANCE_REFUSE {
+		AS.RemoveApply(a.Entity(), item.PlayerId)
+		a.commit(ctx)
+		h.respond(ctx, nil)
+		return
+	}
+	if err := AS.CheckMemberLimit(a.Entity()); err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	playerPID := a.PlayerPID()
+	if playerPID == nil {
+		h.respond(ctx, errPlayerUnreachable)
+		return
+	}
+
+	f := ctx.RequestFuture(playerPID, &messages.AHAllianceChanged{
+		PlayerBaseMessage: messages.PlayerBaseMessage{WorldId: int(a.worldID), PlayerId: item.PlayerId},
+		AllianceId:        int(*a.AllianceID()),
+		AllianceName:      a.Entity().Name(),
+		Join:              true,
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		changed, isChanged := res.(*messages.PHAllianceChanged)
+		if err != nil {
+			// 超时时玩家可能已经记下本联盟，通知回滚
+			h.notifyLeave(ctx, a, item.PlayerId)
+			h.respond(ctx, errPlayerUnreachable)
+			return
+		}
+		if !isChanged || changed == nil || !changed.OK {
+			// 玩家已加入其他联盟，申请作废
+			AS.RemoveApply(a.Entity(), item.PlayerId)
+			a.commit(ctx)
+			h.respond(ctx, errPlayerInAlliance)
+			return
+		}
+		if _, joined := a.Entity().GetMembers(entity.PlayerID(item.PlayerId)); joined {
+			// 同一申请被并发通过，前一次已经加入
+			h.respond(ctx, nil)
+			return
+		}
+		// 等待玩家应答期间可能有其他申请先通过，重新检查人数，满员时让玩家回滚
+		if err := AS.CheckMemberLimit(a.Entity()); err != nil {
+			h.notifyLeave(ctx, a, item.PlayerId)
+			h.respond(ctx, err)
+			return
+		}
+		AS.Adopt(a, req.PlayerId, item, changed.Pos)
+		a.commit(ctx)
+		h.respond(ctx, nil)
+	})
+}
+
+func (h AllianceHandler) HandleHALeaveAlliance(ctx actor.Context, a *AllianceActor, req *messages.HALeaveAlliance) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	err := AS.Leave(a, req)
+	if err == nil {
+		a.commit(ctx)
+	}
+	h.respond(ctx, err)
+}
+
+func (h AllianceHandler) HandleHAKickMember(ctx actor.Context, a *AllianceActor, req *messages.HAKickMember) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	if err := AS.Kick(a, req); err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	h.notifyLeave(ctx, a, req.TargetId)
+	h.respond(ctx, nil)
+}
+
+// HandleHADismissAlliance 解散后删除联盟文档，通知成员、清理 world 里的外交和附庸，再让 manager 回收 actor
+func (h AllianceHandler) HandleHADismissAlliance(ctx actor.Context, a *AllianceActor, req *messages.HADismissAlliance) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	members, err := AS.Dismiss(a, req)
+	if err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	if err := a.dc.FlushSync(context.TODO()); err != nil {
+		ctx.Logger().Error("alliance flush failed", "alliance_id", a.allianceID, "err", err)
+	}
+	if err := a.dc.Delete(context.TODO()); err != nil {
+		ctx.Logger().Error("alliance delete failed", "alliance_id", a.allianceID, "err", err)
+	}
+	for _, playerID := range members {
+		if playerID != req.PlayerId {
+			h.notifyLeave(ctx, a, playerID)
+		}
+	}
+	if worldPID := a.WorldPID(); worldPID != nil {
+		ctx.Send(worldPID, &messages.AWAllianceDismissed{
+			WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(a.worldID)},
+			AllianceId:       int(*a.AllianceID()),
+		})
+	}
+	a.release(ctx)
+	h.respond(ctx, nil)
+}
+
+func (h AllianceHandler) HandleHAAppointTitle(ctx actor.Context, a *AllianceActor, req *messages.HAAppointTitle) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	err := AS.Appoint(a, req)
+	if err == nil {
+		a.commit(ctx)
+	}
+	h.respond(ctx, err)
+}
+
+func (h AllianceHandler) HandleHATransferChairman(ctx actor.Context, a *AllianceActor, req *messages.HATransferChairman) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	err := AS.Transfer(a, req)
+	if err == nil {
+		a.commit(ctx)
+	}
+	h.respond(ctx, err)
+}
+
+// HandleHAMemberActive 活跃时间已在 AllianceActor 收到消息时刷新，这里只记录战力，该消息无需应答
+func (h AllianceHandler) HandleHAMemberActive(ctx actor.Context, a *AllianceActor, req *messages.HAMemberActive) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		return
+	}
+	AS.SetPower(a.Entity(), req.PlayerId, req.Power)
+	// 上线时补发地图标记
+	if _, ok := AS.title(a.Entity(), req.PlayerId); ok {
+		a.pushMarks(ctx, req.PlayerId)
+	}
+}
+
+func (h AllianceHandler) HandleHAEditNotice(ctx actor.Context, a *AllianceActor, req *messages.HAEditNotice) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	if err := AS.EditNotice(a, req); err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	a.pushAlliance(ctx)
+	h.respond(ctx, nil)
+}
+
+// HandleHAAllianceLog 成员查看联盟动态
+func (h AllianceHandler) HandleHAAllianceLog(ctx actor.Context, a *AllianceActor, req *messages.HAAllianceLog) {
+	resp := &messages.AHAllianceLog{Logs: make([]messages.AllianceLog, 0)}
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		ctx.Respond(resp)
+		return
+	}
+	if _, ok := AS.title(a.Entity(), req.PlayerId); !ok {
+		ctx.Respond(resp)
+		return
+	}
+	resp.OK = true
+	resp.Logs, resp.Total = AS.Logs(a.Entity(), req.Page, req.Size)
+	ctx.Respond(resp)
+}
+
+// HandleWAAllianceLog world 单向上报，随定时 flush 落库
+func (h AllianceHandler) HandleWAAllianceLog(ctx actor.Context, a *AllianceActor, req *messages.WAAllianceLog) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) || a.Entity().LenMembers() == 0 {
+		return
+	}
+	AS.RecordWorldLog(a.Entity(), req)
+	a.pushNewLogs(ctx)
+}
+
+// HandleHATribute 附庸上供，金币记入联盟资金；联盟没有资源库存，木铁石粮上供后只从附庸扣除
+func (h AllianceHandler) HandleHATribute(ctx actor.Context, a *AllianceActor, req *messages.HATribute) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) || a.Entity().LenMembers() == 0 {
+		return
+	}
+	AS.AddTreasury(a.Entity(), req.Gold)
+}
+
+// HandleHASysBuilding manager 通知系统建筑得失，记录后推送给成员
+func (h AllianceHandler) HandleHASysBuilding(ctx actor.Context, a *AllianceActor, req *messages.HASysBuilding) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) || a.Entity().LenMembers() == 0 {
+		return
+	}
+	if !AS.SetBuilding(a.Entity(), req.Building, req.Add) {
+		return
+	}
+	a.commit(ctx)
+	a.pushAlliance(ctx)
+}
+
+// HandleHAAllianceBonus 返回系统建筑加成，非成员没有加成
+func (h AllianceHandler) HandleHAAllianceBonus(ctx actor.Context, a *AllianceActor, req *messages.HAAllianceBonus) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		ctx.Respond(&messages.AHAllianceBonus{})
+		return
+	}
+	if _, ok := AS.title(a.Entity(), req.PlayerId); !ok {
+		ctx.Respond(&messages.AHAllianceBonus{})
+		return
+	}
+	bonus := AS.Bonus(a.Entity())
+	ctx.Respond(&messages.AHAllianceBonus{OK: true, Yield: bonus.Yield, Speed: bonus.Speed, Member: bonus.Member})
+}
+
+// HandleHAAddMark 添加地图标记并推送给全体成员
+func (h AllianceHandler) HandleHAAddMark(ctx actor.Context, a *AllianceActor, req *messages.HAAddMark) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	if err := AS.AddMark(a.Entity(), req, time.Now()); err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	a.pushMarks(ctx)
+	h.respond(ctx, nil)
+}
+
+func (h AllianceHandler) HandleHADelMark(ctx actor.Context, a *AllianceActor, req *messages.HADelMark) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	if err := AS.DelMark(a.Entity(), req.Id); err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	a.pushMarks(ctx)
+	h.respond(ctx, nil)
+}
+
+// HandleHAMarkList 成员查看地图标记
+func (h AllianceHandler) HandleHAMarkList(ctx actor.Context, a *AllianceActor, req *messages.HAMarkList) {
+	resp := &messages.AHMarkList{Marks: make([]messages.AllianceMark, 0)}
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		ctx.Respond(resp)
+		return
+	}
+	if _, ok := AS.title(a.Entity(), req.PlayerId); !ok {
+		ctx.Respond(resp)
+		return
+	}
+	resp.OK = true
+	resp.Marks = AS.Marks(a.Entity(), time.Now())
+	ctx.Respond(resp)
+}
+
+// HandleHAProposeDiplomacy 盟主提出外交变更，对方联盟和 world 异步同步
+func (h AllianceHandler) HandleHAProposeDiplomacy(ctx actor.Context, a *AllianceActor, req *messages.HAProposeDiplomacy) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	msg, err := AS.ProposeDiplomacy(a.Entity(), req, time.Now())
+	if err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	a.sendDiplomacy(ctx, req.TargetId, msg)
+	h.respond(ctx, nil)
+}
+
+func (h AllianceHandler) HandleHAAcceptDiplomacy(ctx actor.Context, a *AllianceActor, req *messages.HAAcceptDiplomacy) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		h.respond(ctx, errAllianceNotFound)
+		return
+	}
+	msg, err := AS.AcceptDiplomacy(a.Entity(), req, time.Now())
+	if err != nil {
+		h.respond(ctx, err)
+		return
+	}
+	a.commit(ctx)
+	a.sendDiplomacy(ctx, req.TargetId, msg)
+	h.respond(ctx, nil)
+}
+
+// HandleAADiplomacy 对方联盟同步过来的外交提议或变更
+func (h AllianceHandler) HandleAADiplomacy(ctx actor.Context, a *AllianceActor, req *messages.AADiplomacy) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) || a.Entity().LenMembers() == 0 {
+		return
+	}
+	if !AS.ReceiveDiplomacy(a.Entity(), req, time.Now()) {
+		return
+	}
+	a.commit(ctx)
+}
+
+// HandleHADiplomacyList 成员查看外交关系
+func (h AllianceHandler) HandleHADiplomacyList(ctx actor.Context, a *AllianceActor, req *messages.HADiplomacyList) {
+	resp := &messages.AHDiplomacyList{Relations: make([]messages.Diplomacy, 0)}
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		ctx.Respond(resp)
+		return
+	}
+	if _, ok := AS.title(a.Entity(), req.PlayerId); !ok {
+		ctx.Respond(resp)
+		return
+	}
+	resp.OK = true
+	resp.Relations = AS.Diplomacies(a.Entity(), time.Now())
+	ctx.Respond(resp)
+}
+
+// HandleHARallyCheck 权限已在分发时校验，这里只确认联盟
+func (h AllianceHandler) HandleHARallyCheck(ctx actor.Context, a *AllianceActor, req *messages.HARallyCheck) {
+	if req == nil || !h.preCheck(a, req.WorldID(), req.AllianceID()) {
+		ctx.Respond(&messages.AHResult{Reason: "alliance not match"})
+		return
+	}
+	ctx.Respond(&messages.AHResult{OK: true})
+}
+
+// notifyLeave 通知玩家已离开本联盟
+func (h AllianceHandler) notifyLeave(ctx actor.Context, a *AllianceActor, playerID int) {
+	playerPID := a.PlayerPID()
+	if playerPID == nil {
+		return
+	}
+	ctx.Send(playerPID, &messages.AHAllianceChanged{
+		PlayerBaseMessage: messages.PlayerBaseMessage{WorldId: int(a.worldID), PlayerId: playerID},
+		AllianceId:        int(*a.AllianceID()),
+	})
+}
+
+func (h AllianceHandler) respond(ctx actor.Context, err error) {
+	if err != nil {
+		ctx.Respond(&messages.AHResult{Reason: err.Error()})
+		return
+	}
+	ctx.Respond(&messages.AHResult{OK: true})
+}
+
 func (h AllianceHandler) preCheck(a *AllianceActor, worldID, allianceID int) bool {
 	if a == nil || a.Entity() == nil || a.AllianceID() == nil {
 		return false
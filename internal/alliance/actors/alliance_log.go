@@ -0,0 +1,221 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+const defaultLogPageSize = 20
+
+// EditNotice 修改公告，校验长度和屏蔽词
+func (s *AllianceService) EditNotice(a *AllianceActor, req *messages.HAEditNotice) error {
+	text := strings.TrimSpace(req.Text)
+	if utf8.RuneCountInString(text) > basic.BasicConf.Union.NoticeLen {
+		return fmt.Errorf("notice too long")
+	}
+	if words.HasBanned(text) {
+		return fmt.Errorf("notice contains banned words")
+	}
+	e := a.Entity()
+	if !e.SetNotice(text) {
+		return nil
+	}
+	s.addLog(e, messages.ALLIANCE_LOG_NOTICE, req.PlayerId, 0)
+	return nil
+}
+
+// Logs 按时间倒序分页返回联盟动态
+func (s *AllianceService) Logs(e *entity.AllianceEntity, page, size int) ([]messages.AllianceLog, int) {
+	total := e.LenLogs()
+	if size <= 0 {
+		size = defaultLogPageSize
+	}
+	page = max(page, 1)
+	start := total - (page-1)*size - 1
+	end := max(start-size, -1)
+	out := make([]messages.AllianceLog, 0, max(start-end, 0))
+	for i := start; i > end; i-- {
+		v, ok := e.AtLogs(i)
+		if !ok {
+			continue
+		}
+		out = append(out, messages.AllianceLog{
+			Kind:       messages.AllianceLogKind(v.Kind),
+			OpId:       v.OpId,
+			OpName:     v.OpName,
+			TargetId:   v.TargetId,
+			TargetName: v.TargetName,
+			Title:      messages.AllianceTitle(v.Title),
+			Pos:        messages.Pos{X: v.X, Y: v.Y},
+			Ctime:      v.Ctime.UnixMilli(),
+		})
+	}
+	return out, total
+}
+
+// RecordWorldLog 记录 world 上报的领地事件
+func (s *AllianceService) RecordWorldLog(e *entity.AllianceEntity, req *messages.WAAllianceLog) {
+	s.appendLog(e, entity.AllianceLogState{
+		Kind:       int8(req.Kind),
+		TargetId:   req.TargetId,
+		TargetName: req.TargetName,
+		X:          req.Pos.X,
+		Y:          req.Pos.Y,
+		Ctime:      time.Now(),
+	})
+}
+
+// addLog 记录成员相关的动态，名字取自当前成员表，需在移除成员前调用
+func (s *AllianceService) addLog(e *entity.AllianceEntity, kind messages.AllianceLogKind, opID, targetID int) {
+	s.addTitleLog(e, kind, opID, targetID, messages.ALLIANCE_COMMON)
+}
+
+func (s *AllianceService) addTitleLog(e *entity.AllianceEntity, kind messages.AllianceLogKind, opID, targetID int, title messages.AllianceTitle) {
+	s.appendLog(e, entity.AllianceLogState{
+		Kind:       int8(kind),
+		OpId:       opID,
+		OpName:     s.memberName(e, opID),
+		TargetId:   targetID,
+		TargetName: s.memberName(e, targetID),
+		Title:      int8(title),
+		Ctime:      time.Now(),
+	})
+}
+
+// appendLog 超出上限时丢弃最旧的动态
+func (s *AllianceService) appendLog(e *entity.AllianceEntity, log entity.AllianceLogState) {
+	e.AppendLogs(log)
+	limit := basic.BasicConf.Union.LogLimit
+	for limit > 0 && e.LenLogs() > limit {
+		e.RemoveLogsAt(0)
+	}
+}
+
+func (s *AllianceService) memberName(e *entity.AllianceEntity, playerID int) string {
+	if playerID <= 0 {
+		return ""
+	}
+	member, ok := e.GetMembers(entity.PlayerID(playerID))
+	if !ok {
+		return ""
+	}
+	return member.Name
+}
+
+// pushAlliance 把联盟信息推送给全部成员，走 world 的推送通道
+func (a *AllianceActor) pushAlliance(ctx actor.Context) {
+	worldPID := a.WorldPID()
+	if worldPID == nil || a.entity == nil {
+		return
+	}
+	pb := toPBAlliance(a.summaryFromEntity())
+	items := make([]*gatepb.PushItem, 0, a.entity.LenMembers())
+	a.entity.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		items = append(items, &gatepb.PushItem{PlayerId: int64(k), Payload: &gatepb.PushItem_Alliance{Alliance: pb}})
+	})
+	ctx.Send(worldPID, &messages.WorldPushBatch{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(a.worldID)},
+		Items:            items,
+	})
+}
+
+// lastLog 最新的一条动态
+func (a *AllianceActor) lastLog() (entity.AllianceLogState, bool) {
+	if a.entity == nil || a.entity.LenLogs() == 0 {
+		return entity.AllianceLogState{}, false
+	}
+	return a.entity.AtLogs(a.entity.LenLogs() - 1)
+}
+
+// pushNewLogs 把上次推送之后新增的动态推送给全部成员
+func (a *AllianceActor) pushNewLogs(ctx actor.Context) {
+	if a.entity == nil {
+		return
+	}
+	n := a.entity.LenLogs()
+	start := 0
+	for i := n - 1; a.hasLogMark && i >= 0; i-- {
+		if v, ok := a.entity.AtLogs(i); ok && sameLog(v, a.logMark) {
+			start = i + 1
+			break
+		}
+	}
+	if start >= n {
+		return
+	}
+	a.logMark, a.hasLogMark = a.lastLog()
+	worldPID := a.WorldPID()
+	if worldPID == nil {
+		return
+	}
+	logs := make([]*playerpb.AllianceLog, 0, n-start)
+	for i := start; i < n; i++ {
+		if v, ok := a.entity.AtLogs(i); ok {
+			logs = append(logs, toPBAllianceLog(v))
+		}
+	}
+	items := make([]*gatepb.PushItem, 0, a.entity.LenMembers()*len(logs))
+	a.entity.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		for _, log := range logs {
+			items = append(items, &gatepb.PushItem{PlayerId: int64(k), Payload: &gatepb.PushItem_AllianceLog{AllianceLog: log}})
+		}
+	})
+	ctx.Send(worldPID, &messages.WorldPushBatch{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(a.worldID)},
+		Items:            items,
+	})
+}
+
+func sameLog(a, b entity.AllianceLogState) bool {
+	return a.Kind == b.Kind && a.OpId == b.OpId && a.TargetId == b.TargetId &&
+		a.X == b.X && a.Y == b.Y && a.Ctime.Equal(b.Ctime)
+}
+
+func toPBAllianceLog(v entity.AllianceLogState) *playerpb.AllianceLog {
+	return &playerpb.AllianceLog{
+		Kind:       playerpb.AllianceLogKind(v.Kind),
+		OpId:       int32(v.OpId),
+		OpName:     v.OpName,
+		TargetId:   int32(v.TargetId),
+		TargetName: v.TargetName,
+		Title:      playerpb.AllianceTitle(v.Title),
+		X:          int32(v.X),
+		Y:          int32(v.Y),
+		Ctime:      v.Ctime.UnixMilli(),
+	}
+}
+
+func toPBAlliance(in messages.Alliance) *playerpb.Alliance {
+	majors := make([]*playerpb.Major, 0, len(in.Major))
+	for _, major := range in.Major {
+		if major == nil {
+			continue
+		}
+		majors = append(majors, &playerpb.Major{
+			Rid:   major.Rid,
+			Name:  major.Name,
+			Title: playerpb.AllianceTitle(major.Title),
+		})
+	}
+	return &playerpb.Alliance{
+		Id:        in.Id,
+		Name:      in.Name,
+		Cnt:       in.Cnt,
+		Notice:    in.Notice,
+		Major:     majors,
+		Buildings: toPBSysBuildings(in.Buildings),
+		Limit:     in.Limit,
+		Power:     in.Power,
+		Territory: in.Territory,
+	}
+}
@@ -0,0 +1,135 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// AddMark 添加地图标记，过期的先清掉再判断数量上限
+func (s *AllianceService) AddMark(e *entity.AllianceEntity, req *messages.HAAddMark, now time.Time) error {
+	if req.Pos.X < 0 || req.Pos.X >= _map.MapWidth || req.Pos.Y < 0 || req.Pos.Y >= _map.MapHeight {
+		return fmt.Errorf("mark pos invalid")
+	}
+	if req.Kind < messages.ALLIANCE_MARK_ATTACK || req.Kind > messages.ALLIANCE_MARK_GATHER {
+		return fmt.Errorf("mark kind invalid")
+	}
+	label := strings.TrimSpace(req.Label)
+	conf := basic.BasicConf.Union
+	if utf8.RuneCountInString(label) > conf.MarkLabelLen {
+		return fmt.Errorf("mark label too long")
+	}
+	if words.HasBanned(label) {
+		return fmt.Errorf("mark label contains banned words")
+	}
+	s.PruneMarks(e, now)
+	if e.LenMarks() >= conf.MarkLimit {
+		return fmt.Errorf("mark limit reached")
+	}
+	expire := conf.MarkExpire
+	if req.Expire > 0 {
+		expire = min(req.Expire, conf.MarkExpire)
+	}
+
+	id := 1
+	e.ForEachMarks(func(k int, v entity.AllianceMarkState) {
+		id = max(id, k+1)
+	})
+	e.PutMarks(id, entity.AllianceMarkState{
+		Id:        id,
+		X:         req.Pos.X,
+		Y:         req.Pos.Y,
+		Kind:      int8(req.Kind),
+		Label:     label,
+		CreatorId: entity.PlayerID(req.PlayerId),
+		Expire:    now.Add(time.Duration(expire) * time.Second),
+	})
+	return nil
+}
+
+func (s *AllianceService) DelMark(e *entity.AllianceEntity, id int) error {
+	if !e.DelMarks(id) {
+		return fmt.Errorf("mark not found")
+	}
+	return nil
+}
+
+// PruneMarks 清除到期的标记
+func (s *AllianceService) PruneMarks(e *entity.AllianceEntity, now time.Time) bool {
+	expired := make([]int, 0)
+	e.ForEachMarks(func(k int, v entity.AllianceMarkState) {
+		if !v.Expire.After(now) {
+			expired = append(expired, k)
+		}
+	})
+	return e.DelMarksMany(expired)
+}
+
+// Marks 未过期的标记，按 id 排序
+func (s *AllianceService) Marks(e *entity.AllianceEntity, now time.Time) []messages.AllianceMark {
+	out := make([]messages.AllianceMark, 0, e.LenMarks())
+	e.ForEachMarks(func(k int, v entity.AllianceMarkState) {
+		if !v.Expire.After(now) {
+			return
+		}
+		out = append(out, messages.AllianceMark{
+			Id:        v.Id,
+			Pos:       messages.Pos{X: v.X, Y: v.Y},
+			Kind:      messages.AllianceMarkKind(v.Kind),
+			Label:     v.Label,
+			CreatorId: int(v.CreatorId),
+			Expire:    v.Expire.UnixMilli(),
+		})
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Id < out[j].Id })
+	return out
+}
+
+// pushMarks 把全部标记推送给成员，不传 playerIDs 时推送给全体成员
+func (a *AllianceActor) pushMarks(ctx actor.Context, playerIDs ...int) {
+	worldPID := a.WorldPID()
+	if worldPID == nil || a.entity == nil {
+		return
+	}
+	pb := &playerpb.AllianceMarkList{Marks: toPBAllianceMarks(AS.Marks(a.entity, time.Now()))}
+	if len(playerIDs) == 0 {
+		a.entity.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+			playerIDs = append(playerIDs, int(k))
+		})
+	}
+	items := make([]*gatepb.PushItem, 0, len(playerIDs))
+	for _, id := range playerIDs {
+		items = append(items, &gatepb.PushItem{PlayerId: int64(id), Payload: &gatepb.PushItem_Marks{Marks: pb}})
+	}
+	ctx.Send(worldPID, &messages.WorldPushBatch{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(a.worldID)},
+		Items:            items,
+	})
+}
+
+func toPBAllianceMarks(in []messages.AllianceMark) []*playerpb.AllianceMark {
+	out := make([]*playerpb.AllianceMark, 0, len(in))
+	for _, v := range in {
+		out = append(out, &playerpb.AllianceMark{
+			Id:        int32(v.Id),
+			X:         int32(v.Pos.X),
+			Y:         int32(v.Pos.Y),
+			Kind:      playerpb.AllianceMarkKind(v.Kind),
+			Label:     v.Label,
+			CreatorId: int32(v.CreatorId),
+			Expire:    v.Expire,
+		})
+	}
+	return out
+}
@@ -0,0 +1,172 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"fmt"
+	"time"
+)
+
+// 联盟权限名，对应 basic.json union.permission 的 key
+const (
+	PermVerify    = "verify"    // 审核申请
+	PermKick      = "kick"      // 踢出成员
+	PermNotice    = "notice"    // 修改公告
+	PermAppoint   = "appoint"   // 任免副盟主
+	PermTarget    = "target"    // 宣战和标记目标
+	PermDismiss   = "dismiss"   // 解散联盟
+	PermDiplomacy = "diplomacy" // 外交
+)
+
+// 活跃时间只按小时刷新，避免每条消息都产生落库
+const activeStep = time.Hour
+
+// Allow 按权限表校验成员职位，非成员一律拒绝
+func (s *AllianceService) Allow(e *entity.AllianceEntity, playerID int, perm string) bool {
+	if e == nil {
+		return false
+	}
+	title, ok := s.title(e, playerID)
+	if !ok {
+		return false
+	}
+	for _, v := range basic.BasicConf.Union.Permission[perm] {
+		if messages.AllianceTitle(v) == title {
+			return true
+		}
+	}
+	return false
+}
+
+// Appoint 任免副盟主，不能修改盟主和自己
+func (s *AllianceService) Appoint(a *AllianceActor, req *messages.HAAppointTitle) error {
+	e := a.Entity()
+	if req.TargetId == req.PlayerId {
+		return fmt.Errorf("can not appoint self")
+	}
+	if req.Title != messages.ALLIANCE_VICE_CHAIRMAN && req.Title != messages.ALLIANCE_COMMON {
+		return fmt.Errorf("invalid title")
+	}
+	title, ok := s.title(e, req.TargetId)
+	if !ok {
+		return fmt.Errorf("target not alliance member")
+	}
+	if title == messages.ALLIANCE_CHAIRMAN {
+		return fmt.Errorf("permission denied")
+	}
+	if title == req.Title {
+		return nil
+	}
+	if req.Title == messages.ALLIANCE_VICE_CHAIRMAN {
+		if limit := basic.BasicConf.Union.ViceLimit; limit > 0 && s.countTitle(e, messages.ALLIANCE_VICE_CHAIRMAN) >= limit {
+			return fmt.Errorf("vice chairman full")
+		}
+	}
+	s.setTitle(e, req.TargetId, req.Title)
+	s.addTitleLog(e, messages.ALLIANCE_LOG_APPOINT, req.PlayerId, req.TargetId, req.Title)
+	return nil
+}
+
+// Transfer 盟主让位，原盟主降为普通成员
+func (s *AllianceService) Transfer(a *AllianceActor, req *messages.HATransferChairman) error {
+	e := a.Entity()
+	if title, ok := s.title(e, req.PlayerId); !ok || title != messages.ALLIANCE_CHAIRMAN {
+		return fmt.Errorf("permission denied")
+	}
+	if req.TargetId == req.PlayerId {
+		return fmt.Errorf("can not transfer to self")
+	}
+	if _, ok := s.title(e, req.TargetId); !ok {
+		return fmt.Errorf("target not alliance member")
+	}
+	s.setTitle(e, req.PlayerId, messages.ALLIANCE_COMMON)
+	s.setTitle(e, req.TargetId, messages.ALLIANCE_CHAIRMAN)
+	s.addLog(e, messages.ALLIANCE_LOG_TRANSFER, req.PlayerId, req.TargetId)
+	return nil
+}
+
+// Touch 刷新成员活跃时间
+func (s *AllianceService) Touch(e *entity.AllianceEntity, playerID int, now time.Time) bool {
+	member, ok := e.GetMembers(entity.PlayerID(playerID))
+	if !ok || now.Sub(member.LastActive) < activeStep {
+		return false
+	}
+	return e.UpdateMembers(member.Id, func(v *entity.MemberEntity) {
+		v.SetLastActive(now)
+	})
+}
+
+// AutoAbdicate 盟主长期不活跃时让位，优先最近活跃的副盟主，其次最近活跃的普通成员
+func (s *AllianceService) AutoAbdicate(e *entity.AllianceEntity, now time.Time) bool {
+	days := basic.BasicConf.Union.AbdicateDays
+	if e == nil || days <= 0 {
+		return false
+	}
+	var (
+		chairman  entity.MemberState
+		found     bool
+		successor entity.MemberState
+		hasNext   bool
+	)
+	e.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		if toAllianceTitle(v.Title) == messages.ALLIANCE_CHAIRMAN {
+			chairman, found = v, true
+			return
+		}
+		if !hasNext || betterSuccessor(v, successor) {
+			successor, hasNext = v, true
+		}
+	})
+	// 旧数据没有活跃时间，不做判断
+	if !found || !hasNext || chairman.LastActive.IsZero() {
+		return false
+	}
+	if now.Sub(chairman.LastActive) < time.Duration(days)*24*time.Hour {
+		return false
+	}
+	if !successor.LastActive.After(chairman.LastActive) {
+		return false
+	}
+	s.setTitle(e, int(chairman.Id), messages.ALLIANCE_COMMON)
+	s.setTitle(e, int(successor.Id), messages.ALLIANCE_CHAIRMAN)
+	s.addLog(e, messages.ALLIANCE_LOG_TRANSFER, 0, int(successor.Id))
+	return true
+}
+
+func betterSuccessor(v, cur entity.MemberState) bool {
+	if v.Title != cur.Title {
+		return v.Title < cur.Title
+	}
+	if !v.LastActive.Equal(cur.LastActive) {
+		return v.LastActive.After(cur.LastActive)
+	}
+	return v.Id < cur.Id
+}
+
+// setTitle 修改成员职位并同步 majors
+func (s *AllianceService) setTitle(e *entity.AllianceEntity, playerID int, title messages.AllianceTitle) {
+	id := entity.PlayerID(playerID)
+	member, ok := e.GetMembers(id)
+	if !ok {
+		return
+	}
+	e.UpdateMembers(id, func(v *entity.MemberEntity) {
+		v.SetTitle(int8(title))
+	})
+	if title == messages.ALLIANCE_COMMON {
+		e.DelMajors(id)
+		return
+	}
+	e.PutMajors(id, entity.MajorState{Id: id, Name: member.Name, Title: int8(title)})
+}
+
+func (s *AllianceService) countTitle(e *entity.AllianceEntity, title messages.AllianceTitle) int {
+	cnt := 0
+	e.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		if toAllianceTitle(v.Title) == title {
+			cnt++
+		}
+	})
+	return cnt
+}
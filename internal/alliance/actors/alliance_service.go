@@ -1,6 +1,211 @@
 package actors
 
-// AllianceService 初始化阶段保留空壳，后续按业务逐步补充。
+import (
+	"ThreeKingdoms/internal/alliance/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"fmt"
+	"time"
+)
+
+// AllianceService 联盟业务逻辑，只读写联盟实体，消息收发放在 handler
 type AllianceService struct{}
 
 var AS = &AllianceService{}
+
+// Create 初始化新联盟，创建者为盟主
+func (s *AllianceService) Create(a *AllianceActor, req *messages.HACreateAlliance) error {
+	e := a.Entity()
+	if e.LenMembers() > 0 {
+		return fmt.Errorf("alliance exists")
+	}
+	e.SetWorldId(a.worldID)
+	e.SetName(req.Name)
+	e.SetNotice("")
+	e.ClearApplyList()
+	s.addMember(e, req.PlayerId, req.NickName, messages.ALLIANCE_CHAIRMAN, req.Pos)
+	s.addLog(e, messages.ALLIANCE_LOG_CREATE, req.PlayerId, 0)
+	return nil
+}
+
+// Apply 申请加入联盟
+func (s *AllianceService) Apply(a *AllianceActor, req *messages.HAJoinAlliance) error {
+	e := a.Entity()
+	if e.LenMembers() == 0 {
+		return fmt.Errorf("alliance not found")
+	}
+	if _, ok := e.GetMembers(entity.PlayerID(req.PlayerId)); ok {
+		return fmt.Errorf("already in alliance")
+	}
+	if s.applyIndex(e, req.PlayerId) >= 0 {
+		return fmt.Errorf("already applied")
+	}
+	if err := s.CheckMemberLimit(e); err != nil {
+		return err
+	}
+	e.AppendApplyList(entity.ApplyItemState{PlayerId: req.PlayerId, NickName: req.NickName})
+	return nil
+}
+
+// CheckVerify 校验审核结果，返回对应的申请
+func (s *AllianceService) CheckVerify(a *AllianceActor, req *messages.HAVerifyApply) (entity.ApplyItemState, error) {
+	e := a.Entity()
+	if req.Decide != messages.ALLIANCE_ADOPT && req.Decide != messages.ALLIANCE_REFUSE {
+		return entity.ApplyItemState{}, fmt.Errorf("invalid decide")
+	}
+	item, ok := e.AtApplyList(s.applyIndex(e, req.TargetId))
+	if !ok {
+		return entity.ApplyItemState{}, fmt.Errorf("apply not found")
+	}
+	return item, nil
+}
+
+// Adopt 通过申请，玩家以普通成员身份加入
+func (s *AllianceService) Adopt(a *AllianceActor, opID int, item entity.ApplyItemState, pos messages.Pos) {
+	e := a.Entity()
+	s.RemoveApply(e, item.PlayerId)
+	s.addMember(e, item.PlayerId, item.NickName, messages.ALLIANCE_COMMON, pos)
+	s.addLog(e, messages.ALLIANCE_LOG_JOIN, opID, item.PlayerId)
+}
+
+func (s *AllianceService) RemoveApply(e *entity.AllianceEntity, playerID int) bool {
+	idx := s.applyIndex(e, playerID)
+	if idx < 0 {
+		return false
+	}
+	return e.RemoveApplyListAt(idx)
+}
+
+func (s *AllianceService) CheckMemberLimit(e *entity.AllianceEntity) error {
+	if limit := s.memberLimit(e); limit > 0 && e.LenMembers() >= limit {
+		return fmt.Errorf("alliance member full")
+	}
+	return nil
+}
+
+// memberLimit 成员上限，0 表示不限
+func (s *AllianceService) memberLimit(e *entity.AllianceEntity) int {
+	limit := basic.BasicConf.Union.MemberLimit
+	if limit <= 0 {
+		return 0
+	}
+	return limit + s.Bonus(e).Member
+}
+
+// RefreshStats 刷新联盟列表用的冗余字段
+func (s *AllianceService) RefreshStats(e *entity.AllianceEntity) {
+	if e == nil {
+		return
+	}
+	power := 0
+	e.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		power += v.Power
+	})
+	e.SetMemberCnt(e.LenMembers())
+	e.SetMemberLimit(s.memberLimit(e))
+	e.SetPower(power)
+	e.SetTerritory(e.LenBuildings())
+}
+
+// SetPower 更新成员上报的战力
+func (s *AllianceService) SetPower(e *entity.AllianceEntity, playerID, power int) bool {
+	return e.UpdateMembers(entity.PlayerID(playerID), func(v *entity.MemberEntity) {
+		v.SetPower(max(power, 0))
+	})
+}
+
+// Leave 退出联盟，盟主不能直接退出
+func (s *AllianceService) Leave(a *AllianceActor, req *messages.HALeaveAlliance) error {
+	e := a.Entity()
+	title, ok := s.title(e, req.PlayerId)
+	if !ok {
+		return fmt.Errorf("not alliance member")
+	}
+	if title == messages.ALLIANCE_CHAIRMAN {
+		return fmt.Errorf("chairman can not leave")
+	}
+	s.addLog(e, messages.ALLIANCE_LOG_LEAVE, req.PlayerId, 0)
+	s.removeMember(e, req.PlayerId)
+	return nil
+}
+
+// Kick 只能踢出职位比自己低的成员
+func (s *AllianceService) Kick(a *AllianceActor, req *messages.HAKickMember) error {
+	e := a.Entity()
+	opTitle, ok := s.title(e, req.PlayerId)
+	if !ok {
+		return fmt.Errorf("not alliance member")
+	}
+	targetTitle, ok := s.title(e, req.TargetId)
+	if !ok {
+		return fmt.Errorf("target not alliance member")
+	}
+	if opTitle >= targetTitle {
+		return fmt.Errorf("permission denied")
+	}
+	s.addLog(e, messages.ALLIANCE_LOG_KICK, req.PlayerId, req.TargetId)
+	s.removeMember(e, req.TargetId)
+	return nil
+}
+
+// Dismiss 解散联盟，清空实体并返回原成员
+func (s *AllianceService) Dismiss(a *AllianceActor, req *messages.HADismissAlliance) ([]int, error) {
+	e := a.Entity()
+	members := make([]int, 0, e.LenMembers())
+	e.ForEachMembers(func(k entity.PlayerID, v entity.MemberState) {
+		members = append(members, int(k))
+	})
+	e.ClearMembers()
+	e.ClearMajors()
+	e.ClearApplyList()
+	e.SetNotice("")
+	return members, nil
+}
+
+// AddTreasury 增加联盟资金
+func (s *AllianceService) AddTreasury(e *entity.AllianceEntity, gold int) bool {
+	if gold <= 0 {
+		return false
+	}
+	return e.SetTreasury(e.Treasury() + gold)
+}
+
+// addMember 盟主和副盟主同时记录到 majors
+func (s *AllianceService) addMember(e *entity.AllianceEntity, playerID int, name string, title messages.AllianceTitle, pos messages.Pos) {
+	id := entity.PlayerID(playerID)
+	e.PutMembers(id, entity.MemberState{
+		Id:         id,
+		Name:       name,
+		Title:      int8(title),
+		Pos:        entity.PosState{X: pos.X, Y: pos.Y},
+		LastActive: time.Now(),
+	})
+	if title != messages.ALLIANCE_COMMON {
+		e.PutMajors(id, entity.MajorState{Id: id, Name: name, Title: int8(title)})
+	}
+}
+
+func (s *AllianceService) removeMember(e *entity.AllianceEntity, playerID int) {
+	e.DelMembers(entity.PlayerID(playerID))
+	e.DelMajors(entity.PlayerID(playerID))
+}
+
+func (s *AllianceService) title(e *entity.AllianceEntity, playerID int) (messages.AllianceTitle, bool) {
+	member, ok := e.GetMembers(entity.PlayerID(playerID))
+	if !ok {
+		return messages.ALLIANCE_COMMON, false
+	}
+	return toAllianceTitle(member.Title), true
+}
+
+func (s *AllianceService) applyIndex(e *entity.AllianceEntity, playerID int) int {
+	idx := -1
+	e.RangeApplyList(func(i int, v entity.ApplyItemState) bool {
+		if v.PlayerId == playerID {
+			idx = i
+			return false
+		}
+		return true
+	})
+	return idx
+}
@@ -14,6 +14,7 @@ type Dispatcher struct {
 type Handler struct {
 	fn      reflect.Value
 	reqType reflect.Type
+	perm    string // 需要的联盟权限，空表示不校验
 }
 
 func NewDispatcher() *Dispatcher {
@@ -27,11 +28,43 @@ func NewDispatcher() *Dispatcher {
 func (d *Dispatcher) registerAll() {
 	register(d, AH.HandleHAAllianceInfo)
 	register(d, AH.HandleHAAllianceApplyList)
+	register(d, AH.HandleHACreateAlliance)
+	register(d, AH.HandleHAJoinAlliance)
+	register(d, AH.HandleHALeaveAlliance)
+	register(d, AH.HandleHATransferChairman)
+	register(d, AH.HandleHAMemberActive)
+	register(d, AH.HandleHAAllianceLog)
+	register(d, AH.HandleWAAllianceLog)
+	register(d, AH.HandleHATribute)
+	register(d, AH.HandleHASysBuilding)
+	register(d, AH.HandleHAAllianceBonus)
+	register(d, AH.HandleHAMarkList)
+	register(d, AH.HandleHADiplomacyList)
+	register(d, AH.HandleAADiplomacy)
+	registerPerm(d, AH.HandleHAVerifyApply, PermVerify)
+	registerPerm(d, AH.HandleHAKickMember, PermKick)
+	registerPerm(d, AH.HandleHAAppointTitle, PermAppoint)
+	registerPerm(d, AH.HandleHADismissAlliance, PermDismiss)
+	registerPerm(d, AH.HandleHAEditNotice, PermNotice)
+	registerPerm(d, AH.HandleHARallyCheck, PermTarget)
+	registerPerm(d, AH.HandleHAAddMark, PermTarget)
+	registerPerm(d, AH.HandleHADelMark, PermTarget)
+	registerPerm(d, AH.HandleHAProposeDiplomacy, PermDiplomacy)
+	registerPerm(d, AH.HandleHAAcceptDiplomacy, PermDiplomacy)
 }
 
 func register[Req messages.AllianceMessage](
 	d *Dispatcher,
 	fn func(ctx actor.Context, a *AllianceActor, req Req),
+) {
+	registerPerm(d, fn, "")
+}
+
+// registerPerm 注册需要权限的消息，分发前按权限表校验发起人的职位
+func registerPerm[Req messages.AllianceMessage](
+	d *Dispatcher,
+	fn func(ctx actor.Context, a *AllianceActor, req Req),
+	perm string,
 ) {
 	reqType := reflect.TypeOf((*Req)(nil)).Elem()
 	if reqType == nil {
@@ -44,6 +77,7 @@ func register[Req messages.AllianceMessage](
 	d.handlers[reqType] = Handler{
 		fn:      reflect.ValueOf(fn),
 		reqType: reqType,
+		perm:    perm,
 	}
 }
 
@@ -65,6 +99,11 @@ func (d *Dispatcher) Dispatch(ctx actor.Context, a *AllianceActor, req messages.
 		return
 	}
 
+	if handler.perm != "" && !AS.Allow(a.Entity(), req.PlayerID(), handler.perm) {
+		ctx.Respond(&messages.AHResult{Reason: "permission denied"})
+		return
+	}
+
 	handler.fn.Call([]reflect.Value{
 		reflect.ValueOf(ctx),
 		reflect.ValueOf(a),
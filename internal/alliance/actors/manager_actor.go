@@ -3,9 +3,12 @@ package actors
 import (
 	"ThreeKingdoms/internal/alliance/entity"
 	"ThreeKingdoms/internal/alliance/service/port"
+	sharedactor "ThreeKingdoms/internal/shared/actor"
 	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
 	"context"
 	"sort"
+	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
@@ -14,28 +17,47 @@ type AllianceID = entity.AllianceID
 
 const defaultAllianceID = AllianceID(1)
 
+// allianceCreateTimeout 联盟 actor 初始化新联盟的时限，过期的创建请求会被拒绝
+const allianceCreateTimeout = 2 * time.Second
+
 type summaryEntry struct {
 	summary messages.Alliance
 	version uint64
 }
 
+// creatingEntry 已分配 id、等待联盟 actor 应答的创建请求
+type creatingEntry struct {
+	name     string
+	playerID int
+}
+
 type ManagerActor struct {
 	repo           port.AllianceRepository
+	resolver       sharedactor.ManagerPIDResolver
 	worldID        int
 	allianceActors map[AllianceID]*actor.PID
 	summaries      map[AllianceID]summaryEntry
-	dbLoaded       bool
+	// 创建中的联盟，名称在应答前保持占用
+	creating map[AllianceID]creatingEntry
+	// 系统建筑归属索引，格子下标 -> 联盟
+	owners   map[int]AllianceID
+	index    *summaryIndex
+	dbLoaded bool
 }
 
-func NewManagerActor(repo port.AllianceRepository, worldID int) *ManagerActor {
+func NewManagerActor(repo port.AllianceRepository, resolver sharedactor.ManagerPIDResolver, worldID int) *ManagerActor {
 	if worldID < 0 {
 		worldID = 0
 	}
 	return &ManagerActor{
 		allianceActors: make(map[AllianceID]*actor.PID),
 		repo:           repo,
+		resolver:       resolver,
 		worldID:        worldID,
 		summaries:      make(map[AllianceID]summaryEntry),
+		creating:       make(map[AllianceID]creatingEntry),
+		owners:         make(map[int]AllianceID),
+		index:          newSummaryIndex(),
 	}
 }
 
@@ -51,6 +73,21 @@ func (m *ManagerActor) Receive(ctx actor.Context) {
 		}
 		m.handleAllianceList(ctx, msg)
 		return
+	case *messages.HACreateAlliance:
+		m.handleCreateAlliance(ctx, msg)
+		return
+	case *messages.HACreatedAlliance:
+		m.handleCreatedAlliance(ctx, msg)
+		return
+	case *messages.AllianceDismissed:
+		m.handleAllianceDismissed(ctx, msg)
+		return
+	case *messages.WASysBuildingChanged:
+		m.handleSysBuildingChanged(ctx, msg)
+		return
+	case *messages.HAProposeDiplomacy:
+		m.handleProposeDiplomacy(ctx, msg)
+		return
 	case messages.AllianceMessage:
 		m.forwardAllianceMessage(ctx, msg)
 		return
@@ -65,9 +102,18 @@ func (m *ManagerActor) forwardAllianceMessage(ctx actor.Context, req messages.Al
 		return
 	}
 
-	allianceID := defaultAllianceID
-	if req.AllianceID() > 0 {
-		allianceID = AllianceID(req.AllianceID())
+	if !m.dbLoaded {
+		if err := m.reloadSummariesFromDB(context.Background()); err != nil {
+			ctx.Logger().Error("load alliance summaries from db failed", "world_id", m.worldID, "err", err)
+			ctx.Respond(&messages.AHResult{Reason: "alliance list not ready"})
+			return
+		}
+	}
+	// 只转发给已存在的联盟，新 id 只能由 handleCreateAlliance 分配
+	allianceID := AllianceID(req.AllianceID())
+	if _, ok := m.summaries[allianceID]; !ok {
+		ctx.Respond(&messages.AHResult{Reason: errAllianceNotFound.Error()})
+		return
 	}
 
 	ctx.Forward(m.getOrSpawn(ctx, allianceID))
@@ -88,7 +134,200 @@ func (m *ManagerActor) handleAllianceList(ctx actor.Context, req *messages.HAAll
 			ctx.Logger().Error("load alliance summaries from db failed", "world_id", worldID, "err", err)
 		}
 	}
-	ctx.Respond(&messages.AHAllianceList{List: m.snapshotSummaries()})
+	ctx.Respond(m.querySummaries(req))
+}
+
+// handleCreateAlliance 校验名称唯一并分配联盟 id，联盟 actor 应答成功后才写入摘要
+func (m *ManagerActor) handleCreateAlliance(ctx actor.Context, req *messages.HACreateAlliance) {
+	if req == nil || req.WorldID() <= 0 || req.WorldID() != m.worldID {
+		ctx.Respond(&messages.AHCreateAlliance{Reason: "world not match"})
+		return
+	}
+	if !m.dbLoaded {
+		if err := m.reloadSummariesFromDB(context.Background()); err != nil {
+			ctx.Logger().Error("load alliance summaries from db failed", "world_id", m.worldID, "err", err)
+			ctx.Respond(&messages.AHCreateAlliance{Reason: "alliance list not ready"})
+			return
+		}
+	}
+	if m.nameTaken(req.Name) {
+		ctx.Respond(&messages.AHCreateAlliance{Reason: "alliance name exists"})
+		return
+	}
+
+	allianceID, err := m.nextAllianceID(context.Background())
+	if err != nil {
+		ctx.Logger().Error("allocate alliance id failed", "world_id", m.worldID, "err", err)
+		ctx.Respond(&messages.AHCreateAlliance{Reason: "alliance id unavailable"})
+		return
+	}
+	m.creating[allianceID] = creatingEntry{name: req.Name, playerID: req.PlayerId}
+	req.AllianceId = int(allianceID)
+	req.Deadline = time.Now().Add(allianceCreateTimeout)
+	f := ctx.RequestFuture(m.getOrSpawn(ctx, allianceID), req, allianceCreateTimeout+time.Second)
+
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		delete(m.creating, allianceID)
+		created, isCreated := res.(*messages.AHCreateAlliance)
+		if err != nil || !isCreated {
+			// 过了 Deadline 联盟 actor 不会再创建；已经创建的话摘要随 AllianceSummaryUpsert 到达。
+			// 不应答，玩家超时后用 HACreatedAlliance 对账
+			ctx.Logger().Error("create alliance no reply", "world_id", m.worldID, "alliance_id", allianceID, "err", err)
+			return
+		}
+		if !created.OK {
+			if _, ok := m.summaries[allianceID]; !ok {
+				m.stopAlliance(ctx, allianceID)
+			}
+			ctx.Respond(created)
+			return
+		}
+		if _, ok := m.summaries[allianceID]; !ok {
+			m.summaries[allianceID] = summaryEntry{summary: created.Alliance}
+			m.index.invalidate()
+		}
+		ctx.Respond(created)
+	})
+}
+
+// handleCreatedAlliance 创建应答丢失时玩家来对账，查找该玩家为盟主的联盟
+func (m *ManagerActor) handleCreatedAlliance(ctx actor.Context, req *messages.HACreatedAlliance) {
+	if req == nil || req.WorldID() != m.worldID {
+		ctx.Respond(&messages.AHCreateAlliance{Reason: "world not match"})
+		return
+	}
+	if !m.dbLoaded {
+		if err := m.reloadSummariesFromDB(context.Background()); err != nil {
+			ctx.Logger().Error("load alliance summaries from db failed", "world_id", m.worldID, "err", err)
+			ctx.Respond(&messages.AHCreateAlliance{Reason: "alliance list not ready", Pending: true})
+			return
+		}
+	}
+	for _, entry := range m.creating {
+		if entry.playerID == req.PlayerId {
+			ctx.Respond(&messages.AHCreateAlliance{Reason: "alliance creating", Pending: true})
+			return
+		}
+	}
+	for _, entry := range m.summaries {
+		for _, major := range entry.summary.Major {
+			if int(major.Rid) == req.PlayerId && major.Title == messages.ALLIANCE_CHAIRMAN {
+				ctx.Respond(&messages.AHCreateAlliance{OK: true, Alliance: entry.summary})
+				return
+			}
+		}
+	}
+	ctx.Respond(&messages.AHCreateAlliance{Reason: errAllianceNotFound.Error()})
+}
+
+// nameTaken 已有联盟和创建中的联盟都占用名称
+func (m *ManagerActor) nameTaken(name string) bool {
+	for _, entry := range m.summaries {
+		if entry.summary.Name == name {
+			return true
+		}
+	}
+	for _, entry := range m.creating {
+		if entry.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// nextAllianceID 联盟 id 需要放进 int32 的协议字段，由持久化的计数器递增分配。
+// 解散的联盟已不在摘要里，不能按当前最大 id 加一，否则新联盟会继承旧 id 的外交和附庸
+func (m *ManagerActor) nextAllianceID(ctx context.Context) (AllianceID, error) {
+	floor := defaultAllianceID - 1
+	for allianceID := range m.summaries {
+		floor = max(floor, allianceID)
+	}
+	for allianceID := range m.creating {
+		floor = max(floor, allianceID)
+	}
+	if m.repo == nil {
+		return floor + 1, nil
+	}
+	return m.repo.NextAllianceID(ctx, floor)
+}
+
+// handleProposeDiplomacy 按摘要确认对方联盟存在并填入名字，再交给发起方联盟处理
+func (m *ManagerActor) handleProposeDiplomacy(ctx actor.Context, req *messages.HAProposeDiplomacy) {
+	if req == nil || req.WorldID() != m.worldID {
+		ctx.Respond(&messages.AHResult{Reason: "world not match"})
+		return
+	}
+	if !m.dbLoaded {
+		if err := m.reloadSummariesFromDB(context.Background()); err != nil {
+			ctx.Logger().Error("load alliance summaries from db failed", "world_id", m.worldID, "err", err)
+			ctx.Respond(&messages.AHResult{Reason: "alliance list not ready"})
+			return
+		}
+	}
+	entry, ok := m.summaries[AllianceID(req.TargetId)]
+	if !ok {
+		ctx.Respond(&messages.AHResult{Reason: "alliance not found"})
+		return
+	}
+	req.TargetName = entry.summary.Name
+	m.forwardAllianceMessage(ctx, req)
+}
+
+func (m *ManagerActor) handleAllianceDismissed(ctx actor.Context, msg *messages.AllianceDismissed) {
+	if msg == nil || msg.WorldId != m.worldID {
+		return
+	}
+	allianceID := AllianceID(msg.AllianceId)
+	delete(m.summaries, allianceID)
+	m.index.invalidate()
+	for pos, owner := range m.owners {
+		if owner == allianceID {
+			delete(m.owners, pos)
+		}
+	}
+	m.stopAlliance(ctx, allianceID)
+}
+
+func (m *ManagerActor) stopAlliance(ctx actor.Context, allianceID AllianceID) {
+	if pid, ok := m.allianceActors[allianceID]; ok {
+		delete(m.allianceActors, allianceID)
+		ctx.Stop(pid)
+	}
+}
+
+// handleSysBuildingChanged 更新系统建筑归属索引，旧联盟以索引为准
+func (m *ManagerActor) handleSysBuildingChanged(ctx actor.Context, msg *messages.WASysBuildingChanged) {
+	if msg == nil || msg.WorldId != m.worldID {
+		return
+	}
+	if !m.dbLoaded {
+		if err := m.reloadSummariesFromDB(context.Background()); err != nil {
+			ctx.Logger().Error("load alliance summaries from db failed", "world_id", m.worldID, "err", err)
+			return
+		}
+	}
+	pos := _map.ToPosition(msg.Building.Pos.X, msg.Building.Pos.Y)
+	oldID, newID := m.owners[pos], AllianceID(msg.AllianceId)
+	if oldID == newID {
+		return
+	}
+	if newID > 0 {
+		m.owners[pos] = newID
+	} else {
+		delete(m.owners, pos)
+	}
+	notify := func(allianceID AllianceID, add bool) {
+		if _, ok := m.summaries[allianceID]; !ok {
+			return
+		}
+		ctx.Send(m.getOrSpawn(ctx, allianceID), &messages.HASysBuilding{
+			AllianceBaseMessage: messages.AllianceBaseMessage{WorldId: m.worldID, AllianceId: int(allianceID)},
+			Building:            msg.Building,
+			Add:                 add,
+		})
+	}
+	notify(oldID, false)
+	notify(newID, true)
 }
 
 func (m *ManagerActor) applySummary(upsert *messages.AllianceSummaryUpsert) {
@@ -99,7 +338,7 @@ func (m *ManagerActor) applySummary(upsert *messages.AllianceSummaryUpsert) {
 		return
 	}
 	allianceID := AllianceID(upsert.Summary.Id)
-	if allianceID <= 0 {
+	if allianceID <= 0 || upsert.Summary.Cnt <= 0 {
 		return
 	}
 	if old, ok := m.summaries[allianceID]; ok && upsert.Version < old.version {
@@ -109,6 +348,7 @@ func (m *ManagerActor) applySummary(upsert *messages.AllianceSummaryUpsert) {
 		summary: upsert.Summary,
 		version: upsert.Version,
 	}
+	m.index.invalidate()
 }
 
 func (m *ManagerActor) reloadSummariesFromDB(ctx context.Context) error {
@@ -120,10 +360,14 @@ func (m *ManagerActor) reloadSummariesFromDB(ctx context.Context) error {
 		return err
 	}
 	next := make(map[AllianceID]summaryEntry, len(states))
+	owners := make(map[int]AllianceID)
 	for _, state := range states {
-		if int(state.WorldId) != m.worldID {
+		if int(state.WorldId) != m.worldID || len(state.Members) == 0 {
 			continue
 		}
+		for pos := range state.Buildings {
+			owners[pos] = state.Id
+		}
 		allianceID := state.Id
 		next[allianceID] = summaryEntry{
 			summary: stateToSummary(state),
@@ -131,30 +375,12 @@ func (m *ManagerActor) reloadSummariesFromDB(ctx context.Context) error {
 		}
 	}
 	m.summaries = next
+	m.owners = owners
+	m.index.invalidate()
 	m.dbLoaded = true
 	return nil
 }
 
-func (m *ManagerActor) snapshotSummaries() []messages.Alliance {
-	if len(m.summaries) == 0 {
-		return make([]messages.Alliance, 0)
-	}
-	ids := make([]int, 0, len(m.summaries))
-	for allianceID := range m.summaries {
-		ids = append(ids, int(allianceID))
-	}
-	sort.Ints(ids)
-	out := make([]messages.Alliance, 0, len(ids))
-	for _, id := range ids {
-		entry, ok := m.summaries[AllianceID(id)]
-		if !ok {
-			continue
-		}
-		out = append(out, entry.summary)
-	}
-	return out
-}
-
 func stateToSummary(state entity.AllianceState) messages.Alliance {
 	majorIDs := make([]int, 0, len(state.Majors))
 	for rid := range state.Majors {
@@ -174,11 +400,15 @@ func stateToSummary(state entity.AllianceState) messages.Alliance {
 		})
 	}
 	return messages.Alliance{
-		Id:     int32(state.Id),
-		Name:   state.Name,
-		Cnt:    int32(len(state.Members)),
-		Notice: state.Notice,
-		Major:  majorList,
+		Id:        int32(state.Id),
+		Name:      state.Name,
+		Cnt:       int32(len(state.Members)),
+		Notice:    state.Notice,
+		Major:     majorList,
+		Buildings: buildingsOf(state.Buildings),
+		Limit:     int32(state.MemberLimit),
+		Power:     int32(state.Power),
+		Territory: int32(len(state.Buildings)),
 	}
 }
 
@@ -201,7 +431,7 @@ func (m *ManagerActor) getOrSpawn(ctx actor.Context, allianceID AllianceID) *act
 	}
 
 	props := actor.PropsFromProducer(func() actor.Actor {
-		return NewAllianceActor(allianceID, entity.WorldID(m.worldID), ctx.Self(), m.repo)
+		return NewAllianceActor(allianceID, entity.WorldID(m.worldID), ctx.Self(), m.repo, m.resolver)
 	})
 	pid := ctx.Spawn(props)
 	m.allianceActors[allianceID] = pid
@@ -0,0 +1,132 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultListPageSize = 20
+	maxListPageSize     = 50
+)
+
+// summaryIndex 联盟摘要按排序字段预排好的 id 列表，摘要变化后标记失效，下次查询时重建
+type summaryIndex struct {
+	dirty  bool
+	orders map[messages.AllianceSort][]AllianceID
+}
+
+func newSummaryIndex() *summaryIndex {
+	return &summaryIndex{dirty: true, orders: make(map[messages.AllianceSort][]AllianceID)}
+}
+
+func (x *summaryIndex) invalidate() {
+	x.dirty = true
+}
+
+// order 返回按 sortBy 排好的联盟 id
+func (x *summaryIndex) order(summaries map[AllianceID]summaryEntry, sortBy messages.AllianceSort) []AllianceID {
+	if x.dirty {
+		x.orders = make(map[messages.AllianceSort][]AllianceID)
+		x.dirty = false
+	}
+	if ids, ok := x.orders[sortBy]; ok {
+		return ids
+	}
+	ids := make([]AllianceID, 0, len(summaries))
+	for id := range summaries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return before(sortKey(summaries[ids[i]].summary, sortBy), ids[i], sortKey(summaries[ids[j]].summary, sortBy), ids[j])
+	})
+	x.orders[sortBy] = ids
+	return ids
+}
+
+// sortKey 排序值，按 id 排序时所有联盟的排序值相同，只比较 id
+func sortKey(s messages.Alliance, sortBy messages.AllianceSort) int {
+	switch sortBy {
+	case messages.ALLIANCE_SORT_CNT:
+		return int(s.Cnt)
+	case messages.ALLIANCE_SORT_TERRITORY:
+		return int(s.Territory)
+	case messages.ALLIANCE_SORT_POWER:
+		return int(s.Power)
+	}
+	return 0
+}
+
+// before 排序值从大到小，相同时 id 从小到大
+func before(keyA int, idA AllianceID, keyB int, idB AllianceID) bool {
+	if keyA != keyB {
+		return keyA > keyB
+	}
+	return idA < idB
+}
+
+// 游标记录上一页最后一条的排序值和 id，翻页期间数据变化也不会重复或跳过未变化的联盟
+func encodeCursor(key int, id AllianceID) string {
+	return fmt.Sprintf("%d_%d", key, id)
+}
+
+func decodeCursor(cursor string) (int, AllianceID, bool) {
+	var (
+		key int
+		id  AllianceID
+	)
+	if _, err := fmt.Sscanf(cursor, "%d_%d", &key, &id); err != nil {
+		return 0, 0, false
+	}
+	return key, id, true
+}
+
+func matchSummary(s messages.Alliance, req *messages.HAAllianceList) bool {
+	if req.HasFree && s.Limit > 0 && s.Cnt >= s.Limit {
+		return false
+	}
+	keyword := strings.ToLower(strings.TrimSpace(req.Keyword))
+	if keyword == "" {
+		return true
+	}
+	name := strings.ToLower(s.Name)
+	if req.Prefix {
+		return strings.HasPrefix(name, keyword)
+	}
+	return strings.Contains(name, keyword)
+}
+
+// querySummaries 按条件过滤并分页
+func (m *ManagerActor) querySummaries(req *messages.HAAllianceList) *messages.AHAllianceList {
+	size := req.Size
+	if size <= 0 {
+		size = defaultListPageSize
+	}
+	size = min(size, maxListPageSize)
+	curKey, curID, hasCursor := decodeCursor(req.Cursor)
+
+	resp := &messages.AHAllianceList{List: make([]messages.Alliance, 0, size)}
+	var lastKey int
+	var lastID AllianceID
+	for _, id := range m.index.order(m.summaries, req.Sort) {
+		entry := m.summaries[id]
+		if !matchSummary(entry.summary, req) {
+			continue
+		}
+		resp.Total++
+		key := sortKey(entry.summary, req.Sort)
+		if hasCursor && !before(curKey, curID, key, id) {
+			continue
+		}
+		if len(resp.List) < size {
+			resp.List = append(resp.List, entry.summary)
+			lastKey, lastID = key, id
+			continue
+		}
+		// 还有下一页
+		resp.NextCursor = encodeCursor(lastKey, lastID)
+	}
+	return resp
+}
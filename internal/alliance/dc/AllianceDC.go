@@ -70,6 +70,24 @@ func (d *AllianceDC) Load(ctx context.Context, allianceID AllianceID) (*entity.A
 func (d *AllianceDC) Entity() *entity.AllianceEntity { return d.entity }
 func (d *AllianceDC) FlushEvery() time.Duration      { return d.flushEvery }
 
+// Version 已生成的最新快照版本
+func (d *AllianceDC) Version() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.version
+}
+
+// Delete 删除联盟文档，调用前应先 FlushSync，避免 writer 重新写回
+func (d *AllianceDC) Delete(ctx context.Context) error {
+	if d.repo == nil {
+		return ErrNoRepo
+	}
+	if d.entity == nil {
+		return nil
+	}
+	return d.repo.DeleteAlliance(normalizeContext(ctx), d.entity.Id())
+}
+
 func (d *AllianceDC) IsDirty() bool {
 	if d.entity == nil {
 		return false
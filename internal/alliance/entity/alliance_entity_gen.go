@@ -8,13 +8,22 @@ import (
 )
 
 const (
-	FieldAlliance_id        Field = "id"
-	FieldAlliance_worldId   Field = "worldId"
-	FieldAlliance_name      Field = "name"
-	FieldAlliance_notice    Field = "notice"
-	FieldAlliance_majors    Field = "majors"
-	FieldAlliance_members   Field = "members"
-	FieldAlliance_applyList Field = "applyList"
+	FieldAlliance_id          Field = "id"
+	FieldAlliance_worldId     Field = "worldId"
+	FieldAlliance_name        Field = "name"
+	FieldAlliance_notice      Field = "notice"
+	FieldAlliance_majors      Field = "majors"
+	FieldAlliance_members     Field = "members"
+	FieldAlliance_applyList   Field = "applyList"
+	FieldAlliance_logs        Field = "logs"
+	FieldAlliance_treasury    Field = "treasury"
+	FieldAlliance_buildings   Field = "buildings"
+	FieldAlliance_marks       Field = "marks"
+	FieldAlliance_relations   Field = "relations"
+	FieldAlliance_memberCnt   Field = "memberCnt"
+	FieldAlliance_memberLimit Field = "memberLimit"
+	FieldAlliance_power       Field = "power"
+	FieldAlliance_territory   Field = "territory"
 )
 
 var emptyAllianceEntity = &AllianceEntity{}
@@ -40,11 +49,14 @@ type AllianceEntityCollectionChangeInner struct {
 }
 
 type AllianceEntityTrace struct {
-	dirty              bool
-	trace              map[Field]bool
-	changes            map[Field]*AllianceEntityCollectionChangeInner
-	childDirty_majors  map[PlayerID]struct{}
-	childDirty_members map[PlayerID]struct{}
+	dirty                bool
+	trace                map[Field]bool
+	changes              map[Field]*AllianceEntityCollectionChangeInner
+	childDirty_majors    map[PlayerID]struct{}
+	childDirty_members   map[PlayerID]struct{}
+	childDirty_buildings map[int]struct{}
+	childDirty_marks     map[int]struct{}
+	childDirty_relations map[AllianceID]struct{}
 }
 
 func (t *AllianceEntityTrace) mark(f Field) {
@@ -205,34 +217,136 @@ func (t *AllianceEntityTrace) childDirtyKeys_members() []PlayerID {
 	return out
 }
 
+func (t *AllianceEntityTrace) markChildDirty_buildings(f Field, key int) {
+	t.mark(f)
+	if t.childDirty_buildings == nil {
+		t.childDirty_buildings = make(map[int]struct{}, 8)
+	}
+	t.childDirty_buildings[key] = struct{}{}
+}
+
+func (t *AllianceEntityTrace) clearChildDirty_buildings(key int) {
+	if t.childDirty_buildings == nil {
+		return
+	}
+	delete(t.childDirty_buildings, key)
+}
+
+func (t *AllianceEntityTrace) childDirtyKeys_buildings() []int {
+	if len(t.childDirty_buildings) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(t.childDirty_buildings))
+	for key := range t.childDirty_buildings {
+		out = append(out, key)
+	}
+	sort.Slice(out, func(i, j int) bool { return fmt.Sprint(out[i]) < fmt.Sprint(out[j]) })
+	return out
+}
+
+func (t *AllianceEntityTrace) markChildDirty_marks(f Field, key int) {
+	t.mark(f)
+	if t.childDirty_marks == nil {
+		t.childDirty_marks = make(map[int]struct{}, 8)
+	}
+	t.childDirty_marks[key] = struct{}{}
+}
+
+func (t *AllianceEntityTrace) clearChildDirty_marks(key int) {
+	if t.childDirty_marks == nil {
+		return
+	}
+	delete(t.childDirty_marks, key)
+}
+
+func (t *AllianceEntityTrace) childDirtyKeys_marks() []int {
+	if len(t.childDirty_marks) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(t.childDirty_marks))
+	for key := range t.childDirty_marks {
+		out = append(out, key)
+	}
+	sort.Slice(out, func(i, j int) bool { return fmt.Sprint(out[i]) < fmt.Sprint(out[j]) })
+	return out
+}
+
+func (t *AllianceEntityTrace) markChildDirty_relations(f Field, key AllianceID) {
+	t.mark(f)
+	if t.childDirty_relations == nil {
+		t.childDirty_relations = make(map[AllianceID]struct{}, 8)
+	}
+	t.childDirty_relations[key] = struct{}{}
+}
+
+func (t *AllianceEntityTrace) clearChildDirty_relations(key AllianceID) {
+	if t.childDirty_relations == nil {
+		return
+	}
+	delete(t.childDirty_relations, key)
+}
+
+func (t *AllianceEntityTrace) childDirtyKeys_relations() []AllianceID {
+	if len(t.childDirty_relations) == 0 {
+		return nil
+	}
+	out := make([]AllianceID, 0, len(t.childDirty_relations))
+	for key := range t.childDirty_relations {
+		out = append(out, key)
+	}
+	sort.Slice(out, func(i, j int) bool { return fmt.Sprint(out[i]) < fmt.Sprint(out[j]) })
+	return out
+}
+
 type AllianceState struct {
-	Id        AllianceID
-	WorldId   WorldID
-	Name      string
-	Notice    string
-	Majors    map[PlayerID]MajorState
-	Members   map[PlayerID]MemberState
-	ApplyList []ApplyItemState
+	Id          AllianceID
+	WorldId     WorldID
+	Name        string
+	Notice      string
+	Majors      map[PlayerID]MajorState
+	Members     map[PlayerID]MemberState
+	ApplyList   []ApplyItemState
+	Logs        []AllianceLogState
+	Treasury    int
+	Buildings   map[int]SysBuildingState
+	Marks       map[int]AllianceMarkState
+	Relations   map[AllianceID]RelationState
+	MemberCnt   int
+	MemberLimit int
+	Power       int
+	Territory   int
 }
 
 type AllianceEntitySnap struct {
-	Version          uint64
-	State            AllianceState
-	DirtyFields      []Field
-	Changes          map[Field]AllianceEntityCollectionChange
-	MajorsDirtyKeys  []PlayerID
-	MembersDirtyKeys []PlayerID
+	Version            uint64
+	State              AllianceState
+	DirtyFields        []Field
+	Changes            map[Field]AllianceEntityCollectionChange
+	MajorsDirtyKeys    []PlayerID
+	MembersDirtyKeys   []PlayerID
+	BuildingsDirtyKeys []int
+	MarksDirtyKeys     []int
+	RelationsDirtyKeys []AllianceID
 }
 
 type AllianceEntity struct {
-	id        AllianceID
-	worldId   WorldID
-	name      string
-	notice    string
-	majors    map[PlayerID]*MajorEntity
-	members   map[PlayerID]*MemberEntity
-	applyList []*ApplyItemEntity
-	_dt       AllianceEntityTrace
+	id          AllianceID
+	worldId     WorldID
+	name        string
+	notice      string
+	majors      map[PlayerID]*MajorEntity
+	members     map[PlayerID]*MemberEntity
+	applyList   []*ApplyItemEntity
+	logs        []*AllianceLogEntity
+	treasury    int
+	buildings   map[int]*SysBuildingEntity
+	marks       map[int]*AllianceMarkEntity
+	relations   map[AllianceID]*RelationEntity
+	memberCnt   int
+	memberLimit int
+	power       int
+	territory   int
+	_dt         AllianceEntityTrace
 }
 
 func (e *AllianceEntity) copyMapMajors(in map[PlayerID]MajorState) map[PlayerID]MajorState {
@@ -370,15 +484,204 @@ func (e *AllianceEntity) slicesEqualApplyList(a, b []ApplyItemState) bool {
 	return true
 }
 
+func (e *AllianceEntity) hydrateSliceLogs(in []AllianceLogState) []*AllianceLogEntity {
+	if in == nil {
+		return nil
+	}
+	out := make([]*AllianceLogEntity, len(in))
+	for i, v := range in {
+		out[i] = HydrateAllianceLogEntity(v)
+	}
+	return out
+}
+
+func (e *AllianceEntity) snapshotSliceLogs(in []*AllianceLogEntity) []AllianceLogState {
+	if in == nil {
+		return nil
+	}
+	out := make([]AllianceLogState, len(in))
+	for i, v := range in {
+		if v == nil {
+			var z AllianceLogState
+			out[i] = z
+			continue
+		}
+		out[i] = v.Save()
+	}
+	return out
+}
+
+func (e *AllianceEntity) slicesEqualLogs(a, b []AllianceLogState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *AllianceEntity) copyMapBuildings(in map[int]SysBuildingState) map[int]SysBuildingState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]SysBuildingState, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (e *AllianceEntity) mapsEqualBuildings(a, b map[int]SysBuildingState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	return false
+}
+
+func (e *AllianceEntity) hydrateMapBuildings(in map[int]SysBuildingState) map[int]*SysBuildingEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]*SysBuildingEntity, len(in))
+	for k, v := range in {
+		out[k] = HydrateSysBuildingEntity(v)
+	}
+	return out
+}
+
+func (e *AllianceEntity) snapshotMapBuildings(in map[int]*SysBuildingEntity) map[int]SysBuildingState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]SysBuildingState, len(in))
+	for k, v := range in {
+		if v == nil {
+			var z SysBuildingState
+			out[k] = z
+			continue
+		}
+		out[k] = v.Save()
+	}
+	return out
+}
+
+func (e *AllianceEntity) copyMapMarks(in map[int]AllianceMarkState) map[int]AllianceMarkState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]AllianceMarkState, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (e *AllianceEntity) mapsEqualMarks(a, b map[int]AllianceMarkState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	return false
+}
+
+func (e *AllianceEntity) hydrateMapMarks(in map[int]AllianceMarkState) map[int]*AllianceMarkEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]*AllianceMarkEntity, len(in))
+	for k, v := range in {
+		out[k] = HydrateAllianceMarkEntity(v)
+	}
+	return out
+}
+
+func (e *AllianceEntity) snapshotMapMarks(in map[int]*AllianceMarkEntity) map[int]AllianceMarkState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]AllianceMarkState, len(in))
+	for k, v := range in {
+		if v == nil {
+			var z AllianceMarkState
+			out[k] = z
+			continue
+		}
+		out[k] = v.Save()
+	}
+	return out
+}
+
+func (e *AllianceEntity) copyMapRelations(in map[AllianceID]RelationState) map[AllianceID]RelationState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]RelationState, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (e *AllianceEntity) mapsEqualRelations(a, b map[AllianceID]RelationState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	return false
+}
+
+func (e *AllianceEntity) hydrateMapRelations(in map[AllianceID]RelationState) map[AllianceID]*RelationEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]*RelationEntity, len(in))
+	for k, v := range in {
+		out[k] = HydrateRelationEntity(v)
+	}
+	return out
+}
+
+func (e *AllianceEntity) snapshotMapRelations(in map[AllianceID]*RelationEntity) map[AllianceID]RelationState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]RelationState, len(in))
+	for k, v := range in {
+		if v == nil {
+			var z RelationState
+			out[k] = z
+			continue
+		}
+		out[k] = v.Save()
+	}
+	return out
+}
+
 func HydrateAllianceEntity(s AllianceState) *AllianceEntity {
 	return &AllianceEntity{
-		id:        s.Id,
-		worldId:   s.WorldId,
-		name:      s.Name,
-		notice:    s.Notice,
-		majors:    emptyAllianceEntity.hydrateMapMajors(s.Majors),
-		members:   emptyAllianceEntity.hydrateMapMembers(s.Members),
-		applyList: emptyAllianceEntity.hydrateSliceApplyList(s.ApplyList),
+		id:          s.Id,
+		worldId:     s.WorldId,
+		name:        s.Name,
+		notice:      s.Notice,
+		majors:      emptyAllianceEntity.hydrateMapMajors(s.Majors),
+		members:     emptyAllianceEntity.hydrateMapMembers(s.Members),
+		applyList:   emptyAllianceEntity.hydrateSliceApplyList(s.ApplyList),
+		logs:        emptyAllianceEntity.hydrateSliceLogs(s.Logs),
+		treasury:    s.Treasury,
+		buildings:   emptyAllianceEntity.hydrateMapBuildings(s.Buildings),
+		marks:       emptyAllianceEntity.hydrateMapMarks(s.Marks),
+		relations:   emptyAllianceEntity.hydrateMapRelations(s.Relations),
+		memberCnt:   s.MemberCnt,
+		memberLimit: s.MemberLimit,
+		power:       s.Power,
+		territory:   s.Territory,
 	}
 }
 
@@ -505,6 +808,15 @@ func (e *AllianceEntity) Save() AllianceState {
 	s.Majors = e.snapshotMapMajors(e.majors)
 	s.Members = e.snapshotMapMembers(e.members)
 	s.ApplyList = e.snapshotSliceApplyList(e.applyList)
+	s.Logs = e.snapshotSliceLogs(e.logs)
+	s.Treasury = e.treasury
+	s.Buildings = e.snapshotMapBuildings(e.buildings)
+	s.Marks = e.snapshotMapMarks(e.marks)
+	s.Relations = e.snapshotMapRelations(e.relations)
+	s.MemberCnt = e.memberCnt
+	s.MemberLimit = e.memberLimit
+	s.Power = e.power
+	s.Territory = e.territory
 	return s
 }
 
@@ -515,12 +827,15 @@ func NewAllianceEntitySnap(version uint64, e *AllianceEntity) *AllianceEntitySna
 	dirtyFields := e.DirtyFields()
 	changes := e.DirtyChanges()
 	return &AllianceEntitySnap{
-		Version:          version,
-		State:            e.Save(),
-		DirtyFields:      dirtyFields,
-		Changes:          changes,
-		MajorsDirtyKeys:  e._dt.childDirtyKeys_majors(),
-		MembersDirtyKeys: e._dt.childDirtyKeys_members(),
+		Version:            version,
+		State:              e.Save(),
+		DirtyFields:        dirtyFields,
+		Changes:            changes,
+		MajorsDirtyKeys:    e._dt.childDirtyKeys_majors(),
+		MembersDirtyKeys:   e._dt.childDirtyKeys_members(),
+		BuildingsDirtyKeys: e._dt.childDirtyKeys_buildings(),
+		MarksDirtyKeys:     e._dt.childDirtyKeys_marks(),
+		RelationsDirtyKeys: e._dt.childDirtyKeys_relations(),
 	}
 }
 
@@ -539,9 +854,16 @@ func (s *AllianceEntitySnap) Clone() *AllianceEntitySnap {
 	}
 	out.MajorsDirtyKeys = append([]PlayerID(nil), s.MajorsDirtyKeys...)
 	out.MembersDirtyKeys = append([]PlayerID(nil), s.MembersDirtyKeys...)
+	out.BuildingsDirtyKeys = append([]int(nil), s.BuildingsDirtyKeys...)
+	out.MarksDirtyKeys = append([]int(nil), s.MarksDirtyKeys...)
+	out.RelationsDirtyKeys = append([]AllianceID(nil), s.RelationsDirtyKeys...)
 	out.State.Majors = emptyAllianceEntity.copyMapMajors(s.State.Majors)
 	out.State.Members = emptyAllianceEntity.copyMapMembers(s.State.Members)
 	out.State.ApplyList = append([]ApplyItemState(nil), s.State.ApplyList...)
+	out.State.Logs = append([]AllianceLogState(nil), s.State.Logs...)
+	out.State.Buildings = emptyAllianceEntity.copyMapBuildings(s.State.Buildings)
+	out.State.Marks = emptyAllianceEntity.copyMapMarks(s.State.Marks)
+	out.State.Relations = emptyAllianceEntity.copyMapRelations(s.State.Relations)
 	return out
 }
 
@@ -1078,3 +1400,707 @@ func (e *AllianceEntity) ClearApplyList() bool {
 	e._dt.markFullReplace(FieldAlliance_applyList)
 	return true
 }
+
+func (e *AllianceEntity) LenLogs() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.logs)
+}
+
+func (e *AllianceEntity) AtLogs(index int) (AllianceLogState, bool) {
+	var z AllianceLogState
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.logs) {
+		return z, false
+	}
+	v := e.logs[index]
+	if v == nil {
+		return z, true
+	}
+	return v.Save(), true
+}
+
+func (e *AllianceEntity) ForEachLogs(fn func(index int, value AllianceLogState)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.logs {
+		var state AllianceLogState
+		if v != nil {
+			state = v.Save()
+		}
+		fn(i, state)
+	}
+}
+
+func (e *AllianceEntity) RangeLogs(fn func(index int, value AllianceLogState) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.logs {
+		var state AllianceLogState
+		if v != nil {
+			state = v.Save()
+		}
+		if !fn(i, state) {
+			return
+		}
+	}
+}
+
+func (e *AllianceEntity) ReplaceLogs(v []AllianceLogState) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualLogs(e.snapshotSliceLogs(e.logs), v) {
+		return false
+	}
+	e.logs = e.hydrateSliceLogs(v)
+	e._dt.markFullReplace(FieldAlliance_logs)
+	return true
+}
+
+func (e *AllianceEntity) AppendLogs(values ...AllianceLogState) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		rv := HydrateAllianceLogEntity(v)
+		e.logs = append(e.logs, rv)
+		e._dt.markSliceAppend(FieldAlliance_logs, v)
+	}
+	return true
+}
+
+func (e *AllianceEntity) SetLogsAt(index int, value AllianceLogState) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.logs) {
+		return false
+	}
+	var oldState AllianceLogState
+	if e.logs[index] != nil {
+		oldState = e.logs[index].Save()
+	}
+	if reflect.DeepEqual(oldState, value) {
+		return false
+	}
+	e.logs[index] = HydrateAllianceLogEntity(value)
+	e._dt.markSliceSet(FieldAlliance_logs, index, value)
+	return true
+}
+
+func (e *AllianceEntity) UpdateLogsAt(index int, fn func(value *AllianceLogEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.logs) {
+		return false
+	}
+	v := e.logs[index]
+	if v == nil {
+		return false
+	}
+	before := v.Save()
+	fn(v)
+	after := v.Save()
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markSliceSet(FieldAlliance_logs, index, after)
+	return true
+}
+
+func (e *AllianceEntity) RemoveLogsAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.logs) {
+		return false
+	}
+	e.logs = append(e.logs[:index], e.logs[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldAlliance_logs, index)
+	return true
+}
+
+func (e *AllianceEntity) SwapRemoveLogsAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.logs) {
+		return false
+	}
+	last := len(e.logs) - 1
+	if index != last {
+		e.logs[index] = e.logs[last]
+	}
+	e.logs = e.logs[:last]
+	e._dt.markSliceSwapRemoveAt(FieldAlliance_logs, index)
+	return true
+}
+
+func (e *AllianceEntity) ClearLogs() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.logs) == 0 {
+		return false
+	}
+	e.logs = nil
+	e._dt.markFullReplace(FieldAlliance_logs)
+	return true
+}
+
+func (e *AllianceEntity) Treasury() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.treasury
+}
+
+func (e *AllianceEntity) SetTreasury(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.treasury == v {
+		return false
+	}
+	e.treasury = v
+	e._dt.mark(FieldAlliance_treasury)
+	return true
+}
+
+func (e *AllianceEntity) GetBuildings(key int) (SysBuildingState, bool) {
+	var z SysBuildingState
+	if e == nil || e.buildings == nil {
+		return z, false
+	}
+	v, ok := e.buildings[key]
+	if !ok || v == nil {
+		return z, false
+	}
+	return v.Save(), true
+}
+
+func (e *AllianceEntity) LenBuildings() int {
+	if e == nil || e.buildings == nil {
+		return 0
+	}
+	return len(e.buildings)
+}
+
+func (e *AllianceEntity) ForEachBuildings(fn func(key int, value SysBuildingState)) {
+	if e == nil || e.buildings == nil || fn == nil {
+		return
+	}
+	for k, v := range e.buildings {
+		if v == nil {
+			continue
+		}
+		fn(k, v.Save())
+	}
+}
+
+func (e *AllianceEntity) RangeBuildings(fn func(key int, value SysBuildingState) bool) {
+	if e == nil || e.buildings == nil || fn == nil {
+		return
+	}
+	for k, v := range e.buildings {
+		if v == nil {
+			continue
+		}
+		if !fn(k, v.Save()) {
+			return
+		}
+	}
+}
+
+func (e *AllianceEntity) DirtyBuildingsKeys() []int {
+	if e == nil {
+		return nil
+	}
+	return e._dt.childDirtyKeys_buildings()
+}
+
+func (e *AllianceEntity) ReplaceBuildings(v map[int]SysBuildingState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualBuildings(e.snapshotMapBuildings(e.buildings), v) {
+		return false
+	}
+	e.buildings = e.hydrateMapBuildings(v)
+	e._dt.markFullReplace(FieldAlliance_buildings)
+	return true
+}
+
+func (e *AllianceEntity) PutBuildings(key int, value SysBuildingState) bool {
+	if e == nil {
+		return false
+	}
+	if e.buildings == nil {
+		e.buildings = make(map[int]*SysBuildingEntity)
+	}
+	e.buildings[key] = HydrateSysBuildingEntity(value)
+	e._dt.markMapSet(FieldAlliance_buildings, fmt.Sprint(key), value)
+	e._dt.markChildDirty_buildings(FieldAlliance_buildings, key)
+	return true
+}
+
+func (e *AllianceEntity) PutBuildingsMany(entries map[int]SysBuildingState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.buildings == nil {
+		e.buildings = make(map[int]*SysBuildingEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		e.buildings[k] = HydrateSysBuildingEntity(v)
+		e._dt.markMapSet(FieldAlliance_buildings, fmt.Sprint(k), v)
+		e._dt.markChildDirty_buildings(FieldAlliance_buildings, k)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) UpdateBuildings(key int, fn func(value *SysBuildingEntity)) bool {
+	if e == nil || fn == nil || e.buildings == nil {
+		return false
+	}
+	v, ok := e.buildings[key]
+	if !ok || v == nil {
+		return false
+	}
+	fn(v)
+	e._dt.markChildDirty_buildings(FieldAlliance_buildings, key)
+	return true
+}
+
+func (e *AllianceEntity) DelBuildings(key int) bool {
+	if e == nil || e.buildings == nil {
+		return false
+	}
+	if _, ok := e.buildings[key]; !ok {
+		return false
+	}
+	delete(e.buildings, key)
+	e._dt.markMapDelete(FieldAlliance_buildings, fmt.Sprint(key))
+	e._dt.clearChildDirty_buildings(key)
+	return true
+}
+
+func (e *AllianceEntity) DelBuildingsMany(keys []int) bool {
+	if e == nil || e.buildings == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.buildings[key]; !ok {
+			continue
+		}
+		delete(e.buildings, key)
+		e._dt.markMapDelete(FieldAlliance_buildings, fmt.Sprint(key))
+		e._dt.clearChildDirty_buildings(key)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) ClearBuildings() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.buildings) == 0 {
+		return false
+	}
+	e.buildings = nil
+	e._dt.markFullReplace(FieldAlliance_buildings)
+	e._dt.childDirty_buildings = nil
+	return true
+}
+
+func (e *AllianceEntity) GetMarks(key int) (AllianceMarkState, bool) {
+	var z AllianceMarkState
+	if e == nil || e.marks == nil {
+		return z, false
+	}
+	v, ok := e.marks[key]
+	if !ok || v == nil {
+		return z, false
+	}
+	return v.Save(), true
+}
+
+func (e *AllianceEntity) LenMarks() int {
+	if e == nil || e.marks == nil {
+		return 0
+	}
+	return len(e.marks)
+}
+
+func (e *AllianceEntity) ForEachMarks(fn func(key int, value AllianceMarkState)) {
+	if e == nil || e.marks == nil || fn == nil {
+		return
+	}
+	for k, v := range e.marks {
+		if v == nil {
+			continue
+		}
+		fn(k, v.Save())
+	}
+}
+
+func (e *AllianceEntity) RangeMarks(fn func(key int, value AllianceMarkState) bool) {
+	if e == nil || e.marks == nil || fn == nil {
+		return
+	}
+	for k, v := range e.marks {
+		if v == nil {
+			continue
+		}
+		if !fn(k, v.Save()) {
+			return
+		}
+	}
+}
+
+func (e *AllianceEntity) DirtyMarksKeys() []int {
+	if e == nil {
+		return nil
+	}
+	return e._dt.childDirtyKeys_marks()
+}
+
+func (e *AllianceEntity) ReplaceMarks(v map[int]AllianceMarkState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualMarks(e.snapshotMapMarks(e.marks), v) {
+		return false
+	}
+	e.marks = e.hydrateMapMarks(v)
+	e._dt.markFullReplace(FieldAlliance_marks)
+	return true
+}
+
+func (e *AllianceEntity) PutMarks(key int, value AllianceMarkState) bool {
+	if e == nil {
+		return false
+	}
+	if e.marks == nil {
+		e.marks = make(map[int]*AllianceMarkEntity)
+	}
+	e.marks[key] = HydrateAllianceMarkEntity(value)
+	e._dt.markMapSet(FieldAlliance_marks, fmt.Sprint(key), value)
+	e._dt.markChildDirty_marks(FieldAlliance_marks, key)
+	return true
+}
+
+func (e *AllianceEntity) PutMarksMany(entries map[int]AllianceMarkState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.marks == nil {
+		e.marks = make(map[int]*AllianceMarkEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		e.marks[k] = HydrateAllianceMarkEntity(v)
+		e._dt.markMapSet(FieldAlliance_marks, fmt.Sprint(k), v)
+		e._dt.markChildDirty_marks(FieldAlliance_marks, k)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) UpdateMarks(key int, fn func(value *AllianceMarkEntity)) bool {
+	if e == nil || fn == nil || e.marks == nil {
+		return false
+	}
+	v, ok := e.marks[key]
+	if !ok || v == nil {
+		return false
+	}
+	fn(v)
+	e._dt.markChildDirty_marks(FieldAlliance_marks, key)
+	return true
+}
+
+func (e *AllianceEntity) DelMarks(key int) bool {
+	if e == nil || e.marks == nil {
+		return false
+	}
+	if _, ok := e.marks[key]; !ok {
+		return false
+	}
+	delete(e.marks, key)
+	e._dt.markMapDelete(FieldAlliance_marks, fmt.Sprint(key))
+	e._dt.clearChildDirty_marks(key)
+	return true
+}
+
+func (e *AllianceEntity) DelMarksMany(keys []int) bool {
+	if e == nil || e.marks == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.marks[key]; !ok {
+			continue
+		}
+		delete(e.marks, key)
+		e._dt.markMapDelete(FieldAlliance_marks, fmt.Sprint(key))
+		e._dt.clearChildDirty_marks(key)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) ClearMarks() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.marks) == 0 {
+		return false
+	}
+	e.marks = nil
+	e._dt.markFullReplace(FieldAlliance_marks)
+	e._dt.childDirty_marks = nil
+	return true
+}
+
+func (e *AllianceEntity) GetRelations(key AllianceID) (RelationState, bool) {
+	var z RelationState
+	if e == nil || e.relations == nil {
+		return z, false
+	}
+	v, ok := e.relations[key]
+	if !ok || v == nil {
+		return z, false
+	}
+	return v.Save(), true
+}
+
+func (e *AllianceEntity) LenRelations() int {
+	if e == nil || e.relations == nil {
+		return 0
+	}
+	return len(e.relations)
+}
+
+func (e *AllianceEntity) ForEachRelations(fn func(key AllianceID, value RelationState)) {
+	if e == nil || e.relations == nil || fn == nil {
+		return
+	}
+	for k, v := range e.relations {
+		if v == nil {
+			continue
+		}
+		fn(k, v.Save())
+	}
+}
+
+func (e *AllianceEntity) RangeRelations(fn func(key AllianceID, value RelationState) bool) {
+	if e == nil || e.relations == nil || fn == nil {
+		return
+	}
+	for k, v := range e.relations {
+		if v == nil {
+			continue
+		}
+		if !fn(k, v.Save()) {
+			return
+		}
+	}
+}
+
+func (e *AllianceEntity) DirtyRelationsKeys() []AllianceID {
+	if e == nil {
+		return nil
+	}
+	return e._dt.childDirtyKeys_relations()
+}
+
+func (e *AllianceEntity) ReplaceRelations(v map[AllianceID]RelationState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualRelations(e.snapshotMapRelations(e.relations), v) {
+		return false
+	}
+	e.relations = e.hydrateMapRelations(v)
+	e._dt.markFullReplace(FieldAlliance_relations)
+	return true
+}
+
+func (e *AllianceEntity) PutRelations(key AllianceID, value RelationState) bool {
+	if e == nil {
+		return false
+	}
+	if e.relations == nil {
+		e.relations = make(map[AllianceID]*RelationEntity)
+	}
+	e.relations[key] = HydrateRelationEntity(value)
+	e._dt.markMapSet(FieldAlliance_relations, fmt.Sprint(key), value)
+	e._dt.markChildDirty_relations(FieldAlliance_relations, key)
+	return true
+}
+
+func (e *AllianceEntity) PutRelationsMany(entries map[AllianceID]RelationState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.relations == nil {
+		e.relations = make(map[AllianceID]*RelationEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		e.relations[k] = HydrateRelationEntity(v)
+		e._dt.markMapSet(FieldAlliance_relations, fmt.Sprint(k), v)
+		e._dt.markChildDirty_relations(FieldAlliance_relations, k)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) UpdateRelations(key AllianceID, fn func(value *RelationEntity)) bool {
+	if e == nil || fn == nil || e.relations == nil {
+		return false
+	}
+	v, ok := e.relations[key]
+	if !ok || v == nil {
+		return false
+	}
+	fn(v)
+	e._dt.markChildDirty_relations(FieldAlliance_relations, key)
+	return true
+}
+
+func (e *AllianceEntity) DelRelations(key AllianceID) bool {
+	if e == nil || e.relations == nil {
+		return false
+	}
+	if _, ok := e.relations[key]; !ok {
+		return false
+	}
+	delete(e.relations, key)
+	e._dt.markMapDelete(FieldAlliance_relations, fmt.Sprint(key))
+	e._dt.clearChildDirty_relations(key)
+	return true
+}
+
+func (e *AllianceEntity) DelRelationsMany(keys []AllianceID) bool {
+	if e == nil || e.relations == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.relations[key]; !ok {
+			continue
+		}
+		delete(e.relations, key)
+		e._dt.markMapDelete(FieldAlliance_relations, fmt.Sprint(key))
+		e._dt.clearChildDirty_relations(key)
+		changed = true
+	}
+	return changed
+}
+
+func (e *AllianceEntity) ClearRelations() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.relations) == 0 {
+		return false
+	}
+	e.relations = nil
+	e._dt.markFullReplace(FieldAlliance_relations)
+	e._dt.childDirty_relations = nil
+	return true
+}
+
+func (e *AllianceEntity) MemberCnt() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.memberCnt
+}
+
+func (e *AllianceEntity) SetMemberCnt(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.memberCnt == v {
+		return false
+	}
+	e.memberCnt = v
+	e._dt.mark(FieldAlliance_memberCnt)
+	return true
+}
+
+func (e *AllianceEntity) MemberLimit() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.memberLimit
+}
+
+func (e *AllianceEntity) SetMemberLimit(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.memberLimit == v {
+		return false
+	}
+	e.memberLimit = v
+	e._dt.mark(FieldAlliance_memberLimit)
+	return true
+}
+
+func (e *AllianceEntity) Power() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.power
+}
+
+func (e *AllianceEntity) SetPower(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.power == v {
+		return false
+	}
+	e.power = v
+	e._dt.mark(FieldAlliance_power)
+	return true
+}
+
+func (e *AllianceEntity) Territory() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.territory
+}
+
+func (e *AllianceEntity) SetTerritory(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.territory == v {
+		return false
+	}
+	e.territory = v
+	e._dt.mark(FieldAlliance_territory)
+	return true
+}
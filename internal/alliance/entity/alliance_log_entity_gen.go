@@ -0,0 +1,535 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	FieldAllianceLog_kind       Field = "kind"
+	FieldAllianceLog_opId       Field = "opId"
+	FieldAllianceLog_opName     Field = "opName"
+	FieldAllianceLog_targetId   Field = "targetId"
+	FieldAllianceLog_targetName Field = "targetName"
+	FieldAllianceLog_title      Field = "title"
+	FieldAllianceLog_x          Field = "x"
+	FieldAllianceLog_y          Field = "y"
+	FieldAllianceLog_ctime      Field = "ctime"
+)
+
+var emptyAllianceLogEntity = &AllianceLogEntity{}
+
+type AllianceLogEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type AllianceLogEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type AllianceLogEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*AllianceLogEntityCollectionChangeInner
+}
+
+func (t *AllianceLogEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *AllianceLogEntityTrace) ensureChange(f Field) *AllianceLogEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*AllianceLogEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &AllianceLogEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *AllianceLogEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *AllianceLogEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *AllianceLogEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *AllianceLogEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *AllianceLogEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *AllianceLogEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *AllianceLogEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type AllianceLogState struct {
+	Kind       int8
+	OpId       int
+	OpName     string
+	TargetId   int
+	TargetName string
+	Title      int8
+	X          int
+	Y          int
+	Ctime      time.Time
+}
+
+type AllianceLogEntitySnap struct {
+	Version     uint64
+	State       AllianceLogState
+	DirtyFields []Field
+	Changes     map[Field]AllianceLogEntityCollectionChange
+}
+
+type AllianceLogEntity struct {
+	kind       int8
+	opId       int
+	opName     string
+	targetId   int
+	targetName string
+	title      int8
+	x          int
+	y          int
+	ctime      time.Time
+	_dt        AllianceLogEntityTrace
+}
+
+func HydrateAllianceLogEntity(s AllianceLogState) *AllianceLogEntity {
+	return &AllianceLogEntity{
+		kind:       s.Kind,
+		opId:       s.OpId,
+		opName:     s.OpName,
+		targetId:   s.TargetId,
+		targetName: s.TargetName,
+		title:      s.Title,
+		x:          s.X,
+		y:          s.Y,
+		ctime:      s.Ctime,
+	}
+}
+
+func (e *AllianceLogEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *AllianceLogEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = AllianceLogEntityTrace{}
+}
+
+func (e *AllianceLogEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *AllianceLogEntity) DirtyChanges() map[Field]AllianceLogEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]AllianceLogEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := AllianceLogEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneAllianceLogEntityCollectionChange(in AllianceLogEntityCollectionChange) AllianceLogEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *AllianceLogEntity) Save() AllianceLogState {
+	var s AllianceLogState
+	if e == nil {
+		return s
+	}
+	s.Kind = e.kind
+	s.OpId = e.opId
+	s.OpName = e.opName
+	s.TargetId = e.targetId
+	s.TargetName = e.targetName
+	s.Title = e.title
+	s.X = e.x
+	s.Y = e.y
+	s.Ctime = e.ctime
+	return s
+}
+
+func NewAllianceLogEntitySnap(version uint64, e *AllianceLogEntity) *AllianceLogEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &AllianceLogEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *AllianceLogEntitySnap) Clone() *AllianceLogEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &AllianceLogEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]AllianceLogEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneAllianceLogEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *AllianceLogEntity) Kind() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.kind
+}
+
+func (e *AllianceLogEntity) SetKind(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.kind == v {
+		return false
+	}
+	e.kind = v
+	e._dt.mark(FieldAllianceLog_kind)
+	return true
+}
+
+func (e *AllianceLogEntity) OpId() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.opId
+}
+
+func (e *AllianceLogEntity) SetOpId(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.opId == v {
+		return false
+	}
+	e.opId = v
+	e._dt.mark(FieldAllianceLog_opId)
+	return true
+}
+
+func (e *AllianceLogEntity) OpName() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.opName
+}
+
+func (e *AllianceLogEntity) SetOpName(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.opName == v {
+		return false
+	}
+	e.opName = v
+	e._dt.mark(FieldAllianceLog_opName)
+	return true
+}
+
+func (e *AllianceLogEntity) TargetId() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.targetId
+}
+
+func (e *AllianceLogEntity) SetTargetId(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.targetId == v {
+		return false
+	}
+	e.targetId = v
+	e._dt.mark(FieldAllianceLog_targetId)
+	return true
+}
+
+func (e *AllianceLogEntity) TargetName() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.targetName
+}
+
+func (e *AllianceLogEntity) SetTargetName(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.targetName == v {
+		return false
+	}
+	e.targetName = v
+	e._dt.mark(FieldAllianceLog_targetName)
+	return true
+}
+
+func (e *AllianceLogEntity) Title() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.title
+}
+
+func (e *AllianceLogEntity) SetTitle(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.title == v {
+		return false
+	}
+	e.title = v
+	e._dt.mark(FieldAllianceLog_title)
+	return true
+}
+
+func (e *AllianceLogEntity) X() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.x
+}
+
+func (e *AllianceLogEntity) SetX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.x == v {
+		return false
+	}
+	e.x = v
+	e._dt.mark(FieldAllianceLog_x)
+	return true
+}
+
+func (e *AllianceLogEntity) Y() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.y
+}
+
+func (e *AllianceLogEntity) SetY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.y == v {
+		return false
+	}
+	e.y = v
+	e._dt.mark(FieldAllianceLog_y)
+	return true
+}
+
+func (e *AllianceLogEntity) Ctime() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.ctime
+}
+
+func (e *AllianceLogEntity) SetCtime(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.ctime.Equal(v) {
+		return false
+	}
+	e.ctime = v
+	e._dt.mark(FieldAllianceLog_ctime)
+	return true
+}
@@ -0,0 +1,485 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	FieldAllianceMark_id        Field = "id"
+	FieldAllianceMark_x         Field = "x"
+	FieldAllianceMark_y         Field = "y"
+	FieldAllianceMark_kind      Field = "kind"
+	FieldAllianceMark_label     Field = "label"
+	FieldAllianceMark_creatorId Field = "creatorId"
+	FieldAllianceMark_expire    Field = "expire"
+)
+
+var emptyAllianceMarkEntity = &AllianceMarkEntity{}
+
+type AllianceMarkEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type AllianceMarkEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type AllianceMarkEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*AllianceMarkEntityCollectionChangeInner
+}
+
+func (t *AllianceMarkEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *AllianceMarkEntityTrace) ensureChange(f Field) *AllianceMarkEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*AllianceMarkEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &AllianceMarkEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *AllianceMarkEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *AllianceMarkEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *AllianceMarkEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *AllianceMarkEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *AllianceMarkEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *AllianceMarkEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *AllianceMarkEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type AllianceMarkState struct {
+	Id        int
+	X         int
+	Y         int
+	Kind      int8
+	Label     string
+	CreatorId PlayerID
+	Expire    time.Time
+}
+
+type AllianceMarkEntitySnap struct {
+	Version     uint64
+	State       AllianceMarkState
+	DirtyFields []Field
+	Changes     map[Field]AllianceMarkEntityCollectionChange
+}
+
+type AllianceMarkEntity struct {
+	id        int
+	x         int
+	y         int
+	kind      int8
+	label     string
+	creatorId PlayerID
+	expire    time.Time
+	_dt       AllianceMarkEntityTrace
+}
+
+func HydrateAllianceMarkEntity(s AllianceMarkState) *AllianceMarkEntity {
+	return &AllianceMarkEntity{
+		id:        s.Id,
+		x:         s.X,
+		y:         s.Y,
+		kind:      s.Kind,
+		label:     s.Label,
+		creatorId: s.CreatorId,
+		expire:    s.Expire,
+	}
+}
+
+func (e *AllianceMarkEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *AllianceMarkEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = AllianceMarkEntityTrace{}
+}
+
+func (e *AllianceMarkEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *AllianceMarkEntity) DirtyChanges() map[Field]AllianceMarkEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]AllianceMarkEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := AllianceMarkEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneAllianceMarkEntityCollectionChange(in AllianceMarkEntityCollectionChange) AllianceMarkEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *AllianceMarkEntity) Save() AllianceMarkState {
+	var s AllianceMarkState
+	if e == nil {
+		return s
+	}
+	s.Id = e.id
+	s.X = e.x
+	s.Y = e.y
+	s.Kind = e.kind
+	s.Label = e.label
+	s.CreatorId = e.creatorId
+	s.Expire = e.expire
+	return s
+}
+
+func NewAllianceMarkEntitySnap(version uint64, e *AllianceMarkEntity) *AllianceMarkEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &AllianceMarkEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *AllianceMarkEntitySnap) Clone() *AllianceMarkEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &AllianceMarkEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]AllianceMarkEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneAllianceMarkEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *AllianceMarkEntity) Id() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.id
+}
+
+func (e *AllianceMarkEntity) SetId(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.id == v {
+		return false
+	}
+	e.id = v
+	e._dt.mark(FieldAllianceMark_id)
+	return true
+}
+
+func (e *AllianceMarkEntity) X() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.x
+}
+
+func (e *AllianceMarkEntity) SetX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.x == v {
+		return false
+	}
+	e.x = v
+	e._dt.mark(FieldAllianceMark_x)
+	return true
+}
+
+func (e *AllianceMarkEntity) Y() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.y
+}
+
+func (e *AllianceMarkEntity) SetY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.y == v {
+		return false
+	}
+	e.y = v
+	e._dt.mark(FieldAllianceMark_y)
+	return true
+}
+
+func (e *AllianceMarkEntity) Kind() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.kind
+}
+
+func (e *AllianceMarkEntity) SetKind(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.kind == v {
+		return false
+	}
+	e.kind = v
+	e._dt.mark(FieldAllianceMark_kind)
+	return true
+}
+
+func (e *AllianceMarkEntity) Label() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.label
+}
+
+func (e *AllianceMarkEntity) SetLabel(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.label == v {
+		return false
+	}
+	e.label = v
+	e._dt.mark(FieldAllianceMark_label)
+	return true
+}
+
+func (e *AllianceMarkEntity) CreatorId() PlayerID {
+	if e == nil {
+		var z PlayerID
+		return z
+	}
+	return e.creatorId
+}
+
+func (e *AllianceMarkEntity) SetCreatorId(v PlayerID) bool {
+	if e == nil {
+		return false
+	}
+	if e.creatorId == v {
+		return false
+	}
+	e.creatorId = v
+	e._dt.mark(FieldAllianceMark_creatorId)
+	return true
+}
+
+func (e *AllianceMarkEntity) Expire() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.expire
+}
+
+func (e *AllianceMarkEntity) SetExpire(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.expire.Equal(v) {
+		return false
+	}
+	e.expire = v
+	e._dt.mark(FieldAllianceMark_expire)
+	return true
+}
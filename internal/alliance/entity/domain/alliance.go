@@ -15,4 +15,14 @@ type Alliance struct {
 	majors    map[PlayerID]*Major // 联盟主要人物，盟主副盟主
 	members   map[PlayerID]*Member
 	applyList []*ApplyItem
+	logs      []*AllianceLog           // 联盟动态，只保留最近 union.log_limit 条
+	treasury  int                      // 联盟资金，来自附庸上供
+	buildings map[int]*SysBuilding     // 占领的系统城市和要塞，key 为格子下标
+	marks     map[int]*AllianceMark    // 地图标记，key 为标记 id
+	relations map[AllianceID]*Relation // 外交关系，key 为对方联盟 id
+	// 以下为联盟列表用的冗余字段，提交时刷新，便于按字段建索引查询
+	memberCnt   int // 成员数
+	memberLimit int // 成员上限，含系统建筑加成
+	power       int // 成员战力合计
+	territory   int // 占领的系统建筑数
 }
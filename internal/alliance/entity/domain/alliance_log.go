@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// entity
+type AllianceLog struct {
+	kind       int8 // 动态类型，见 messages.AllianceLogKind
+	opId       int  // 操作人，系统事件为 0
+	opName     string
+	targetId   int
+	targetName string
+	title      int8 // 任免时的新职位
+	x          int  // 领地事件坐标
+	y          int
+	ctime      time.Time
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// 联盟地图标记，盟主和副盟主标记的进攻、防守、集合目标
+// entity
+type AllianceMark struct {
+	id        int
+	x         int
+	y         int
+	kind      int8 // 标记类型 0 进攻 1 防守 2 集合
+	label     string
+	creatorId PlayerID
+	expire    time.Time // 到期自动清除
+}
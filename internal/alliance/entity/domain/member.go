@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 // entity
 type Member struct {
-	id    PlayerID
-	name  string
-	title int8
-	pos   Pos
+	id         PlayerID
+	name       string
+	title      int8
+	pos        Pos
+	lastActive time.Time // 最近活跃时间，用于盟主自动让位
+	power      int       // 战力，成员上线时上报
 }
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// 和其他联盟的外交关系，过了 until 视为中立
+// entity
+type Relation struct {
+	allianceId AllianceID
+	name       string
+	state      int8 // 外交状态，见 messages.DiplomacyState
+	until      time.Time
+	proposal   int8 // 待确认的提议，0 表示没有
+	incoming   bool // 提议是否由对方发起
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// entity
+type SysBuilding struct {
+	pos        int // 格子下标
+	x          int
+	y          int
+	kind       int8 // 系统城市或系统要塞
+	level      int8
+	occupyTime time.Time
+}
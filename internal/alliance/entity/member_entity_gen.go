@@ -3,13 +3,16 @@ package entity
 
 import (
 	"sort"
+	"time"
 )
 
 const (
-	FieldMember_id    Field = "id"
-	FieldMember_name  Field = "name"
-	FieldMember_title Field = "title"
-	FieldMember_pos   Field = "pos"
+	FieldMember_id         Field = "id"
+	FieldMember_name       Field = "name"
+	FieldMember_title      Field = "title"
+	FieldMember_pos        Field = "pos"
+	FieldMember_lastActive Field = "lastActive"
+	FieldMember_power      Field = "power"
 )
 
 var emptyMemberEntity = &MemberEntity{}
@@ -145,10 +148,12 @@ func (t *MemberEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
 }
 
 type MemberState struct {
-	Id    PlayerID
-	Name  string
-	Title int8
-	Pos   PosState
+	Id         PlayerID
+	Name       string
+	Title      int8
+	Pos        PosState
+	LastActive time.Time
+	Power      int
 }
 
 type MemberEntitySnap struct {
@@ -159,19 +164,23 @@ type MemberEntitySnap struct {
 }
 
 type MemberEntity struct {
-	id    PlayerID
-	name  string
-	title int8
-	pos   *PosEntity
-	_dt   MemberEntityTrace
+	id         PlayerID
+	name       string
+	title      int8
+	pos        *PosEntity
+	lastActive time.Time
+	power      int
+	_dt        MemberEntityTrace
 }
 
 func HydrateMemberEntity(s MemberState) *MemberEntity {
 	return &MemberEntity{
-		id:    s.Id,
-		name:  s.Name,
-		title: s.Title,
-		pos:   HydratePosEntity(s.Pos),
+		id:         s.Id,
+		name:       s.Name,
+		title:      s.Title,
+		pos:        HydratePosEntity(s.Pos),
+		lastActive: s.LastActive,
+		power:      s.Power,
 	}
 }
 
@@ -309,6 +318,8 @@ func (e *MemberEntity) Save() MemberState {
 		var z PosState
 		s.Pos = z
 	}
+	s.LastActive = e.lastActive
+	s.Power = e.power
 	return s
 }
 
@@ -445,3 +456,43 @@ func (e *MemberEntity) UpdatePos(fn func(value *PosEntity)) bool {
 	e._dt.mark(FieldMember_pos)
 	return true
 }
+
+func (e *MemberEntity) LastActive() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.lastActive
+}
+
+func (e *MemberEntity) SetLastActive(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.lastActive.Equal(v) {
+		return false
+	}
+	e.lastActive = v
+	e._dt.mark(FieldMember_lastActive)
+	return true
+}
+
+func (e *MemberEntity) Power() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.power
+}
+
+func (e *MemberEntity) SetPower(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.power == v {
+		return false
+	}
+	e.power = v
+	e._dt.mark(FieldMember_power)
+	return true
+}
@@ -0,0 +1,460 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	FieldRelation_allianceId Field = "allianceId"
+	FieldRelation_name       Field = "name"
+	FieldRelation_state      Field = "state"
+	FieldRelation_until      Field = "until"
+	FieldRelation_proposal   Field = "proposal"
+	FieldRelation_incoming   Field = "incoming"
+)
+
+var emptyRelationEntity = &RelationEntity{}
+
+type RelationEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type RelationEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type RelationEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*RelationEntityCollectionChangeInner
+}
+
+func (t *RelationEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *RelationEntityTrace) ensureChange(f Field) *RelationEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*RelationEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &RelationEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *RelationEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *RelationEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *RelationEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *RelationEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *RelationEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *RelationEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *RelationEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type RelationState struct {
+	AllianceId AllianceID
+	Name       string
+	State      int8
+	Until      time.Time
+	Proposal   int8
+	Incoming   bool
+}
+
+type RelationEntitySnap struct {
+	Version     uint64
+	State       RelationState
+	DirtyFields []Field
+	Changes     map[Field]RelationEntityCollectionChange
+}
+
+type RelationEntity struct {
+	allianceId AllianceID
+	name       string
+	state      int8
+	until      time.Time
+	proposal   int8
+	incoming   bool
+	_dt        RelationEntityTrace
+}
+
+func HydrateRelationEntity(s RelationState) *RelationEntity {
+	return &RelationEntity{
+		allianceId: s.AllianceId,
+		name:       s.Name,
+		state:      s.State,
+		until:      s.Until,
+		proposal:   s.Proposal,
+		incoming:   s.Incoming,
+	}
+}
+
+func (e *RelationEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *RelationEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = RelationEntityTrace{}
+}
+
+func (e *RelationEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *RelationEntity) DirtyChanges() map[Field]RelationEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]RelationEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := RelationEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneRelationEntityCollectionChange(in RelationEntityCollectionChange) RelationEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *RelationEntity) Save() RelationState {
+	var s RelationState
+	if e == nil {
+		return s
+	}
+	s.AllianceId = e.allianceId
+	s.Name = e.name
+	s.State = e.state
+	s.Until = e.until
+	s.Proposal = e.proposal
+	s.Incoming = e.incoming
+	return s
+}
+
+func NewRelationEntitySnap(version uint64, e *RelationEntity) *RelationEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &RelationEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *RelationEntitySnap) Clone() *RelationEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &RelationEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]RelationEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneRelationEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *RelationEntity) AllianceId() AllianceID {
+	if e == nil {
+		var z AllianceID
+		return z
+	}
+	return e.allianceId
+}
+
+func (e *RelationEntity) SetAllianceId(v AllianceID) bool {
+	if e == nil {
+		return false
+	}
+	if e.allianceId == v {
+		return false
+	}
+	e.allianceId = v
+	e._dt.mark(FieldRelation_allianceId)
+	return true
+}
+
+func (e *RelationEntity) Name() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.name
+}
+
+func (e *RelationEntity) SetName(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.name == v {
+		return false
+	}
+	e.name = v
+	e._dt.mark(FieldRelation_name)
+	return true
+}
+
+func (e *RelationEntity) State() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.state
+}
+
+func (e *RelationEntity) SetState(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.state == v {
+		return false
+	}
+	e.state = v
+	e._dt.mark(FieldRelation_state)
+	return true
+}
+
+func (e *RelationEntity) Until() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.until
+}
+
+func (e *RelationEntity) SetUntil(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.until.Equal(v) {
+		return false
+	}
+	e.until = v
+	e._dt.mark(FieldRelation_until)
+	return true
+}
+
+func (e *RelationEntity) Proposal() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.proposal
+}
+
+func (e *RelationEntity) SetProposal(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.proposal == v {
+		return false
+	}
+	e.proposal = v
+	e._dt.mark(FieldRelation_proposal)
+	return true
+}
+
+func (e *RelationEntity) Incoming() bool {
+	if e == nil {
+		var z bool
+		return z
+	}
+	return e.incoming
+}
+
+func (e *RelationEntity) SetIncoming(v bool) bool {
+	if e == nil {
+		return false
+	}
+	if e.incoming == v {
+		return false
+	}
+	e.incoming = v
+	e._dt.mark(FieldRelation_incoming)
+	return true
+}
@@ -0,0 +1,460 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	FieldSysBuilding_pos        Field = "pos"
+	FieldSysBuilding_x          Field = "x"
+	FieldSysBuilding_y          Field = "y"
+	FieldSysBuilding_kind       Field = "kind"
+	FieldSysBuilding_level      Field = "level"
+	FieldSysBuilding_occupyTime Field = "occupyTime"
+)
+
+var emptySysBuildingEntity = &SysBuildingEntity{}
+
+type SysBuildingEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type SysBuildingEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type SysBuildingEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*SysBuildingEntityCollectionChangeInner
+}
+
+func (t *SysBuildingEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *SysBuildingEntityTrace) ensureChange(f Field) *SysBuildingEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*SysBuildingEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &SysBuildingEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *SysBuildingEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *SysBuildingEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *SysBuildingEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *SysBuildingEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *SysBuildingEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *SysBuildingEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *SysBuildingEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type SysBuildingState struct {
+	Pos        int
+	X          int
+	Y          int
+	Kind       int8
+	Level      int8
+	OccupyTime time.Time
+}
+
+type SysBuildingEntitySnap struct {
+	Version     uint64
+	State       SysBuildingState
+	DirtyFields []Field
+	Changes     map[Field]SysBuildingEntityCollectionChange
+}
+
+type SysBuildingEntity struct {
+	pos        int
+	x          int
+	y          int
+	kind       int8
+	level      int8
+	occupyTime time.Time
+	_dt        SysBuildingEntityTrace
+}
+
+func HydrateSysBuildingEntity(s SysBuildingState) *SysBuildingEntity {
+	return &SysBuildingEntity{
+		pos:        s.Pos,
+		x:          s.X,
+		y:          s.Y,
+		kind:       s.Kind,
+		level:      s.Level,
+		occupyTime: s.OccupyTime,
+	}
+}
+
+func (e *SysBuildingEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *SysBuildingEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = SysBuildingEntityTrace{}
+}
+
+func (e *SysBuildingEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *SysBuildingEntity) DirtyChanges() map[Field]SysBuildingEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]SysBuildingEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := SysBuildingEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneSysBuildingEntityCollectionChange(in SysBuildingEntityCollectionChange) SysBuildingEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *SysBuildingEntity) Save() SysBuildingState {
+	var s SysBuildingState
+	if e == nil {
+		return s
+	}
+	s.Pos = e.pos
+	s.X = e.x
+	s.Y = e.y
+	s.Kind = e.kind
+	s.Level = e.level
+	s.OccupyTime = e.occupyTime
+	return s
+}
+
+func NewSysBuildingEntitySnap(version uint64, e *SysBuildingEntity) *SysBuildingEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &SysBuildingEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *SysBuildingEntitySnap) Clone() *SysBuildingEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &SysBuildingEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]SysBuildingEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneSysBuildingEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *SysBuildingEntity) Pos() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.pos
+}
+
+func (e *SysBuildingEntity) SetPos(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.pos == v {
+		return false
+	}
+	e.pos = v
+	e._dt.mark(FieldSysBuilding_pos)
+	return true
+}
+
+func (e *SysBuildingEntity) X() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.x
+}
+
+func (e *SysBuildingEntity) SetX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.x == v {
+		return false
+	}
+	e.x = v
+	e._dt.mark(FieldSysBuilding_x)
+	return true
+}
+
+func (e *SysBuildingEntity) Y() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.y
+}
+
+func (e *SysBuildingEntity) SetY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.y == v {
+		return false
+	}
+	e.y = v
+	e._dt.mark(FieldSysBuilding_y)
+	return true
+}
+
+func (e *SysBuildingEntity) Kind() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.kind
+}
+
+func (e *SysBuildingEntity) SetKind(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.kind == v {
+		return false
+	}
+	e.kind = v
+	e._dt.mark(FieldSysBuilding_kind)
+	return true
+}
+
+func (e *SysBuildingEntity) Level() int8 {
+	if e == nil {
+		var z int8
+		return z
+	}
+	return e.level
+}
+
+func (e *SysBuildingEntity) SetLevel(v int8) bool {
+	if e == nil {
+		return false
+	}
+	if e.level == v {
+		return false
+	}
+	e.level = v
+	e._dt.mark(FieldSysBuilding_level)
+	return true
+}
+
+func (e *SysBuildingEntity) OccupyTime() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.occupyTime
+}
+
+func (e *SysBuildingEntity) SetOccupyTime(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.occupyTime.Equal(v) {
+		return false
+	}
+	e.occupyTime = v
+	e._dt.mark(FieldSysBuilding_occupyTime)
+	return true
+}
@@ -6,13 +6,22 @@ import (
 )
 
 type AllianceDoc struct {
-	Id        AllianceID             `bson:"id"`
-	WorldId   WorldID                `bson:"world_id"`
-	Name      string                 `bson:"name"`
-	Notice    string                 `bson:"notice"`
-	Majors    map[PlayerID]MajorDoc  `bson:"majors"`
-	Members   map[PlayerID]MemberDoc `bson:"members"`
-	ApplyList []ApplyItemDoc         `bson:"apply_list"`
+	Id          AllianceID                 `bson:"id"`
+	WorldId     WorldID                    `bson:"world_id"`
+	Name        string                     `bson:"name"`
+	Notice      string                     `bson:"notice"`
+	Majors      map[PlayerID]MajorDoc      `bson:"majors"`
+	Members     map[PlayerID]MemberDoc     `bson:"members"`
+	ApplyList   []ApplyItemDoc             `bson:"apply_list"`
+	Logs        []AllianceLogDoc           `bson:"logs"`
+	Treasury    int                        `bson:"treasury"`
+	Buildings   map[int]SysBuildingDoc     `bson:"buildings"`
+	Marks       map[int]AllianceMarkDoc    `bson:"marks"`
+	Relations   map[AllianceID]RelationDoc `bson:"relations"`
+	MemberCnt   int                        `bson:"member_cnt"`
+	MemberLimit int                        `bson:"member_limit"`
+	Power       int                        `bson:"power"`
+	Territory   int                        `bson:"territory"`
 }
 
 func toDocMap_majors(in map[PlayerID]entity.MajorState) map[PlayerID]MajorDoc {
@@ -81,28 +90,134 @@ func toStateSlice_applyList(in []ApplyItemDoc) []entity.ApplyItemState {
 	return out
 }
 
+func toDocSlice_logs(in []entity.AllianceLogState) []AllianceLogDoc {
+	if in == nil {
+		return nil
+	}
+	out := make([]AllianceLogDoc, len(in))
+	for i, v := range in {
+		out[i] = AllianceLogStateToDoc(v)
+	}
+	return out
+}
+
+func toStateSlice_logs(in []AllianceLogDoc) []entity.AllianceLogState {
+	if in == nil {
+		return nil
+	}
+	out := make([]entity.AllianceLogState, len(in))
+	for i, v := range in {
+		out[i] = AllianceLogDocToState(v)
+	}
+	return out
+}
+
+func toDocMap_buildings(in map[int]entity.SysBuildingState) map[int]SysBuildingDoc {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]SysBuildingDoc, len(in))
+	for k, v := range in {
+		out[k] = SysBuildingStateToDoc(v)
+	}
+	return out
+}
+
+func toStateMap_buildings(in map[int]SysBuildingDoc) map[int]entity.SysBuildingState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]entity.SysBuildingState, len(in))
+	for k, v := range in {
+		out[k] = SysBuildingDocToState(v)
+	}
+	return out
+}
+
+func toDocMap_marks(in map[int]entity.AllianceMarkState) map[int]AllianceMarkDoc {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]AllianceMarkDoc, len(in))
+	for k, v := range in {
+		out[k] = AllianceMarkStateToDoc(v)
+	}
+	return out
+}
+
+func toStateMap_marks(in map[int]AllianceMarkDoc) map[int]entity.AllianceMarkState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]entity.AllianceMarkState, len(in))
+	for k, v := range in {
+		out[k] = AllianceMarkDocToState(v)
+	}
+	return out
+}
+
+func toDocMap_relations(in map[AllianceID]entity.RelationState) map[AllianceID]RelationDoc {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]RelationDoc, len(in))
+	for k, v := range in {
+		out[k] = RelationStateToDoc(v)
+	}
+	return out
+}
+
+func toStateMap_relations(in map[AllianceID]RelationDoc) map[AllianceID]entity.RelationState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]entity.RelationState, len(in))
+	for k, v := range in {
+		out[k] = RelationDocToState(v)
+	}
+	return out
+}
+
 func AllianceStateToDoc(s entity.AllianceState) AllianceDoc {
 	state := entity.HydrateAllianceEntity(s).Save()
 	return AllianceDoc{
-		Id:        state.Id,
-		WorldId:   state.WorldId,
-		Name:      state.Name,
-		Notice:    state.Notice,
-		Majors:    toDocMap_majors(state.Majors),
-		Members:   toDocMap_members(state.Members),
-		ApplyList: toDocSlice_applyList(state.ApplyList),
+		Id:          state.Id,
+		WorldId:     state.WorldId,
+		Name:        state.Name,
+		Notice:      state.Notice,
+		Majors:      toDocMap_majors(state.Majors),
+		Members:     toDocMap_members(state.Members),
+		ApplyList:   toDocSlice_applyList(state.ApplyList),
+		Logs:        toDocSlice_logs(state.Logs),
+		Treasury:    state.Treasury,
+		Buildings:   toDocMap_buildings(state.Buildings),
+		Marks:       toDocMap_marks(state.Marks),
+		Relations:   toDocMap_relations(state.Relations),
+		MemberCnt:   state.MemberCnt,
+		MemberLimit: state.MemberLimit,
+		Power:       state.Power,
+		Territory:   state.Territory,
 	}
 }
 
 func AllianceDocToState(d AllianceDoc) entity.AllianceState {
 	state := entity.AllianceState{
-		Id:        d.Id,
-		WorldId:   d.WorldId,
-		Name:      d.Name,
-		Notice:    d.Notice,
-		Majors:    toStateMap_majors(d.Majors),
-		Members:   toStateMap_members(d.Members),
-		ApplyList: toStateSlice_applyList(d.ApplyList),
+		Id:          d.Id,
+		WorldId:     d.WorldId,
+		Name:        d.Name,
+		Notice:      d.Notice,
+		Majors:      toStateMap_majors(d.Majors),
+		Members:     toStateMap_members(d.Members),
+		ApplyList:   toStateSlice_applyList(d.ApplyList),
+		Logs:        toStateSlice_logs(d.Logs),
+		Treasury:    d.Treasury,
+		Buildings:   toStateMap_buildings(d.Buildings),
+		Marks:       toStateMap_marks(d.Marks),
+		Relations:   toStateMap_relations(d.Relations),
+		MemberCnt:   d.MemberCnt,
+		MemberLimit: d.MemberLimit,
+		Power:       d.Power,
+		Territory:   d.Territory,
 	}
 	return entity.HydrateAllianceEntity(state).Save()
 }
@@ -0,0 +1,49 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/alliance/entity"
+	"time"
+)
+
+type AllianceLogDoc struct {
+	Kind       int8      `bson:"kind"`
+	OpId       int       `bson:"op_id"`
+	OpName     string    `bson:"op_name"`
+	TargetId   int       `bson:"target_id"`
+	TargetName string    `bson:"target_name"`
+	Title      int8      `bson:"title"`
+	X          int       `bson:"x"`
+	Y          int       `bson:"y"`
+	Ctime      time.Time `bson:"ctime"`
+}
+
+func AllianceLogStateToDoc(s entity.AllianceLogState) AllianceLogDoc {
+	state := entity.HydrateAllianceLogEntity(s).Save()
+	return AllianceLogDoc{
+		Kind:       state.Kind,
+		OpId:       state.OpId,
+		OpName:     state.OpName,
+		TargetId:   state.TargetId,
+		TargetName: state.TargetName,
+		Title:      state.Title,
+		X:          state.X,
+		Y:          state.Y,
+		Ctime:      state.Ctime,
+	}
+}
+
+func AllianceLogDocToState(d AllianceLogDoc) entity.AllianceLogState {
+	state := entity.AllianceLogState{
+		Kind:       d.Kind,
+		OpId:       d.OpId,
+		OpName:     d.OpName,
+		TargetId:   d.TargetId,
+		TargetName: d.TargetName,
+		Title:      d.Title,
+		X:          d.X,
+		Y:          d.Y,
+		Ctime:      d.Ctime,
+	}
+	return entity.HydrateAllianceLogEntity(state).Save()
+}
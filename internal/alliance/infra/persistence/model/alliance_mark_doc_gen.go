@@ -0,0 +1,43 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/alliance/entity"
+	"time"
+)
+
+type AllianceMarkDoc struct {
+	Id        int       `bson:"id"`
+	X         int       `bson:"x"`
+	Y         int       `bson:"y"`
+	Kind      int8      `bson:"kind"`
+	Label     string    `bson:"label"`
+	CreatorId PlayerID  `bson:"creator_id"`
+	Expire    time.Time `bson:"expire"`
+}
+
+func AllianceMarkStateToDoc(s entity.AllianceMarkState) AllianceMarkDoc {
+	state := entity.HydrateAllianceMarkEntity(s).Save()
+	return AllianceMarkDoc{
+		Id:        state.Id,
+		X:         state.X,
+		Y:         state.Y,
+		Kind:      state.Kind,
+		Label:     state.Label,
+		CreatorId: state.CreatorId,
+		Expire:    state.Expire,
+	}
+}
+
+func AllianceMarkDocToState(d AllianceMarkDoc) entity.AllianceMarkState {
+	state := entity.AllianceMarkState{
+		Id:        d.Id,
+		X:         d.X,
+		Y:         d.Y,
+		Kind:      d.Kind,
+		Label:     d.Label,
+		CreatorId: d.CreatorId,
+		Expire:    d.Expire,
+	}
+	return entity.HydrateAllianceMarkEntity(state).Save()
+}
@@ -3,31 +3,38 @@ package model
 
 import (
 	entity "ThreeKingdoms/internal/alliance/entity"
+	"time"
 )
 
 type MemberDoc struct {
-	Id    PlayerID `bson:"id"`
-	Name  string   `bson:"name"`
-	Title int8     `bson:"title"`
-	Pos   PosDoc   `bson:"pos"`
+	Id         PlayerID  `bson:"id"`
+	Name       string    `bson:"name"`
+	Title      int8      `bson:"title"`
+	Pos        PosDoc    `bson:"pos"`
+	LastActive time.Time `bson:"last_active"`
+	Power      int       `bson:"power"`
 }
 
 func MemberStateToDoc(s entity.MemberState) MemberDoc {
 	state := entity.HydrateMemberEntity(s).Save()
 	return MemberDoc{
-		Id:    state.Id,
-		Name:  state.Name,
-		Title: state.Title,
-		Pos:   PosStateToDoc(state.Pos),
+		Id:         state.Id,
+		Name:       state.Name,
+		Title:      state.Title,
+		Pos:        PosStateToDoc(state.Pos),
+		LastActive: state.LastActive,
+		Power:      state.Power,
 	}
 }
 
 func MemberDocToState(d MemberDoc) entity.MemberState {
 	state := entity.MemberState{
-		Id:    d.Id,
-		Name:  d.Name,
-		Title: d.Title,
-		Pos:   PosDocToState(d.Pos),
+		Id:         d.Id,
+		Name:       d.Name,
+		Title:      d.Title,
+		Pos:        PosDocToState(d.Pos),
+		LastActive: d.LastActive,
+		Power:      d.Power,
 	}
 	return entity.HydrateMemberEntity(state).Save()
 }
@@ -0,0 +1,40 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/alliance/entity"
+	"time"
+)
+
+type RelationDoc struct {
+	AllianceId AllianceID `bson:"alliance_id"`
+	Name       string     `bson:"name"`
+	State      int8       `bson:"state"`
+	Until      time.Time  `bson:"until"`
+	Proposal   int8       `bson:"proposal"`
+	Incoming   bool       `bson:"incoming"`
+}
+
+func RelationStateToDoc(s entity.RelationState) RelationDoc {
+	state := entity.HydrateRelationEntity(s).Save()
+	return RelationDoc{
+		AllianceId: state.AllianceId,
+		Name:       state.Name,
+		State:      state.State,
+		Until:      state.Until,
+		Proposal:   state.Proposal,
+		Incoming:   state.Incoming,
+	}
+}
+
+func RelationDocToState(d RelationDoc) entity.RelationState {
+	state := entity.RelationState{
+		AllianceId: d.AllianceId,
+		Name:       d.Name,
+		State:      d.State,
+		Until:      d.Until,
+		Proposal:   d.Proposal,
+		Incoming:   d.Incoming,
+	}
+	return entity.HydrateRelationEntity(state).Save()
+}
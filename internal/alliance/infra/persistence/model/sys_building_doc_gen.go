@@ -0,0 +1,40 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/alliance/entity"
+	"time"
+)
+
+type SysBuildingDoc struct {
+	Pos        int       `bson:"pos"`
+	X          int       `bson:"x"`
+	Y          int       `bson:"y"`
+	Kind       int8      `bson:"kind"`
+	Level      int8      `bson:"level"`
+	OccupyTime time.Time `bson:"occupy_time"`
+}
+
+func SysBuildingStateToDoc(s entity.SysBuildingState) SysBuildingDoc {
+	state := entity.HydrateSysBuildingEntity(s).Save()
+	return SysBuildingDoc{
+		Pos:        state.Pos,
+		X:          state.X,
+		Y:          state.Y,
+		Kind:       state.Kind,
+		Level:      state.Level,
+		OccupyTime: state.OccupyTime,
+	}
+}
+
+func SysBuildingDocToState(d SysBuildingDoc) entity.SysBuildingState {
+	state := entity.SysBuildingState{
+		Pos:        d.Pos,
+		X:          d.X,
+		Y:          d.Y,
+		Kind:       d.Kind,
+		Level:      d.Level,
+		OccupyTime: d.OccupyTime,
+	}
+	return entity.HydrateSysBuildingEntity(state).Save()
+}
@@ -11,15 +11,22 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
-const defaultCollectionName = "alliance"
+const (
+	defaultCollectionName = "alliance"
+	seqCollectionName     = "alliance_seq"
+	// allianceSeqID 联盟 id 计数器所在文档
+	allianceSeqID = "alliance_id"
+)
 
 type AllianceRepository struct {
 	coll *mongo.Collection
+	seq  *mongo.Collection
 }
 
 func NewAllianceRepository(db *mongo.Database) *AllianceRepository {
 	return &AllianceRepository{
 		coll: db.Collection(defaultCollectionName),
+		seq:  db.Collection(seqCollectionName),
 	}
 }
 
@@ -48,7 +55,9 @@ func (r *AllianceRepository) ListAllianceSummaryByWorld(ctx context.Context, wor
 	if r == nil || r.coll == nil {
 		return nil, errors.New("mongodb alliance collection is nil")
 	}
-	cur, err := r.coll.Find(ctx, bson.M{"world_id": worldID})
+	// 列表只需要摘要字段，动态、申请列表和地图标记不读取
+	opts := options.Find().SetProjection(bson.M{"logs": 0, "apply_list": 0, "marks": 0, "relations": 0})
+	cur, err := r.coll.Find(ctx, bson.M{"world_id": worldID}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +77,20 @@ func (r *AllianceRepository) ListAllianceSummaryByWorld(ctx context.Context, wor
 	return out, nil
 }
 
+// EnsureIndexes 创建联盟列表查询用的索引，已存在时不会重复创建
+func (r *AllianceRepository) EnsureIndexes(ctx context.Context) error {
+	if r == nil || r.coll == nil {
+		return errors.New("mongodb alliance collection is nil")
+	}
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "world_id", Value: 1}, {Key: "name", Value: 1}}},
+		{Keys: bson.D{{Key: "world_id", Value: 1}, {Key: "member_cnt", Value: -1}}},
+		{Keys: bson.D{{Key: "world_id", Value: 1}, {Key: "territory", Value: -1}}},
+		{Keys: bson.D{{Key: "world_id", Value: 1}, {Key: "power", Value: -1}}},
+	})
+	return err
+}
+
 func (r *AllianceRepository) Save(ctx context.Context, s *entity.AllianceEntitySnap) error {
 	if s == nil {
 		return nil
@@ -86,3 +109,31 @@ func (r *AllianceRepository) Save(ctx context.Context, s *entity.AllianceEntityS
 	)
 	return err
 }
+
+func (r *AllianceRepository) DeleteAlliance(ctx context.Context, allianceID entity.AllianceID) error {
+	if r == nil || r.coll == nil {
+		return errors.New("mongodb alliance collection is nil")
+	}
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": allianceID})
+	return err
+}
+
+// NextAllianceID 计数器先抬到 floor 再加一，兼容计数器上线前已经存在的联盟
+func (r *AllianceRepository) NextAllianceID(ctx context.Context, floor entity.AllianceID) (entity.AllianceID, error) {
+	if r == nil || r.seq == nil {
+		return 0, errors.New("mongodb alliance seq collection is nil")
+	}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"seq": bson.M{"$add": bson.A{bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$seq", 0}}, int(floor)}}, 1}},
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var doc struct {
+		Seq int `bson:"seq"`
+	}
+	if err := r.seq.FindOneAndUpdate(ctx, bson.M{"_id": allianceSeqID}, update, opts).Decode(&doc); err != nil {
+		return 0, err
+	}
+	return entity.AllianceID(doc.Seq), nil
+}
@@ -9,4 +9,7 @@ type AllianceRepository interface {
 	LoadAlliance(ctx context.Context, allianceID entity.AllianceID) (*entity.AllianceEntity, error)
 	ListAllianceSummaryByWorld(ctx context.Context, worldID entity.WorldID) ([]entity.AllianceState, error)
 	Save(ctx context.Context, s *entity.AllianceEntitySnap) error
+	DeleteAlliance(ctx context.Context, allianceID entity.AllianceID) error
+	// NextAllianceID 分配新的联盟 id，只增不减，解散的 id 不会被复用。floor 为当前已知的最大 id
+	NextAllianceID(ctx context.Context, floor entity.AllianceID) (entity.AllianceID, error)
 }
@@ -6,6 +6,12 @@ import (
 	commonpb "ThreeKingdoms/internal/shared/gen/common"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
 	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type GateService struct {
@@ -15,6 +21,18 @@ type GateService struct {
 
 const defaultPlayerWorldID int64 = 1
 
+// internalSeq gate 自己发起的玩家请求用的 seq。玩家 actor 只收正数且按窗口去重，
+// 从启动时的纳秒时间往上递增，和客户端从 1 开始的 seq 错开
+var internalSeq atomic.Int64
+
+func init() {
+	internalSeq.Store(time.Now().UnixNano())
+}
+
+func nextInternalSeq() int64 {
+	return internalSeq.Add(1)
+}
+
 func checkBizResult(result *commonpb.BizResult) error {
 	if result == nil {
 		return ErrInternalServer.WithReason(ReasonUpstreamBadResponse)
@@ -86,6 +104,30 @@ func (g *GateService) Register(ctx context.Context, registerReqDTO model.Registe
 	return nil
 }
 
+// Logout 会话结束后记录登出：账号的最后登录记录和角色的登出时间，两边互不影响
+func (g *GateService) Logout(ctx context.Context, uid int, at time.Time) error {
+	var errs []error
+	if g.accountServiceClient == nil {
+		errs = append(errs, ErrUnavailable.WithReason(ReasonUpstreamUnavailable))
+	} else {
+		rpcResp, err := g.accountServiceClient.Logout(ctx, &accountpb.LogoutRequest{Uid: int32(uid), LogoutTime: at.UnixMilli()})
+		switch {
+		case err != nil:
+			errs = append(errs, wrapTechErr(err))
+		case rpcResp == nil:
+			errs = append(errs, ErrInternalServer.WithReason(ReasonUpstreamBadResponse))
+		default:
+			if err = checkBizResult(rpcResp.Result); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if _, err := g.CallPlayer(ctx, uid, nextInternalSeq(), &playerpb.LogoutRequest{LogoutTime: at.UnixMilli()}); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
 func (g *GateService) EnterServer(ctx context.Context, reqDTO model.EnterServerReq, seq int64) (*model.EnterServerResp, error) {
 	if g.playerServiceClient == nil {
 		return nil, ErrUnavailable.WithReason(ReasonUpstreamUnavailable)
@@ -129,18 +171,19 @@ func (g *GateService) EnterServer(ctx context.Context, reqDTO model.EnterServerR
 	if enterResp.Resource != nil {
 		resource := enterResp.Resource
 		resp.RoleRes = model.Resource{
-			Wood:          int(resource.Wood),
-			Iron:          int(resource.Iron),
-			Stone:         int(resource.Stone),
-			Grain:         int(resource.Grain),
-			Gold:          int(resource.Gold),
-			Decree:        int(resource.Decree),
-			WoodYield:     int(resource.WoodYield),
-			IronYield:     int(resource.IronYield),
-			StoneYield:    int(resource.StoneYield),
-			GrainYield:    int(resource.GrainYield),
-			GoldYield:     int(resource.GoldYield),
-			DepotCapacity: int(resource.DepotCapacity),
+			Wood:           int(resource.Wood),
+			Iron:           int(resource.Iron),
+			Stone:          int(resource.Stone),
+			Grain:          int(resource.Grain),
+			Gold:           int(resource.Gold),
+			Decree:         int(resource.Decree),
+			WoodYield:      int(resource.WoodYield),
+			IronYield:      int(resource.IronYield),
+			StoneYield:     int(resource.StoneYield),
+			GrainYield:     int(resource.GrainYield),
+			GoldYield:      int(resource.GoldYield),
+			DepotCapacity:  int(resource.DepotCapacity),
+			DecreeNextTime: resource.DecreeNextTime,
 		}
 	}
 
@@ -217,6 +260,64 @@ func (g *GateService) MyProperty(ctx context.Context, uid int, seq int64) (*play
 	return body, nil
 }
 
+// Admin 运营接口的 GM 命令转发给玩家 actor，玩家不在线时会先加载
+func (g *GateService) Admin(ctx context.Context, uid int, req *playerpb.AdminRequest) (*playerpb.AdminResponse, error) {
+	out, err := g.CallPlayer(ctx, uid, nextInternalSeq(), req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := out.(*playerpb.AdminResponse)
+	if !ok {
+		return nil, ErrInternalServer.WithReason(ReasonUpstreamBadResponse)
+	}
+	return resp, nil
+}
+
+// CallPlayer 通用的玩家请求转发：按 body 的消息类型放进 PlayerRequest.body 对应的字段，
+// 应答取 PlayerResponse.body 中编号相同的字段，新增协议不需要再写专门的方法
+func (g *GateService) CallPlayer(ctx context.Context, uid int, seq int64, body proto.Message) (proto.Message, error) {
+	if body == nil {
+		return nil, ErrInternalServer.WithReason(ReasonUpstreamBadResponse)
+	}
+	reqField := PlayerBodyField(body.ProtoReflect().Descriptor().FullName())
+	if reqField == nil {
+		return nil, ErrInternalServer.WithReason(ReasonUpstreamBadResponse)
+	}
+	req := &playerpb.PlayerRequest{
+		PlayerId: int64(uid),
+		WorldId:  defaultPlayerWorldID,
+		Seq:      seq,
+	}
+	req.ProtoReflect().Set(reqField, protoreflect.ValueOfMessage(body.ProtoReflect()))
+
+	rpcResp, err := g.callPlayer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	respMsg := rpcResp.ProtoReflect()
+	respField := respMsg.Descriptor().Fields().ByNumber(reqField.Number())
+	if respField == nil || respField.Message() == nil || !respMsg.Has(respField) {
+		return nil, ErrInternalServer.WithReason(ReasonUpstreamBadResponse)
+	}
+	return respMsg.Get(respField).Message().Interface(), nil
+}
+
+// PlayerBodyField 按请求消息的全名找到 PlayerRequest.body 中的字段，不是 body 的消息返回 nil
+func PlayerBodyField(name protoreflect.FullName) protoreflect.FieldDescriptor {
+	desc := (&playerpb.PlayerRequest{}).ProtoReflect().Descriptor()
+	body := desc.Oneofs().ByName("body")
+	if body == nil {
+		return nil
+	}
+	fields := body.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if f := fields.Get(i); f.Message() != nil && f.Message().FullName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
 func (g *GateService) callPlayer(ctx context.Context, req *playerpb.PlayerRequest) (*playerpb.PlayerResponse, error) {
 	if g.playerServiceClient == nil {
 		return nil, ErrUnavailable.WithReason(ReasonUpstreamUnavailable)
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"ThreeKingdoms/internal/shared/transport/ws/middlewares"
+)
+
+// 运营接口的请求，uid 为玩家账号 id
+
+type AdminOnlineResp struct {
+	Count int   `json:"count"`
+	UIds  []int `json:"uids"`
+}
+
+type AdminKickReq struct {
+	UId int `json:"uid"`
+}
+
+// AdminKickResp gate 为玩家会话所在的 gate，不在本 gate 时按路由表转发过去
+type AdminKickResp struct {
+	Gate string `json:"gate"`
+}
+
+// AdminPushReq uid 大于 0 时推给该玩家，不在本 gate 时按路由表转发；
+// uid 为 0 时只推给本 gate 上所有在线玩家，全服公告需要对每个 gate 各调用一次
+type AdminPushReq struct {
+	UId     int    `json:"uid"`
+	Id      int    `json:"id"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// AdminPushResp gate 为推送所经的 gate，广播时即本 gate
+type AdminPushResp struct {
+	Count int    `json:"count"`
+	Gate  string `json:"gate"`
+}
+
+type AdminGrantReq struct {
+	UId    int `json:"uid"`
+	Wood   int `json:"wood"`
+	Iron   int `json:"iron"`
+	Stone  int `json:"stone"`
+	Grain  int `json:"grain"`
+	Gold   int `json:"gold"`
+	Decree int `json:"decree"`
+}
+
+// AdminFacilityReq cityId 为 0 表示主城
+type AdminFacilityReq struct {
+	UId    int `json:"uid"`
+	CityId int `json:"cityId"`
+	Type   int `json:"type"`
+	Level  int `json:"level"`
+}
+
+// AdminTeleportReq cityId 为 0 表示主城
+type AdminTeleportReq struct {
+	UId    int `json:"uid"`
+	ArmyId int `json:"armyId"`
+	CityId int `json:"cityId"`
+}
+
+// AdminStatsResp 本 gate 的推送队列和限流统计
+type AdminStatsResp struct {
+	Push      ws.PushStats               `json:"push"`
+	RateLimit middlewares.RateLimitStats `json:"rateLimit"`
+}
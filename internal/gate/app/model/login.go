@@ -5,6 +5,7 @@ type LoginResp struct {
 	Password string `json:"password"`
 	Session  string `json:"session"` // token
 	UId      int    `json:"uid"`
+	Resume   string `json:"resume"` // 断线重连用的 resume token
 }
 
 type LoginReq struct {
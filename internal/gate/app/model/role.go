@@ -11,16 +11,17 @@ type Role struct {
 }
 
 type Resource struct {
-	Wood          int `json:"wood"`
-	Iron          int `json:"iron"`
-	Stone         int `json:"stone"`
-	Grain         int `json:"grain"`
-	Gold          int `json:"gold"`
-	Decree        int `json:"decree"` // 令牌
-	WoodYield     int `json:"wood_yield"`
-	IronYield     int `json:"iron_yield"`
-	StoneYield    int `json:"stone_yield"`
-	GrainYield    int `json:"grain_yield"`
-	GoldYield     int `json:"gold_yield"`
-	DepotCapacity int `json:"depot_capacity"` // 仓库容量
+	Wood           int   `json:"wood"`
+	Iron           int   `json:"iron"`
+	Stone          int   `json:"stone"`
+	Grain          int   `json:"grain"`
+	Gold           int   `json:"gold"`
+	Decree         int   `json:"decree"` // 令牌
+	WoodYield      int   `json:"wood_yield"`
+	IronYield      int   `json:"iron_yield"`
+	StoneYield     int   `json:"stone_yield"`
+	GrainYield     int   `json:"grain_yield"`
+	GoldYield      int   `json:"gold_yield"`
+	DepotCapacity  int   `json:"depot_capacity"`   // 仓库容量
+	DecreeNextTime int64 `json:"decree_next_time"` // 下一点政令恢复时间，毫秒
 }
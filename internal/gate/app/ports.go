@@ -13,6 +13,8 @@ type AccountServiceClient interface {
 	Login(ctx context.Context, req *accountpb.LoginRequest, opts ...grpc.CallOption) (*accountpb.LoginReply, error)
 	// 注册
 	Register(ctx context.Context, req *accountpb.RegisterRequest, opts ...grpc.CallOption) (*accountpb.RegisterReply, error)
+	// 登出
+	Logout(ctx context.Context, req *accountpb.LogoutRequest, opts ...grpc.CallOption) (*accountpb.LogoutReply, error)
 }
 
 type PlayerServiceClient interface {
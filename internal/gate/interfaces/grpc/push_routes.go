@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"ThreeKingdoms/internal/gate/interfaces/handler/ws/dto"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pushRoute PushItem.payload 字段到 ws 推送路由的映射，msg 为空时直接推送 payload 消息
+type pushRoute struct {
+	name  string            // ws 推送的路由名，如 army.push
+	field protoreflect.Name // PushItem.payload 中的字段名
+	msg   func(proto.Message) any
+	high  bool // 和请求的应答一样优先发送
+}
+
+// pushRoutes 新增推送只需要在 PushItem.payload 里加字段，再在这里加一行
+var pushRoutes = []pushRoute{
+	{name: "army.push", field: "army", msg: armyPush},
+	{name: "city.push", field: "city", msg: cityPush},
+	{name: "union.push", field: "alliance", msg: alliancePush},
+	{name: "union.mark.push", field: "marks", msg: allianceMarksPush},
+	{name: "union.log.push", field: "alliance_log"},
+	{name: "warReport.push", field: "war_report", high: true},
+	{name: "battle.push", field: "battle_result", high: true},
+	{name: "roleRes.push", field: "resource"},
+	{name: "facility.push", field: "facility"},
+	{name: "chat.push", field: "chat"},
+	{name: "announce.push", field: "announcement"},
+}
+
+// payloadRoutes 按字段序号索引，字段名写错直接 panic，启动时就能发现
+var payloadRoutes = func() map[protoreflect.FieldNumber]pushRoute {
+	payload := (&gatepb.PushItem{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+	out := make(map[protoreflect.FieldNumber]pushRoute, len(pushRoutes))
+	for _, route := range pushRoutes {
+		field := payload.Fields().ByName(route.field)
+		if field == nil {
+			panic(fmt.Sprintf("push route %q: PushItem payload has no field %q", route.name, route.field))
+		}
+		out[field.Number()] = route
+		if route.high {
+			ws.SetPushPriority(route.name, ws.PriorityHigh)
+		}
+	}
+	return out
+}()
+
+// routePush 找到 item 的推送路由和推送内容，payload 为空或没有配置路由时返回 false
+func routePush(item *gatepb.PushItem) (string, any, bool) {
+	m := item.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if field == nil {
+		return "", nil, false
+	}
+	route, ok := payloadRoutes[field.Number()]
+	if !ok {
+		return "", nil, false
+	}
+	payload := m.Get(field).Message().Interface()
+	if route.msg == nil {
+		return route.name, payload, true
+	}
+	return route.name, route.msg(payload), true
+}
+
+func armyPush(m proto.Message) any {
+	army, _ := m.(*playerpb.Army)
+	return dto.NewArmy(army)
+}
+
+func cityPush(m proto.Message) any {
+	city, _ := m.(*playerpb.City)
+	return dto.NewCity(city)
+}
+
+func alliancePush(m proto.Message) any {
+	alliance, _ := m.(*playerpb.Alliance)
+	return dto.NewAlliance(alliance)
+}
+
+func allianceMarksPush(m proto.Message) any {
+	marks, _ := m.(*playerpb.AllianceMarkList)
+	return dto.NewAllianceMarks(marks)
+}
@@ -1,10 +1,12 @@
 package grpc
 
 import (
-	"ThreeKingdoms/internal/gate/interfaces/handler/ws/dto"
 	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	"ThreeKingdoms/internal/shared/logs"
 	"ThreeKingdoms/internal/shared/session"
 	"context"
+
+	"go.uber.org/zap"
 )
 
 type PushServer struct {
@@ -16,21 +18,35 @@ func NewPushServer(sessMgr session.Manager) *PushServer {
 	return &PushServer{sessMgr: sessMgr}
 }
 
-func (s *PushServer) PushWorldBatch(ctx context.Context, req *gatepb.PushWorldBatchRequest) (*gatepb.PushWorldBatchReply, error) {
-	if s == nil || s.sessMgr == nil || req == nil || len(req.Items) == 0 || req.MsgType == "" {
-		return &gatepb.PushWorldBatchReply{Ok: true}, nil
+func (s *PushServer) PushBatch(ctx context.Context, req *gatepb.PushBatchRequest) (*gatepb.PushBatchReply, error) {
+	if s == nil || s.sessMgr == nil || req == nil || len(req.Items) == 0 {
+		return &gatepb.PushBatchReply{Ok: true}, nil
 	}
 	for _, item := range req.Items {
-		if item == nil || item.PlayerId <= 0 || item.Army == nil {
+		if item == nil || item.PlayerId <= 0 {
 			continue
 		}
-		conn, ok := s.sessMgr.GetConn(int(item.PlayerId))
-		if !ok || conn == nil {
+		name, msg, ok := routePush(item)
+		if !ok {
+			logs.Warn("push item without route", zap.Int64("playerId", item.PlayerId))
 			continue
 		}
-		conn.Push(req.MsgType, dto.NewArmy(item.Army))
+		// 不在线也要推，会话在重连窗口内时记入补发缓冲
+		s.sessMgr.Push(int(item.PlayerId), name, msg)
+	}
+	return &gatepb.PushBatchReply{Ok: true}, nil
+}
+
+// Kick 玩家在别的 gate 登录了，踢掉这里的旧会话；logout 为其他 gate 转来的运营踢人
+func (s *PushServer) Kick(ctx context.Context, req *gatepb.KickRequest) (*gatepb.KickReply, error) {
+	if s == nil || s.sessMgr == nil || req == nil || req.PlayerId <= 0 {
+		return &gatepb.KickReply{Ok: true}, nil
+	}
+	if req.Logout {
+		return &gatepb.KickReply{Ok: true, Online: s.sessMgr.Disconnect(int(req.PlayerId))}, nil
 	}
-	return &gatepb.PushWorldBatchReply{Ok: true}, nil
+	s.sessMgr.Kick(int(req.PlayerId))
+	return &gatepb.KickReply{Ok: true}, nil
 }
 
 var _ gatepb.GatePushServiceServer = (*PushServer)(nil)
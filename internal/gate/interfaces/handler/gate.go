@@ -2,19 +2,55 @@ package handler
 
 import (
 	"ThreeKingdoms/internal/gate/app"
+	"ThreeKingdoms/internal/shared/gateroute"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/logs"
 	"ThreeKingdoms/internal/shared/session"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// logoutTimeout 会话结束后通知下游记录登出的超时
+	logoutTimeout = 5 * time.Second
+	// routeTimeout 登记和删除路由表的超时
+	routeTimeout = 3 * time.Second
 )
 
 type Gate struct {
 	Session     session.Manager
+	Member      *gateroute.Member
 	GateService *app.GateService
 }
 
-func NewGate(s session.Manager, accountServiceClient app.AccountServiceClient, playerServiceClient playerpb.PlayerServiceClient) *Gate {
+func NewGate(s session.Manager, member *gateroute.Member, accountServiceClient app.AccountServiceClient, playerServiceClient playerpb.PlayerServiceClient) *Gate {
 	gate := Gate{
 		Session: s,
+		Member:  member,
 	}
 	gate.GateService = app.NewGateService(accountServiceClient, playerServiceClient)
+	// 超时断开和正常断开一样，过了重连窗口才算登出
+	s.OnLogout(func(uid int, at time.Time) {
+		ctx, cancel := context.WithTimeout(context.Background(), logoutTimeout)
+		defer cancel()
+		if err := gate.GateService.Logout(ctx, uid, at); err != nil {
+			logs.Error("gate logout bookkeeping failed", zap.Int("uid", uid), zap.Error(err))
+		}
+		if err := gate.Member.Release(ctx, uid); err != nil {
+			logs.Error("gate release route failed", zap.Int("uid", uid), zap.Error(err))
+		}
+	})
 	return &gate
 }
+
+// Claim 登录或重连成功后登记 uid 在本 gate 上，同一账号在别的 gate 上的旧会话会被踢掉。
+// 登记失败只影响推送的路由，不影响这次登录
+func (g *Gate) Claim(uid int) {
+	ctx, cancel := context.WithTimeout(context.Background(), routeTimeout)
+	defer cancel()
+	if err := g.Member.Claim(ctx, uid); err != nil {
+		logs.Error("gate claim route failed", zap.Int("uid", uid), zap.Error(err))
+	}
+}
@@ -0,0 +1,227 @@
+package http
+
+import (
+	"ThreeKingdoms/internal/gate/app/model"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/logs"
+	"ThreeKingdoms/internal/shared/transport"
+	"ThreeKingdoms/internal/shared/transport/http/middleware"
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// announcePush 系统公告的 ws 推送路由，和 gate 推送服务的 announcement 一致
+const announcePush = "announce.push"
+
+var errNotOnline = errors.New("player not online")
+
+// registerAdminRoutes 运营接口，只有配置了 admin.enable 才注册
+func (h *HttpHandler) registerAdminRoutes(group *gin.RouterGroup) {
+	adminGroup := group.Group("/admin", middleware.AdminAuth(h.admin))
+	adminGroup.GET("/online", h.AdminOnline)
+	adminGroup.GET("/stats", h.AdminStats)
+	adminGroup.POST("/kick", h.AdminKick)
+	adminGroup.POST("/push", h.AdminPush)
+	adminGroup.POST("/grant", h.AdminGrant)
+	adminGroup.POST("/facility", h.AdminFacility)
+	adminGroup.POST("/teleport", h.AdminTeleport)
+}
+
+// AdminOnline 本 gate 上的在线人数和 uid 列表
+func (h *HttpHandler) AdminOnline(c *gin.Context) {
+	uids := h.gate.Session.Online()
+	h.audit(c, "online", 0, nil, zap.Int("count", len(uids)))
+	h.ok(c, model.AdminOnlineResp{Count: len(uids), UIds: uids})
+}
+
+// AdminStats 本 gate 的推送队列和限流统计
+func (h *HttpHandler) AdminStats(c *gin.Context) {
+	h.audit(c, "stats", 0, nil)
+	h.ok(c, model.AdminStatsResp{Push: ws.Stats(), RateLimit: h.limiter.Stats()})
+}
+
+// AdminKick 关闭连接并结束会话，不留重连窗口；玩家在别的 gate 上时转发过去
+func (h *HttpHandler) AdminKick(c *gin.Context) {
+	var req model.AdminKickReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.UId <= 0 {
+		h.audit(c, "kick", req.UId, errors.Join(errors.New("invalid param"), err))
+		h.fail(c, transport.InvalidParam, "参数有误")
+		return
+	}
+	local := h.gate.Member.ID()
+	if h.gate.Session.Disconnect(req.UId) {
+		h.audit(c, "kick", req.UId, nil, zap.String("gate", local))
+		h.ok(c, model.AdminKickResp{Gate: local})
+		return
+	}
+	ctx := c.Request.Context()
+	gate, err := h.remoteGate(ctx, req.UId)
+	if err != nil {
+		h.audit(c, "kick", req.UId, err)
+		h.adminError(ctx, c, err)
+		return
+	}
+	reply, err := h.gate.Member.Router().Kick(ctx, &gatepb.KickRequest{PlayerId: int64(req.UId), Gate: gate, Logout: true})
+	if err == nil && !reply.GetOnline() {
+		err = errNotOnline
+	}
+	h.audit(c, "kick", req.UId, err, zap.String("gate", gate))
+	if err != nil {
+		h.adminError(ctx, c, err)
+		return
+	}
+	h.ok(c, model.AdminKickResp{Gate: gate})
+}
+
+// AdminPush 系统公告，指定 uid 时推到玩家所在的 gate；uid 为 0 时只推给本 gate 上所有在线玩家
+func (h *HttpHandler) AdminPush(c *gin.Context) {
+	var req model.AdminPushReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.UId < 0 || req.Content == "" {
+		h.audit(c, "push", req.UId, errors.Join(errors.New("invalid param"), err))
+		h.fail(c, transport.InvalidParam, "参数有误")
+		return
+	}
+	msg := &gatepb.Announcement{
+		Id:      int32(req.Id),
+		Title:   req.Title,
+		Content: req.Content,
+		Ctime:   time.Now().UnixMilli(),
+	}
+	local := h.gate.Member.ID()
+	uids := h.gate.Session.Online()
+	if req.UId > 0 {
+		if _, ok := h.gate.Session.GetConn(req.UId); !ok {
+			h.remotePush(c, req, msg)
+			return
+		}
+		uids = []int{req.UId}
+	}
+	for _, uid := range uids {
+		h.gate.Session.Push(uid, announcePush, msg)
+	}
+	h.audit(c, "push", req.UId, nil, zap.String("title", req.Title), zap.String("content", req.Content), zap.Int("count", len(uids)), zap.String("gate", local))
+	h.ok(c, model.AdminPushResp{Count: len(uids), Gate: local})
+}
+
+// remotePush 玩家不在本 gate 上，按路由表推给所在的 gate
+func (h *HttpHandler) remotePush(c *gin.Context, req model.AdminPushReq, msg *gatepb.Announcement) {
+	ctx := c.Request.Context()
+	gate, err := h.remoteGate(ctx, req.UId)
+	if err == nil {
+		_, err = h.gate.Member.Router().PushBatch(ctx, &gatepb.PushBatchRequest{Items: []*gatepb.PushItem{{
+			PlayerId: int64(req.UId),
+			Payload:  &gatepb.PushItem_Announcement{Announcement: msg},
+		}}})
+	}
+	h.audit(c, "push", req.UId, err, zap.String("title", req.Title), zap.String("content", req.Content), zap.String("gate", gate))
+	if err != nil {
+		h.adminError(ctx, c, err)
+		return
+	}
+	h.ok(c, model.AdminPushResp{Count: 1, Gate: gate})
+}
+
+// remoteGate 查路由表找玩家会话所在的其他 gate，没有会话时返回 errNotOnline
+func (h *HttpHandler) remoteGate(ctx context.Context, uid int) (string, error) {
+	gate, err := h.gate.Member.Locate(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	if gate == "" || gate == h.gate.Member.ID() {
+		return "", errNotOnline
+	}
+	return gate, nil
+}
+
+// adminError 不在线按 UserNotInConnect 返回，其余按统一的错误处理
+func (h *HttpHandler) adminError(ctx context.Context, c *gin.Context, err error) {
+	if errors.Is(err, errNotOnline) {
+		h.fail(c, transport.UserNotInConnect, errNotOnline.Error())
+		return
+	}
+	h.error(ctx, c, err)
+}
+
+func (h *HttpHandler) AdminGrant(c *gin.Context) {
+	var req model.AdminGrantReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.UId <= 0 {
+		h.audit(c, "grant", req.UId, errors.Join(errors.New("invalid param"), err))
+		h.fail(c, transport.InvalidParam, "参数有误")
+		return
+	}
+	h.adminCommand(c, "grant", req.UId, &playerpb.AdminRequest{
+		Cmd: &playerpb.AdminRequest_GrantResource{GrantResource: &playerpb.AdminGrantResource{
+			Wood:   int32(req.Wood),
+			Iron:   int32(req.Iron),
+			Stone:  int32(req.Stone),
+			Grain:  int32(req.Grain),
+			Gold:   int32(req.Gold),
+			Decree: int32(req.Decree),
+		}},
+	})
+}
+
+func (h *HttpHandler) AdminFacility(c *gin.Context) {
+	var req model.AdminFacilityReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.UId <= 0 {
+		h.audit(c, "facility", req.UId, errors.Join(errors.New("invalid param"), err))
+		h.fail(c, transport.InvalidParam, "参数有误")
+		return
+	}
+	h.adminCommand(c, "facility", req.UId, &playerpb.AdminRequest{
+		Cmd: &playerpb.AdminRequest_SetFacilityLevel{SetFacilityLevel: &playerpb.AdminSetFacilityLevel{
+			CityId: int64(req.CityId),
+			Type:   int32(req.Type),
+			Level:  int32(req.Level),
+		}},
+	})
+}
+
+func (h *HttpHandler) AdminTeleport(c *gin.Context) {
+	var req model.AdminTeleportReq
+	if err := c.ShouldBindJSON(&req); err != nil || req.UId <= 0 {
+		h.audit(c, "teleport", req.UId, errors.Join(errors.New("invalid param"), err))
+		h.fail(c, transport.InvalidParam, "参数有误")
+		return
+	}
+	h.adminCommand(c, "teleport", req.UId, &playerpb.AdminRequest{
+		Cmd: &playerpb.AdminRequest_TeleportArmy{TeleportArmy: &playerpb.AdminTeleportArmy{
+			ArmyId: int32(req.ArmyId),
+			CityId: int64(req.CityId),
+		}},
+	})
+}
+
+// adminCommand 转发给玩家 actor 执行，命令内容和结果都记审计日志
+func (h *HttpHandler) adminCommand(c *gin.Context, action string, uid int, req *playerpb.AdminRequest) {
+	ctx := c.Request.Context()
+	req.Operator = middleware.AdminOperator(c)
+	resp, err := h.gate.GateService.Admin(ctx, uid, req)
+	h.audit(c, action, uid, err, zap.Stringer("cmd", req))
+	if err != nil {
+		h.error(ctx, c, err)
+		return
+	}
+	h.ok(c, resp)
+}
+
+// audit 每个运营操作都记一条审计日志，err 为空表示成功
+func (h *HttpHandler) audit(c *gin.Context, action string, uid int, err error, fields ...zap.Field) {
+	base := []zap.Field{
+		zap.String("operator", middleware.AdminOperator(c)),
+		zap.String("ip", c.ClientIP()),
+		zap.String("action", action),
+		zap.Int("uid", uid),
+		zap.Bool("ok", err == nil),
+	}
+	if err != nil {
+		base = append(base, zap.Error(err))
+	}
+	logs.Audit("admin", append(base, fields...)...)
+}
@@ -4,7 +4,9 @@ import (
 	"ThreeKingdoms/internal/gate/app/model"
 	"ThreeKingdoms/internal/gate/interfaces/handler"
 	"ThreeKingdoms/internal/gate/interfaces/handler/http/dto"
+	"ThreeKingdoms/internal/shared/serverconfig"
 	"ThreeKingdoms/internal/shared/transport"
+	"ThreeKingdoms/internal/shared/transport/ws/middlewares"
 	"context"
 	nethttp "net/http"
 
@@ -12,16 +14,21 @@ import (
 )
 
 type HttpHandler struct {
-	gate *handler.Gate
+	gate    *handler.Gate
+	admin   serverconfig.AdminConfig
+	limiter *middlewares.RateLimiter
 }
 
-func NewHttpHandler(g *handler.Gate) *HttpHandler {
-	return &HttpHandler{gate: g}
+func NewHttpHandler(g *handler.Gate, admin serverconfig.AdminConfig, limiter *middlewares.RateLimiter) *HttpHandler {
+	return &HttpHandler{gate: g, admin: admin, limiter: limiter}
 }
 
 func (h *HttpHandler) RegisterRoutes(group *gin.RouterGroup) {
 	accountGroup := group.Group("/account")
 	accountGroup.POST("/register", h.Register)
+	if h.admin.Enable {
+		h.registerAdminRoutes(group)
+	}
 }
 
 func (h *HttpHandler) Register(c *gin.Context) {
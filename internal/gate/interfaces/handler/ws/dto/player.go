@@ -3,6 +3,7 @@ package dto
 import (
 	"ThreeKingdoms/internal/gate/app/model"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"strconv"
 )
 
 type CreateRoleResp struct {
@@ -31,6 +32,13 @@ type MyPropertyResp struct {
 	Generals  []General      `json:"generals"`
 	Cities    []City         `json:"cities"`
 	Armies    []Army         `json:"armies"`
+	PosTags   []PosTag       `json:"pos_tags"`
+}
+
+type PosTag struct {
+	X    int32  `json:"x"`
+	Y    int32  `json:"y"`
+	Name string `json:"name"`
 }
 
 type Building struct {
@@ -99,6 +107,34 @@ type City struct {
 	OccupyTime int64  `json:"occupy_time"`
 }
 
+type Alliance struct {
+	Id     int32   `json:"id"`
+	Name   string  `json:"name"`
+	Cnt    int32   `json:"cnt"`
+	Notice string  `json:"notice"`
+	Major  []Major `json:"major"`
+}
+
+type AllianceMark struct {
+	Id        int32  `json:"id"`
+	X         int32  `json:"x"`
+	Y         int32  `json:"y"`
+	Kind      int32  `json:"kind"`
+	Label     string `json:"label"`
+	CreatorId int32  `json:"creator_id"`
+	Expire    int64  `json:"expire"`
+}
+
+type AllianceMarks struct {
+	Marks []AllianceMark `json:"marks"`
+}
+
+type Major struct {
+	Rid   int32  `json:"rid"`
+	Name  string `json:"name"`
+	Title int32  `json:"title"`
+}
+
 type Army struct {
 	Id       int32   `json:"id"`
 	CityId   int32   `json:"cityId"`
@@ -118,6 +154,16 @@ type Army struct {
 	End      int64   `json:"end"`
 }
 
+type ArmyListResp struct {
+	CityId int32  `json:"cityId"`
+	Armies []Army `json:"armies"`
+}
+
+type ArmyResp struct {
+	Army     Army            `json:"army"`
+	Resource *model.Resource `json:"resource,omitempty"`
+}
+
 func legacyArmyTime(v int64) int64 {
 	if v <= 0 {
 		return 0
@@ -128,6 +174,11 @@ func legacyArmyTime(v int64) int64 {
 	return v
 }
 
+// MergeKey 同一支军队的推送只保留最新的状态
+func (a Army) MergeKey() string {
+	return strconv.Itoa(int(a.Id))
+}
+
 func NewArmy(resp *playerpb.Army) Army {
 	if resp == nil {
 		return Army{}
@@ -156,6 +207,85 @@ func NewArmy(resp *playerpb.Army) Army {
 	}
 }
 
+func NewArmyListResp(resp *playerpb.ArmyListResponse) ArmyListResp {
+	out := ArmyListResp{CityId: resp.GetCityId(), Armies: make([]Army, 0, len(resp.GetArmies()))}
+	for _, a := range resp.GetArmies() {
+		if a == nil {
+			continue
+		}
+		out.Armies = append(out.Armies, NewArmy(a))
+	}
+	return out
+}
+
+// NewArmyResp resource 为空时不返回资源
+func NewArmyResp(army *playerpb.Army, resource *playerpb.Resource) ArmyResp {
+	out := ArmyResp{Army: NewArmy(army)}
+	if resource != nil {
+		res := resourceFromPB(resource)
+		out.Resource = &res
+	}
+	return out
+}
+
+func NewCity(c *playerpb.City) City {
+	if c == nil {
+		return City{}
+	}
+	return City{
+		Rid:        c.GetPlayerId(),
+		CityId:     c.GetCityId(),
+		Name:       c.GetName(),
+		UnionId:    c.GetUnionId(),
+		UnionName:  c.GetUnionName(),
+		ParentId:   c.GetParentId(),
+		X:          c.GetX(),
+		Y:          c.GetY(),
+		IsMain:     c.GetIsMain(),
+		Level:      c.GetLevel(),
+		CurDurable: c.GetCurDurable(),
+		MaxDurable: c.GetMaxDurable(),
+		OccupyTime: c.GetOccupyTime(),
+	}
+}
+
+func NewAlliance(a *playerpb.Alliance) Alliance {
+	if a == nil {
+		return Alliance{}
+	}
+	majors := make([]Major, 0, len(a.GetMajor()))
+	for _, m := range a.GetMajor() {
+		majors = append(majors, Major{
+			Rid:   m.GetRid(),
+			Name:  m.GetName(),
+			Title: int32(m.GetTitle()),
+		})
+	}
+	return Alliance{
+		Id:     a.GetId(),
+		Name:   a.GetName(),
+		Cnt:    a.GetCnt(),
+		Notice: a.GetNotice(),
+		Major:  majors,
+	}
+}
+
+func NewAllianceMarks(in *playerpb.AllianceMarkList) AllianceMarks {
+	out := AllianceMarks{Marks: make([]AllianceMark, 0, len(in.GetMarks()))}
+	for _, m := range in.GetMarks() {
+		out.Marks = append(out.Marks, AllianceMark{
+			Id:        m.GetId(),
+			X:         m.GetX(),
+			Y:         m.GetY(),
+			Kind:      int32(m.GetKind()),
+			Label:     m.GetLabel(),
+			CreatorId: m.GetCreatorId(),
+			Expire:    m.GetExpire(),
+		})
+	}
+	return out
+}
+
 func NewCreateRoleResp(resp *playerpb.CreateRoleResponse) CreateRoleResp {
 	out := CreateRoleResp{}
 	if resp == nil {
@@ -277,21 +407,7 @@ func NewMyPropertyResp(resp *playerpb.MyPropertyResponse) MyPropertyResp {
 			if c == nil {
 				continue
 			}
-			out.Cities = append(out.Cities, City{
-				Rid:        c.GetPlayerId(),
-				CityId:     c.GetCityId(),
-				Name:       c.GetName(),
-				UnionId:    c.GetUnionId(),
-				UnionName:  c.GetUnionName(),
-				ParentId:   c.GetParentId(),
-				X:          c.GetX(),
-				Y:          c.GetY(),
-				IsMain:     c.GetIsMain(),
-				Level:      c.GetLevel(),
-				CurDurable: c.GetCurDurable(),
-				MaxDurable: c.GetMaxDurable(),
-				OccupyTime: c.GetOccupyTime(),
-			})
+			out.Cities = append(out.Cities, NewCity(c))
 		}
 	}
 
@@ -305,6 +421,20 @@ func NewMyPropertyResp(resp *playerpb.MyPropertyResponse) MyPropertyResp {
 		}
 	}
 
+	if items := resp.GetPosTags(); len(items) > 0 {
+		out.PosTags = make([]PosTag, 0, len(items))
+		for _, t := range items {
+			if t == nil {
+				continue
+			}
+			out.PosTags = append(out.PosTags, PosTag{
+				X:    t.GetX(),
+				Y:    t.GetY(),
+				Name: t.GetName(),
+			})
+		}
+	}
+
 	return out
 }
 
@@ -328,17 +458,18 @@ func resourceFromPB(resource *playerpb.Resource) model.Resource {
 		return model.Resource{}
 	}
 	return model.Resource{
-		Wood:          int(resource.GetWood()),
-		Iron:          int(resource.GetIron()),
-		Stone:         int(resource.GetStone()),
-		Grain:         int(resource.GetGrain()),
-		Gold:          int(resource.GetGold()),
-		Decree:        int(resource.GetDecree()),
-		WoodYield:     int(resource.GetWoodYield()),
-		IronYield:     int(resource.GetIronYield()),
-		StoneYield:    int(resource.GetStoneYield()),
-		GrainYield:    int(resource.GetGrainYield()),
-		GoldYield:     int(resource.GetGoldYield()),
-		DepotCapacity: int(resource.GetDepotCapacity()),
+		Wood:           int(resource.GetWood()),
+		Iron:           int(resource.GetIron()),
+		Stone:          int(resource.GetStone()),
+		Grain:          int(resource.GetGrain()),
+		Gold:           int(resource.GetGold()),
+		Decree:         int(resource.GetDecree()),
+		WoodYield:      int(resource.GetWoodYield()),
+		IronYield:      int(resource.GetIronYield()),
+		StoneYield:     int(resource.GetStoneYield()),
+		GrainYield:     int(resource.GetGrainYield()),
+		GoldYield:      int(resource.GetGoldYield()),
+		DepotCapacity:  int(resource.GetDepotCapacity()),
+		DecreeNextTime: resource.GetDecreeNextTime(),
 	}
 }
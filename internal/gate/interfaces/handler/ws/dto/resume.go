@@ -0,0 +1,11 @@
+package dto
+
+type ResumeReq struct {
+	Resume string `json:"resume"`
+	Ack    int64  `json:"ack"` // 最后收到的推送序号
+}
+
+type ResumeResp struct {
+	UId    int    `json:"uid"`
+	Resume string `json:"resume"` // 新的 resume token，旧的已失效
+}
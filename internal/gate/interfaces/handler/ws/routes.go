@@ -0,0 +1,157 @@
+package ws
+
+import (
+	"ThreeKingdoms/internal/gate/app"
+	"ThreeKingdoms/internal/gate/interfaces/handler/ws/dto"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/transport"
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// playerRoute ws 路由到 PlayerRequest.body 字段的映射，resp 为空时直接返回应答消息
+type playerRoute struct {
+	name  string            // 组名.路由名，如 army.assign
+	field protoreflect.Name // PlayerRequest.body 中的字段名
+	resp  func(proto.Message) any
+}
+
+// playerRoutes 透传给 player 服务的路由，新增协议只需要在这里加一行
+var playerRoutes = []playerRoute{
+	{name: "role.posTagList", field: "posTagListRequest"},
+	{name: "role.addPosTag", field: "posTagAddRequest"},
+	{name: "role.renamePosTag", field: "posTagRenameRequest"},
+	{name: "role.delPosTag", field: "posTagDelRequest"},
+
+	{name: "general.myGenerals", field: "myGeneralsRequest"},
+	{name: "general.drawGeneral", field: "drawGeneralRequest"},
+
+	{name: "army.myList", field: "armyListRequest", resp: armyListResp},
+	{name: "army.myOne", field: "armyInfoRequest", resp: armyResp},
+	{name: "army.dispose", field: "disposeRequest", resp: armyResp},
+	{name: "army.conscript", field: "ConscriptRequest", resp: armyResp},
+	{name: "army.assign", field: "assignArmyRequest", resp: armyResp},
+	{name: "army.reinforceBack", field: "reinforceBackRequest"},
+
+	{name: "war.report", field: "WarReportRequest"},
+	{name: "skill.list", field: "skillListRequest"},
+
+	{name: "nationMap.scanBlock", field: "scanBlockRequest"},
+	{name: "nationMap.giveUp", field: "giveUpRequest"},
+
+	{name: "interior.openCollect", field: "openCollectionRequest"},
+	{name: "interior.collect", field: "collectionRequest"},
+	{name: "interior.transform", field: "transformRequest"},
+
+	{name: "city.facilities", field: "facilitiesRequest"},
+	{name: "city.upFacility", field: "upFacilityRequest"},
+	{name: "city.createSubCity", field: "createSubCityRequest"},
+	{name: "city.move", field: "moveCityRequest"},
+
+	{name: "union.list", field: "allianceListRequest"},
+	{name: "union.info", field: "allianceInfoRequest"},
+	{name: "union.applyList", field: "allianceApplyListRequest"},
+	{name: "union.create", field: "allianceCreateRequest"},
+	{name: "union.join", field: "allianceJoinRequest"},
+	{name: "union.verify", field: "allianceVerifyRequest"},
+	{name: "union.exit", field: "allianceExitRequest"},
+	{name: "union.kick", field: "allianceKickRequest"},
+	{name: "union.dismiss", field: "allianceDismissRequest"},
+	{name: "union.appoint", field: "allianceAppointRequest"},
+	{name: "union.abdicate", field: "allianceTransferRequest"},
+	{name: "union.modNotice", field: "allianceNoticeRequest"},
+	{name: "union.log", field: "allianceLogRequest"},
+	{name: "union.addMark", field: "allianceMarkAddRequest"},
+	{name: "union.delMark", field: "allianceMarkDelRequest"},
+	{name: "union.markList", field: "allianceMarkListRequest"},
+	{name: "union.diplomacy", field: "diplomacyProposeRequest"},
+	{name: "union.diplomacyAccept", field: "diplomacyAcceptRequest"},
+	{name: "union.diplomacyList", field: "diplomacyListRequest"},
+	{name: "union.rally", field: "rallyCreateRequest"},
+	{name: "union.joinRally", field: "rallyJoinRequest"},
+	{name: "union.rallyList", field: "rallyListRequest"},
+}
+
+// registerPlayerRoutes 按路由表注册处理函数，字段名写错直接 panic，启动时就能发现
+func (h *WsHandler) registerPlayerRoutes(group func(prefix string) *ws.Group) {
+	body := (&playerpb.PlayerRequest{}).ProtoReflect().Descriptor().Oneofs().ByName("body")
+	for _, route := range playerRoutes {
+		prefix, name, ok := strings.Cut(route.name, ".")
+		if !ok {
+			panic(fmt.Sprintf("ws route %q must be group.name", route.name))
+		}
+		field := body.Fields().ByName(route.field)
+		if field == nil || field.Message() == nil {
+			panic(fmt.Sprintf("ws route %q: PlayerRequest body has no field %q", route.name, route.field))
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(field.Message().FullName())
+		if err != nil {
+			panic(fmt.Sprintf("ws route %q: %v", route.name, err))
+		}
+		group(prefix).Handle(name, h.playerHandler(mt, route.resp))
+	}
+}
+
+// playerHandler 绑定请求消息后转发给 player 服务
+func (h *WsHandler) playerHandler(mt protoreflect.MessageType, resp func(proto.Message) any) ws.HandlerFunc {
+	return func(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *ws.WsMsgResp) {
+		if wsReq == nil || wsReq.Body == nil || wsReq.Conn == nil || wsResp == nil || wsResp.Body == nil {
+			h.fail(wsResp, transport.InvalidParam, "参数有误")
+			return
+		}
+		uid, ok := h.gate.Session.GetUID(wsReq.Conn)
+		if !ok {
+			h.fail(wsResp, transport.SessionInvalid, "session 无效")
+			return
+		}
+
+		req := mt.New().Interface()
+		if err := ws.Bind(wsReq, req); err != nil {
+			h.fail(wsResp, transport.InvalidParam, err.Error())
+			return
+		}
+
+		out, err := h.gate.GateService.CallPlayer(ctx, uid, wsReq.Body.Seq, req)
+		if err != nil {
+			h.error(ctx, wsResp, err)
+			return
+		}
+		if out == nil {
+			h.error(ctx, wsResp, app.ErrInternalServer.WithReason(app.ReasonUpstreamBadResponse))
+			return
+		}
+		// 二进制编码直接返回应答消息
+		if resp != nil && !wsReq.Binary() {
+			h.ok(wsResp, resp(out))
+			return
+		}
+		h.ok(wsResp, out)
+	}
+}
+
+func armyListResp(m proto.Message) any {
+	resp, _ := m.(*playerpb.ArmyListResponse)
+	return dto.NewArmyListResp(resp)
+}
+
+// armyResp 返回单支军队的应答，军队时间转换为前端使用的秒
+func armyResp(m proto.Message) any {
+	switch resp := m.(type) {
+	case *playerpb.ArmyInfoResponse:
+		return dto.NewArmyResp(resp.GetArmy(), nil)
+	case *playerpb.DisposeResponse:
+		return dto.NewArmyResp(resp.GetArmy(), nil)
+	case *playerpb.ConscriptResponse:
+		return dto.NewArmyResp(resp.GetArmy(), resp.GetResource())
+	case *playerpb.AssignArmyResponse:
+		return dto.NewArmyResp(resp.GetArmy(), nil)
+	default:
+		return m
+	}
+}
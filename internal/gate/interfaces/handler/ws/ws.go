@@ -21,19 +21,30 @@ func NewWsHandler(g *handler.Gate) *WsHandler {
 }
 
 func (h *WsHandler) RegisterRoutes(r *ws.Router) {
-	accountGroup := r.Group("account")
-	accountGroup.Use(middlewares.Log())
+	groups := make(map[string]*ws.Group)
+	group := func(prefix string) *ws.Group {
+		if g, ok := groups[prefix]; ok {
+			return g
+		}
+		g := r.Group(prefix)
+		g.Use(middlewares.Log())
+		groups[prefix] = g
+		return g
+	}
+
+	accountGroup := group("account")
 	accountGroup.Handle("login", h.Login)
+	accountGroup.Handle("resume", h.resume)
 
-	roleGroup := r.Group("role")
-	roleGroup.Use(middlewares.Log())
+	roleGroup := group("role")
 	roleGroup.Handle("enterServer", h.enterServer)
 	roleGroup.Handle("create", h.createRole)
 	roleGroup.Handle("myProperty", h.myProperty)
 
-	nationMapGroup := r.Group("nationMap")
-	nationMapGroup.Use(middlewares.Log())
-	nationMapGroup.Handle("config", h.buildingConf)
+	group("nationMap").Handle("config", h.buildingConf)
+
+	// 其余玩家请求走通用路由表
+	h.registerPlayerRoutes(group)
 }
 
 func (h *WsHandler) Login(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *ws.WsMsgResp) {
@@ -63,11 +74,43 @@ func (h *WsHandler) Login(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *ws.Ws
 		return
 	}
 
+	// 握手绑定了 Token 的连接只能登录同一个账号
+	if bindUID, ok := wsReq.Conn.GetProperty(ws.ConnKeyBindUID).(int); ok && bindUID != loginRespDTO.UId {
+		h.fail(wsResp, transport.SessionInvalid, "账号与握手不一致")
+		return
+	}
+
 	wsReq.Conn.SetProperty(ws.ConnKeyUID, loginRespDTO.UId)
-	h.gate.Session.Bind(loginRespDTO.UId, loginRespDTO.Session, wsReq.Conn)
+	loginRespDTO.Resume = h.gate.Session.Bind(loginRespDTO.UId, loginRespDTO.Session, wsReq.Conn)
+	h.gate.Claim(loginRespDTO.UId)
 	h.ok(wsResp, loginRespDTO)
 }
 
+// resume 断线重连，凭登录时的 resume token 接回会话，不用重新登录和 enterServer，
+// 断线期间的推送按客户端确认的序号补发
+func (h *WsHandler) resume(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *ws.WsMsgResp) {
+	if wsReq == nil || wsReq.Body == nil || wsReq.Conn == nil || wsResp == nil || wsResp.Body == nil {
+		h.fail(wsResp, transport.InvalidParam, "参数有误")
+		return
+	}
+
+	req := dto.ResumeReq{}
+	if err := ws.BindJSON(wsReq, &req); err != nil {
+		h.fail(wsResp, transport.InvalidParam, "参数有误")
+		return
+	}
+
+	uid, resume, err := h.gate.Session.Resume(req.Resume, req.Ack, wsReq.Conn)
+	if err != nil {
+		// 客户端收到后走正常的登录流程
+		h.fail(wsResp, transport.SessionInvalid, err.Error())
+		return
+	}
+	wsReq.Conn.SetProperty(ws.ConnKeyUID, uid)
+	h.gate.Claim(uid)
+	h.ok(wsResp, dto.ResumeResp{UId: uid, Resume: resume})
+}
+
 func (h *WsHandler) enterServer(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *ws.WsMsgResp) {
 	if wsReq == nil || wsReq.Body == nil || wsReq.Conn == nil || wsResp == nil || wsResp.Body == nil {
 		h.fail(wsResp, transport.InvalidParam, "参数有误")
@@ -124,7 +167,7 @@ func (h *WsHandler) createRole(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *
 	}
 
 	var req playerpb.CreateRoleRequest
-	if err := ws.BindJSON(wsReq, &req); err != nil {
+	if err := ws.Bind(wsReq, &req); err != nil {
 		h.fail(wsResp, transport.InvalidParam, err.Error())
 		return
 	}
@@ -138,6 +181,10 @@ func (h *WsHandler) createRole(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *
 		h.error(ctx, wsResp, app.ErrInternalServer.WithReason(app.ReasonUpstreamBadResponse))
 		return
 	}
+	if wsReq.Binary() {
+		h.ok(wsResp, resp)
+		return
+	}
 	h.ok(wsResp, dto.NewCreateRoleResp(resp))
 }
 
@@ -161,6 +208,10 @@ func (h *WsHandler) buildingConf(ctx context.Context, wsReq *ws.WsMsgReq, wsResp
 		h.error(ctx, wsResp, app.ErrInternalServer.WithReason(app.ReasonUpstreamBadResponse))
 		return
 	}
+	if wsReq.Binary() {
+		h.ok(wsResp, resp)
+		return
+	}
 	h.ok(wsResp, dto.NewWorldMapResp(resp))
 }
 
@@ -184,6 +235,10 @@ func (h *WsHandler) myProperty(ctx context.Context, wsReq *ws.WsMsgReq, wsResp *
 		h.error(ctx, wsResp, app.ErrInternalServer.WithReason(app.ReasonUpstreamBadResponse))
 		return
 	}
+	if wsReq.Binary() {
+		h.ok(wsResp, resp)
+		return
+	}
 	h.ok(wsResp, dto.NewMyPropertyResp(resp))
 }
 
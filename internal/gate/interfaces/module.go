@@ -4,11 +4,14 @@ import (
 	"ThreeKingdoms/internal/gate/interfaces/handler"
 	"ThreeKingdoms/internal/gate/interfaces/handler/http"
 	ws2 "ThreeKingdoms/internal/gate/interfaces/handler/ws"
+	"ThreeKingdoms/internal/shared/gateroute"
 	accountpb "ThreeKingdoms/internal/shared/gen/account"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/serverconfig"
 	"ThreeKingdoms/internal/shared/session"
 	transporthttp "ThreeKingdoms/internal/shared/transport/http"
 	"ThreeKingdoms/internal/shared/transport/ws"
+	"ThreeKingdoms/internal/shared/transport/ws/middlewares"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,11 +21,11 @@ type Module struct {
 	httpHandler *http.HttpHandler
 }
 
-func New(s session.Manager, accountClient accountpb.AccountServiceClient, playerClient playerpb.PlayerServiceClient) *Module {
-	gate := handler.NewGate(s, accountClient, playerClient)
+func New(s session.Manager, member *gateroute.Member, accountClient accountpb.AccountServiceClient, playerClient playerpb.PlayerServiceClient, admin serverconfig.AdminConfig, limiter *middlewares.RateLimiter) *Module {
+	gate := handler.NewGate(s, member, accountClient, playerClient)
 	return &Module{
 		wsHandler:   ws2.NewWsHandler(gate),
-		httpHandler: http.NewHttpHandler(gate),
+		httpHandler: http.NewHttpHandler(gate, admin, limiter),
 	}
 }
 
@@ -53,6 +53,34 @@ func (d *Dispatcher) registerAll() {
 	register(d, PH.HandleConscriptRequest)
 	register(d, PH.HandleArmyInfoRequest)
 	register(d, PH.HandleAssignArmyRequest)
+	register(d, PH.HandleGiveUpRequest)
+	register(d, PH.HandlePosTagAddRequest)
+	register(d, PH.HandlePosTagRenameRequest)
+	register(d, PH.HandlePosTagDelRequest)
+	register(d, PH.HandleCreateSubCityRequest)
+	register(d, PH.HandleMoveCityRequest)
+	register(d, PH.HandleAllianceCreateRequest)
+	register(d, PH.HandleAllianceJoinRequest)
+	register(d, PH.HandleAllianceVerifyRequest)
+	register(d, PH.HandleAllianceExitRequest)
+	register(d, PH.HandleAllianceKickRequest)
+	register(d, PH.HandleAllianceDismissRequest)
+	register(d, PH.HandleAllianceAppointRequest)
+	register(d, PH.HandleAllianceTransferRequest)
+	register(d, PH.HandleAllianceNoticeRequest)
+	register(d, PH.HandleAllianceLogRequest)
+	register(d, PH.HandleRallyCreateRequest)
+	register(d, PH.HandleRallyJoinRequest)
+	register(d, PH.HandleRallyListRequest)
+	register(d, PH.HandleReinforceBackRequest)
+	register(d, PH.HandleAllianceMarkAddRequest)
+	register(d, PH.HandleAllianceMarkDelRequest)
+	register(d, PH.HandleAllianceMarkListRequest)
+	register(d, PH.HandleDiplomacyProposeRequest)
+	register(d, PH.HandleDiplomacyAcceptRequest)
+	register(d, PH.HandleDiplomacyListRequest)
+	register(d, PH.HandleLogoutRequest)
+	register(d, PH.HandleAdminRequest)
 }
 
 // register 注册统一分发函数，要求 Req/Rep 都是 protobuf 指针消息。
@@ -150,6 +178,62 @@ func unwrapPlayerRequestBody(req *playerpb.PlayerRequest) proto.Message {
 		return body.ArmyInfoRequest
 	case *playerpb.PlayerRequest_AssignArmyRequest:
 		return body.AssignArmyRequest
+	case *playerpb.PlayerRequest_GiveUpRequest:
+		return body.GiveUpRequest
+	case *playerpb.PlayerRequest_PosTagAddRequest:
+		return body.PosTagAddRequest
+	case *playerpb.PlayerRequest_PosTagRenameRequest:
+		return body.PosTagRenameRequest
+	case *playerpb.PlayerRequest_PosTagDelRequest:
+		return body.PosTagDelRequest
+	case *playerpb.PlayerRequest_CreateSubCityRequest:
+		return body.CreateSubCityRequest
+	case *playerpb.PlayerRequest_MoveCityRequest:
+		return body.MoveCityRequest
+	case *playerpb.PlayerRequest_AllianceCreateRequest:
+		return body.AllianceCreateRequest
+	case *playerpb.PlayerRequest_AllianceJoinRequest:
+		return body.AllianceJoinRequest
+	case *playerpb.PlayerRequest_AllianceVerifyRequest:
+		return body.AllianceVerifyRequest
+	case *playerpb.PlayerRequest_AllianceExitRequest:
+		return body.AllianceExitRequest
+	case *playerpb.PlayerRequest_AllianceKickRequest:
+		return body.AllianceKickRequest
+	case *playerpb.PlayerRequest_AllianceDismissRequest:
+		return body.AllianceDismissRequest
+	case *playerpb.PlayerRequest_AllianceAppointRequest:
+		return body.AllianceAppointRequest
+	case *playerpb.PlayerRequest_AllianceTransferRequest:
+		return body.AllianceTransferRequest
+	case *playerpb.PlayerRequest_AllianceNoticeRequest:
+		return body.AllianceNoticeRequest
+	case *playerpb.PlayerRequest_AllianceLogRequest:
+		return body.AllianceLogRequest
+	case *playerpb.PlayerRequest_RallyCreateRequest:
+		return body.RallyCreateRequest
+	case *playerpb.PlayerRequest_RallyJoinRequest:
+		return body.RallyJoinRequest
+	case *playerpb.PlayerRequest_RallyListRequest:
+		return body.RallyListRequest
+	case *playerpb.PlayerRequest_ReinforceBackRequest:
+		return body.ReinforceBackRequest
+	case *playerpb.PlayerRequest_AllianceMarkAddRequest:
+		return body.AllianceMarkAddRequest
+	case *playerpb.PlayerRequest_AllianceMarkDelRequest:
+		return body.AllianceMarkDelRequest
+	case *playerpb.PlayerRequest_AllianceMarkListRequest:
+		return body.AllianceMarkListRequest
+	case *playerpb.PlayerRequest_DiplomacyProposeRequest:
+		return body.DiplomacyProposeRequest
+	case *playerpb.PlayerRequest_DiplomacyAcceptRequest:
+		return body.DiplomacyAcceptRequest
+	case *playerpb.PlayerRequest_DiplomacyListRequest:
+		return body.DiplomacyListRequest
+	case *playerpb.PlayerRequest_LogoutRequest:
+		return body.LogoutRequest
+	case *playerpb.PlayerRequest_AdminRequest:
+		return body.AdminRequest
 	default:
 		return nil
 	}
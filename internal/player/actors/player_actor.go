@@ -46,6 +46,11 @@ type PlayerActor struct {
 
 	seenSeq      map[int64]struct{}
 	seenSeqOrder []int64
+
+	// allianceCreating 已扣金币、等待联盟应答的创建请求
+	allianceCreating *allianceCreating
+	// subCityPending 已扣资源、等待 world 应答的建分城请求数，占用分城名额
+	subCityPending int
 }
 
 type flushTick struct{}
@@ -93,6 +98,7 @@ func (p *PlayerActor) Receive(actorCtx actor.Context) {
 		if p.state != Online {
 			return
 		}
+		p.settleFacilities(actorCtx)
 		if _, err := p.dc.Tick(); err != nil {
 			actorCtx.Logger().Error("player periodic flush failed", "player_id", p.PlayerId, "err", err)
 		}
@@ -153,6 +159,12 @@ func (p *PlayerActor) handlePlayerMessage(ctx actor.Context, msg messages.Player
 		PH.HandleWHBattleResult(ctx, p, typed)
 	case *messages.WHArmySync:
 		PH.HandleWHArmySync(ctx, p, typed)
+	case *messages.AHAllianceChanged:
+		PH.HandleAHAllianceChanged(ctx, p, typed)
+	case *messages.WHVassalChanged:
+		PH.HandleWHVassalChanged(ctx, p, typed)
+	case *messages.WHReclamationYield:
+		PH.HandleWHReclamationYield(ctx, p, typed)
 	default:
 		return
 	}
@@ -179,6 +191,10 @@ func (p *PlayerActor) init(ctx context.Context, actorCtx actor.Context, respondO
 	}
 
 	p.state = Online
+	if player := p.Entity(); player != nil {
+		allianceID := player.AllianceID()
+		p.AllianceID = &allianceID
+	}
 	p.startFlushLoop(actorCtx)
 
 	// 重放 stash
@@ -217,6 +233,49 @@ func (p *PlayerActor) startFlushLoop(actorCtx actor.Context) {
 	}(p.flushStop, interval)
 }
 
+// settleFacilities 升级完成的设施随定时 flush 结算并推送
+func (p *PlayerActor) settleFacilities(actorCtx actor.Context) {
+	done := PS.SettleFacilities(p.Entity(), time.Now().UnixMilli())
+	if len(done) == 0 {
+		return
+	}
+	items := make([]*gatepb.PushItem, 0, len(done))
+	for _, v := range done {
+		items = append(items, &gatepb.PushItem{Payload: &gatepb.PushItem_Facility{Facility: v}})
+	}
+	if err := pushToPlayer(context.Background(), p.pusher, p.Entity(), items...); err != nil {
+		actorCtx.Logger().Error("push facility done failed", "player_id", p.PlayerId, "err", err)
+	}
+	p.syncSettledFacilities(actorCtx, done)
+}
+
+// syncSettledFacilities 结算后的设施等级同步给 world，不等回复
+func (p *PlayerActor) syncSettledFacilities(actorCtx actor.Context, done []*gatepb.FacilityDone) {
+	worldPID := p.WorldPID()
+	if worldPID == nil {
+		return
+	}
+	synced := make(map[int64]struct{}, len(done))
+	for _, v := range done {
+		if _, ok := synced[v.GetCityId()]; ok {
+			continue
+		}
+		synced[v.GetCityId()] = struct{}{}
+		city, ok := findCity(p.Entity(), int(v.GetCityId()))
+		if !ok {
+			continue
+		}
+		actorCtx.Send(worldPID, &messages.HWSyncCityFacility{
+			WorldBaseMessage: messages.WorldBaseMessage{
+				WorldId:  int(*p.WorldId),
+				PlayerId: int(*p.PlayerId),
+			},
+			CityId:     int(v.GetCityId()),
+			Facilities: collectFacilitiesForWorldSync(p.Entity(), city),
+		})
+	}
+}
+
 func (p *PlayerActor) stopFlushLoop() {
 	if p.flushStop == nil {
 		return
@@ -0,0 +1,221 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/facility"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"context"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// LedgerAdminGrant 运营发放资源的流水原因
+const LedgerAdminGrant = "admin.grant"
+
+// HandleAdminRequest 运营接口转发的 GM 命令，改完立即落库并推送给在线的客户端
+func (h *PlayerHandler) HandleAdminRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AdminRequest) {
+	ctx.Logger().Info("admin command", "player_id", p.PlayerId, "request", request.String())
+	switch cmd := request.GetCmd().(type) {
+	case *playerpb.AdminRequest_GrantResource:
+		h.adminGrantResource(ctx, p, cmd.GrantResource)
+	case *playerpb.AdminRequest_SetFacilityLevel:
+		h.adminSetFacilityLevel(ctx, p, cmd.SetFacilityLevel)
+	case *playerpb.AdminRequest_TeleportArmy:
+		h.adminTeleportArmy(ctx, p, cmd.TeleportArmy)
+	default:
+		ctx.Respond(fail("unknown admin command"))
+	}
+}
+
+func (h *PlayerHandler) adminGrantResource(ctx actor.Context, p *PlayerActor, request *playerpb.AdminGrantResource) {
+	gain := entity.ResourceState{
+		Wood:   int(request.GetWood()),
+		Iron:   int(request.GetIron()),
+		Stone:  int(request.GetStone()),
+		Grain:  int(request.GetGrain()),
+		Gold:   int(request.GetGold()),
+		Decree: int(request.GetDecree()),
+	}
+	if gain.Wood < 0 || gain.Iron < 0 || gain.Stone < 0 || gain.Grain < 0 || gain.Gold < 0 || gain.Decree < 0 {
+		ctx.Respond(fail("request param invalid"))
+		return
+	}
+	player := p.Entity()
+	SettleDecree(player.Resource(), time.Now().UnixMilli())
+	Gain(player.Resource(), gain)
+	PS.RecordLedger(player, LedgerAdminGrant, gain)
+	if err := p.DC().FlushSync(context.TODO()); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+
+	resource := ToPBResource(player.Resource())
+	if err := pushToPlayer(context.Background(), p.pusher, player, &gatepb.PushItem{
+		Payload: &gatepb.PushItem_Resource{Resource: resource},
+	}); err != nil {
+		ctx.Logger().Error("push admin resource failed", "player_id", p.PlayerId, "err", err)
+	}
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_AdminResponse{
+		AdminResponse: &playerpb.AdminResponse{Resource: resource},
+	}
+	ctx.Respond(response)
+}
+
+// adminSetFacilityLevel 直接设置设施等级，同步 world 失败时恢复原来的等级
+func (h *PlayerHandler) adminSetFacilityLevel(ctx actor.Context, p *PlayerActor, request *playerpb.AdminSetFacilityLevel) {
+	player := p.Entity()
+	city, found := findCity(player, int(request.GetCityId()))
+	if !found {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+	fType := int8(request.GetType())
+	level := int(request.GetLevel())
+	if level < 0 || level > facility.FacilityConf.MaxLevel(fType) {
+		ctx.Respond(fail("facility level invalid"))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+
+	index := -1
+	var prev entity.FacilityState
+	forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
+		if v.FType == fType {
+			index, prev = i, v
+		}
+	})
+	if index < 0 {
+		ctx.Respond(fail("facility not found"))
+		return
+	}
+	var state entity.FacilityState
+	updateCityFacilityAt(player, city, index, func(fe *entity.FacilityEntity) {
+		fe.SetPrivateLevel(level)
+		fe.SetUpTime(0)
+		state = fe.Save()
+	})
+
+	future := ctx.RequestFuture(worldPID, &messages.HWSyncCityFacility{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		CityId:     int(city.id),
+		Facilities: collectFacilitiesForWorldSync(player, city),
+	}, 500*time.Millisecond)
+
+	ctx.ReenterAfter(future, func(res interface{}, syncErr error) {
+		syncResp, isSync := res.(*messages.WHSyncCityFacility)
+		if syncErr != nil || !isSync || syncResp == nil || !syncResp.OK {
+			updateCityFacilityAt(player, city, index, func(fe *entity.FacilityEntity) {
+				fe.SetPrivateLevel(prev.PrivateLevel)
+				fe.SetUpTime(prev.UpTime)
+			})
+			ctx.Respond(fail("sync city facility failed"))
+			return
+		}
+		if err := p.DC().FlushSync(context.TODO()); err != nil {
+			ctx.Respond(fail(err.Error()))
+			return
+		}
+
+		done := &gatepb.FacilityDone{CityId: int64(city.id), Facility: toPBFacility(state)}
+		if err := pushToPlayer(context.Background(), p.pusher, player, &gatepb.PushItem{
+			Payload: &gatepb.PushItem_Facility{Facility: done},
+		}); err != nil {
+			ctx.Logger().Error("push admin facility failed", "player_id", p.PlayerId, "err", err)
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AdminResponse{
+			AdminResponse: &playerpb.AdminResponse{CityId: done.CityId, Facility: done.Facility},
+		}
+		ctx.Respond(response)
+	})
+}
+
+// adminTeleportArmy 把空闲的军队送回所属城池。军队 id 编码了所属城池，不能送去别的城；
+// 同步 world 失败时恢复原来的位置
+func (h *PlayerHandler) adminTeleportArmy(ctx actor.Context, p *PlayerActor, request *playerpb.AdminTeleportArmy) {
+	player := p.Entity()
+	army, found := player.GetArmies(int(request.GetArmyId()))
+	if !found {
+		ctx.Respond(fail("army not found"))
+		return
+	}
+	if army.Cmd != entity.ArmyCmdIdle || army.State != entity.ArmyStop || army.Frozen {
+		ctx.Respond(fail("army is busy"))
+		return
+	}
+	city, found := findCity(player, int(request.GetCityId()))
+	if !found {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+	if home, ok := armyCity(player, army.Id); !ok || home.id != city.id {
+		ctx.Respond(fail("army does not belong to city"))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+
+	prev := army
+	player.UpdateArmies(army.Id, func(v *entity.ArmyEntity) {
+		v.SetCellX(city.x)
+		v.SetCellY(city.y)
+		v.SetFromX(city.x)
+		v.SetFromY(city.y)
+		v.SetToX(city.x)
+		v.SetToY(city.y)
+		army = v.Save()
+	})
+
+	future := ctx.RequestFuture(worldPID, &messages.HWTeleportArmy{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		ArmyId: army.Id,
+		CityId: int(city.id),
+		Pos:    messages.Pos{X: city.x, Y: city.y},
+	}, 500*time.Millisecond)
+
+	ctx.ReenterAfter(future, func(res interface{}, syncErr error) {
+		syncResp, isSync := res.(*messages.WHTeleportArmy)
+		if syncErr != nil || !isSync || syncResp == nil || !syncResp.OK {
+			player.UpdateArmies(prev.Id, func(v *entity.ArmyEntity) {
+				v.SetCellX(prev.CellX)
+				v.SetCellY(prev.CellY)
+				v.SetFromX(prev.FromX)
+				v.SetFromY(prev.FromY)
+				v.SetToX(prev.ToX)
+				v.SetToY(prev.ToY)
+			})
+			ctx.Respond(fail("sync army failed"))
+			return
+		}
+		if err := p.DC().FlushSync(context.TODO()); err != nil {
+			ctx.Respond(fail(err.Error()))
+			return
+		}
+
+		if err := pushArmyUpdate(context.Background(), p.pusher, player, army); err != nil {
+			ctx.Logger().Error("push admin army failed", "player_id", p.PlayerId, "army_id", army.Id, "err", err)
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AdminResponse{
+			AdminResponse: &playerpb.AdminResponse{Army: ToPBArmy(city.id, army)},
+		}
+		ctx.Respond(response)
+	})
+}
@@ -0,0 +1,605 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+func (h *PlayerHandler) HandleAllianceCreateRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceCreateRequest) {
+	name := strings.TrimSpace(request.Name)
+	if err := PS.CheckAllianceName(name); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+	player := p.Entity()
+	if player.AllianceID() > 0 {
+		ctx.Respond(fail("already in alliance"))
+		return
+	}
+	main, found := findCity(player, 0)
+	if !found {
+		ctx.Respond(fail("main city not found"))
+		return
+	}
+	alliancePID := p.AlliancePID()
+	if alliancePID == nil || p.WorldId == nil {
+		ctx.Respond(fail("alliance actor unavailable"))
+		return
+	}
+	if pending := p.allianceCreating; pending != nil {
+		// 上次对账没有结果时由本次请求接着核对，否则仍在等待应答
+		if pending.replyTo != nil {
+			ctx.Respond(fail("alliance creating"))
+			return
+		}
+		pending.replyTo = ctx.Sender()
+		h.reconcileAllianceCreate(ctx, p, 0)
+		return
+	}
+
+	// 先扣金币，创建失败时退还
+	cost := entity.ResourceState{Gold: basic.BasicConf.Union.CreateGold}
+	if !Consume(player.Resource(), cost) {
+		ctx.Respond(fail("gold is not enough"))
+		return
+	}
+
+	// 超时后联盟可能已经建好，不能直接退还，见 reconcileAllianceCreate
+	p.allianceCreating = &allianceCreating{cost: cost, replyTo: ctx.Sender()}
+	f := ctx.RequestFuture(alliancePID, &messages.HACreateAlliance{
+		AllianceBaseMessage: messages.AllianceBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		Name:     name,
+		NickName: player.Profile().NickName(),
+		Pos:      messages.Pos{X: main.x, Y: main.y},
+	}, allianceCreateWait)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		created, isCreated := res.(*messages.AHCreateAlliance)
+		if err != nil || !isCreated {
+			h.reconcileAllianceCreate(ctx, p, 0)
+			return
+		}
+		h.settleAllianceCreate(ctx, p, created)
+	})
+}
+
+const (
+	// allianceCreateWait 等待创建应答的时长，要长于 manager 等待联盟 actor 的时限
+	allianceCreateWait = 5 * time.Second
+	// allianceReconcileRetry 对账时 manager 仍在处理的重试次数，间隔 allianceReconcileDelay
+	allianceReconcileRetry = 3
+	allianceReconcileDelay = time.Second
+)
+
+// allianceCreating 等待联盟应答的创建请求，replyTo 为网关请求的应答地址，为空表示需要重新对账
+type allianceCreating struct {
+	cost    entity.ResourceState
+	replyTo *actor.PID
+}
+
+// reconcileAllianceCreate 创建应答丢失时向 manager 核对：已建成就加入，没有就退还。
+// 多次仍无结果则保留扣费，等下一次创建请求再核对
+func (h *PlayerHandler) reconcileAllianceCreate(ctx actor.Context, p *PlayerActor, attempt int) {
+	pending := p.allianceCreating
+	if pending == nil {
+		return
+	}
+	retry := func() {
+		if attempt+1 >= allianceReconcileRetry {
+			if pending.replyTo != nil {
+				ctx.Send(pending.replyTo, fail("alliance creating"))
+				pending.replyTo = nil
+			}
+			return
+		}
+		// 没有人应答的 future 当作定时器
+		ctx.ReenterAfter(actor.NewFuture(ctx.ActorSystem(), allianceReconcileDelay), func(interface{}, error) {
+			h.reconcileAllianceCreate(ctx, p, attempt+1)
+		})
+	}
+	alliancePID := p.AlliancePID()
+	if alliancePID == nil {
+		retry()
+		return
+	}
+	f := ctx.RequestFuture(alliancePID, &messages.HACreatedAlliance{
+		AllianceBaseMessage: p.allianceBase(0),
+	}, allianceCreateWait)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		found, isFound := res.(*messages.AHCreateAlliance)
+		if err != nil || !isFound || found.Pending {
+			retry()
+			return
+		}
+		h.settleAllianceCreate(ctx, p, found)
+	})
+}
+
+// settleAllianceCreate 按联盟的结果结算创建请求，失败才退还金币。网关那边即使已经超时，状态也以这里为准
+func (h *PlayerHandler) settleAllianceCreate(ctx actor.Context, p *PlayerActor, created *messages.AHCreateAlliance) {
+	pending := p.allianceCreating
+	if pending == nil {
+		return
+	}
+	p.allianceCreating = nil
+	player := p.Entity()
+	reply := func(resp *playerpb.PlayerResponse) {
+		if pending.replyTo != nil {
+			ctx.Send(pending.replyTo, resp)
+		}
+	}
+	if !created.OK {
+		Gain(player.Resource(), pending.cost)
+		reply(fail(allianceReason(created, "create alliance failed")))
+		return
+	}
+
+	PS.SetAlliance(ctx, p, AllianceID(created.Alliance.Id), created.Alliance.Name)
+	PS.RecordLedger(player, LedgerAllianceCreate, entity.ResourceState{Gold: -pending.cost.Gold})
+	if err := p.DC().FlushSync(context.TODO()); err != nil {
+		reply(fail(err.Error()))
+		return
+	}
+
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_AllianceCreateResponse{
+		AllianceCreateResponse: &playerpb.AllianceCreateResponse{
+			Alliance: toPBAlliance(created.Alliance),
+			Resource: ToPBResource(player.Resource()),
+		},
+	}
+	reply(response)
+}
+
+func (h *PlayerHandler) HandleAllianceJoinRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceJoinRequest) {
+	if request.AllianceId <= 0 {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	if p.Entity().AllianceID() > 0 {
+		ctx.Respond(fail("already in alliance"))
+		return
+	}
+	h.requestAlliance(ctx, p, &messages.HAJoinAlliance{
+		AllianceBaseMessage: p.allianceBase(int(request.AllianceId)),
+		NickName:            p.Entity().Profile().NickName(),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceJoinResponse{
+			AllianceJoinResponse: &playerpb.AllianceJoinResponse{},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceVerifyRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceVerifyRequest) {
+	if request.PlayerId <= 0 {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	h.requestAlliance(ctx, p, &messages.HAVerifyApply{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.PlayerId),
+		Decide:              messages.AllianceApplyStatus(request.Decide),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceVerifyResponse{
+			AllianceVerifyResponse: &playerpb.AllianceVerifyResponse{
+				PlayerId: request.PlayerId,
+				Decide:   request.Decide,
+			},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceExitRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceExitRequest) {
+	h.requestAlliance(ctx, p, &messages.HALeaveAlliance{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+	}, func() *playerpb.PlayerResponse {
+		PS.SetAlliance(ctx, p, 0, "")
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceExitResponse{
+			AllianceExitResponse: &playerpb.AllianceExitResponse{},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceKickRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceKickRequest) {
+	if request.PlayerId <= 0 || PlayerID(request.PlayerId) == *p.PlayerId {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	h.requestAlliance(ctx, p, &messages.HAKickMember{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.PlayerId),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceKickResponse{
+			AllianceKickResponse: &playerpb.AllianceKickResponse{PlayerId: request.PlayerId},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceDismissRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceDismissRequest) {
+	h.requestAlliance(ctx, p, &messages.HADismissAlliance{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+	}, func() *playerpb.PlayerResponse {
+		PS.SetAlliance(ctx, p, 0, "")
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceDismissResponse{
+			AllianceDismissResponse: &playerpb.AllianceDismissResponse{},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceAppointRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceAppointRequest) {
+	if request.PlayerId <= 0 {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	h.requestAlliance(ctx, p, &messages.HAAppointTitle{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.PlayerId),
+		Title:               messages.AllianceTitle(request.Title),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceAppointResponse{
+			AllianceAppointResponse: &playerpb.AllianceAppointResponse{
+				PlayerId: request.PlayerId,
+				Title:    request.Title,
+			},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceTransferRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceTransferRequest) {
+	if request.PlayerId <= 0 {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	h.requestAlliance(ctx, p, &messages.HATransferChairman{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.PlayerId),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceTransferResponse{
+			AllianceTransferResponse: &playerpb.AllianceTransferResponse{PlayerId: request.PlayerId},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceNoticeRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceNoticeRequest) {
+	text := strings.TrimSpace(request.Text)
+	h.requestAlliance(ctx, p, &messages.HAEditNotice{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		Text:                text,
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceNoticeResponse{
+			AllianceNoticeResponse: &playerpb.AllianceNoticeResponse{Text: text},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceLogRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceLogRequest) {
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	if allianceID <= 0 || alliancePID == nil {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	f := ctx.RequestFuture(alliancePID, &messages.HAAllianceLog{
+		AllianceBaseMessage: p.allianceBase(allianceID),
+		Page:                int(request.Page),
+		Size:                int(request.Size),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		logRes, isLog := res.(*messages.AHAllianceLog)
+		if err != nil || !isLog || !logRes.OK {
+			ctx.Respond(fail("query alliance log failed"))
+			return
+		}
+		logs := make([]*playerpb.AllianceLog, 0, len(logRes.Logs))
+		for _, v := range logRes.Logs {
+			logs = append(logs, toPBAllianceLog(v))
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceLogResponse{
+			AllianceLogResponse: &playerpb.AllianceLogResponse{
+				Logs:  logs,
+				Total: int32(logRes.Total),
+			},
+		}
+		ctx.Respond(response)
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceMarkAddRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceMarkAddRequest) {
+	h.requestAlliance(ctx, p, &messages.HAAddMark{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		Pos:                 messages.Pos{X: int(request.X), Y: int(request.Y)},
+		Kind:                messages.AllianceMarkKind(request.Kind),
+		Label:               request.Label,
+		Expire:              int(request.Expire),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceMarkAddResponse{
+			AllianceMarkAddResponse: &playerpb.AllianceMarkAddResponse{},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceMarkDelRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceMarkDelRequest) {
+	h.requestAlliance(ctx, p, &messages.HADelMark{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		Id:                  int(request.Id),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceMarkDelResponse{
+			AllianceMarkDelResponse: &playerpb.AllianceMarkDelResponse{Id: request.Id},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleAllianceMarkListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.AllianceMarkListRequest) {
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	if allianceID <= 0 || alliancePID == nil {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	f := ctx.RequestFuture(alliancePID, &messages.HAMarkList{
+		AllianceBaseMessage: p.allianceBase(allianceID),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		markRes, isMark := res.(*messages.AHMarkList)
+		if err != nil || !isMark || !markRes.OK {
+			ctx.Respond(fail("query alliance mark failed"))
+			return
+		}
+		marks := make([]*playerpb.AllianceMark, 0, len(markRes.Marks))
+		for _, v := range markRes.Marks {
+			marks = append(marks, &playerpb.AllianceMark{
+				Id:        int32(v.Id),
+				X:         int32(v.Pos.X),
+				Y:         int32(v.Pos.Y),
+				Kind:      playerpb.AllianceMarkKind(v.Kind),
+				Label:     v.Label,
+				CreatorId: int32(v.CreatorId),
+				Expire:    v.Expire,
+			})
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_AllianceMarkListResponse{
+			AllianceMarkListResponse: &playerpb.AllianceMarkListResponse{Marks: marks},
+		}
+		ctx.Respond(response)
+	})
+}
+
+// HandleDiplomacyProposeRequest 盟主提出外交变更，对方联盟由 manager 校验
+func (h *PlayerHandler) HandleDiplomacyProposeRequest(ctx actor.Context, p *PlayerActor, request *playerpb.DiplomacyProposeRequest) {
+	h.requestAlliance(ctx, p, &messages.HAProposeDiplomacy{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.TargetId),
+		State:               messages.DiplomacyState(request.State),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_DiplomacyProposeResponse{
+			DiplomacyProposeResponse: &playerpb.DiplomacyProposeResponse{TargetId: request.TargetId, State: request.State},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleDiplomacyAcceptRequest(ctx actor.Context, p *PlayerActor, request *playerpb.DiplomacyAcceptRequest) {
+	h.requestAlliance(ctx, p, &messages.HAAcceptDiplomacy{
+		AllianceBaseMessage: p.allianceBase(int(p.Entity().AllianceID())),
+		TargetId:            int(request.TargetId),
+	}, func() *playerpb.PlayerResponse {
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_DiplomacyAcceptResponse{
+			DiplomacyAcceptResponse: &playerpb.DiplomacyAcceptResponse{TargetId: request.TargetId},
+		}
+		return response
+	})
+}
+
+func (h *PlayerHandler) HandleDiplomacyListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.DiplomacyListRequest) {
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	if allianceID <= 0 || alliancePID == nil {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	f := ctx.RequestFuture(alliancePID, &messages.HADiplomacyList{
+		AllianceBaseMessage: p.allianceBase(allianceID),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		listRes, isList := res.(*messages.AHDiplomacyList)
+		if err != nil || !isList || !listRes.OK {
+			ctx.Respond(fail("query diplomacy failed"))
+			return
+		}
+		relations := make([]*playerpb.Diplomacy, 0, len(listRes.Relations))
+		for _, v := range listRes.Relations {
+			relations = append(relations, &playerpb.Diplomacy{
+				AllianceId: int32(v.AllianceId),
+				Name:       v.Name,
+				State:      playerpb.DiplomacyState(v.State),
+				Until:      v.Until,
+				Proposal:   playerpb.DiplomacyState(v.Proposal),
+				Incoming:   v.Incoming,
+			})
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_DiplomacyListResponse{
+			DiplomacyListResponse: &playerpb.DiplomacyListResponse{Relations: relations},
+		}
+		ctx.Respond(response)
+	})
+}
+
+// HandleAHAllianceChanged 联盟通知成员变化。加入时已在其他联盟则拒绝，离开时只处理当前所在联盟
+func (h *PlayerHandler) HandleAHAllianceChanged(ctx actor.Context, p *PlayerActor, msg *messages.AHAllianceChanged) {
+	player := p.Entity()
+	current := int(player.AllianceID())
+	if msg.Join {
+		if current > 0 && current != msg.AllianceId {
+			ctx.Respond(&messages.PHAllianceChanged{OK: false})
+			return
+		}
+		PS.SetAlliance(ctx, p, AllianceID(msg.AllianceId), msg.AllianceName)
+	} else if current == msg.AllianceId {
+		PS.SetAlliance(ctx, p, 0, "")
+	}
+	if err := p.DC().FlushSync(context.TODO()); err != nil {
+		ctx.Logger().Error("player flush failed", "player_id", p.PlayerId, "err", err)
+	}
+
+	resp := &messages.PHAllianceChanged{OK: true}
+	if main, found := findCity(player, 0); found {
+		resp.Pos = messages.Pos{X: main.x, Y: main.y}
+	}
+	ctx.Respond(resp)
+}
+
+// requestAlliance 转发联盟写操作，成功后由 onOK 组装响应
+func (h *PlayerHandler) requestAlliance(ctx actor.Context, p *PlayerActor, req messages.AllianceMessage, onOK func() *playerpb.PlayerResponse) {
+	if req.AllianceID() <= 0 {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	alliancePID := p.AlliancePID()
+	if alliancePID == nil || p.WorldId == nil {
+		ctx.Respond(fail("alliance actor unavailable"))
+		return
+	}
+	f := ctx.RequestFuture(alliancePID, req, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		result, isResult := res.(*messages.AHResult)
+		if err != nil || !isResult || !result.OK {
+			reason := "alliance request failed"
+			if isResult && result.Reason != "" {
+				reason = result.Reason
+			}
+			ctx.Respond(fail(reason))
+			return
+		}
+		response := onOK()
+		if err := p.DC().FlushSync(context.TODO()); err != nil {
+			ctx.Respond(fail(err.Error()))
+			return
+		}
+		ctx.Respond(response)
+	})
+}
+
+func (p *PlayerActor) allianceBase(allianceID int) messages.AllianceBaseMessage {
+	base := messages.AllianceBaseMessage{
+		AllianceId: allianceID,
+		PlayerId:   int(*p.PlayerId),
+	}
+	if p.WorldId != nil {
+		base.WorldId = int(*p.WorldId)
+	}
+	return base
+}
+
+// SetAlliance 更新玩家所属联盟，并同步到 world 的城池和领地
+func (s *PlayerService) SetAlliance(ctx actor.Context, p *PlayerActor, allianceID AllianceID, name string) {
+	player := p.Entity()
+	player.SetAllianceID(allianceID)
+	player.SetAllianceName(name)
+	p.AllianceID = &allianceID
+
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		return
+	}
+	ctx.Send(worldPID, &messages.HWSyncAlliance{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		AllianceId:   int(allianceID),
+		AllianceName: name,
+	})
+}
+
+// TouchAlliance 上线时通知联盟刷新活跃时间和战力
+func (s *PlayerService) TouchAlliance(ctx actor.Context, p *PlayerActor) {
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	if allianceID <= 0 || alliancePID == nil {
+		return
+	}
+	ctx.Send(alliancePID, &messages.HAMemberActive{
+		AllianceBaseMessage: p.allianceBase(allianceID),
+		Power:               s.Power(p.Entity()),
+	})
+}
+
+// Power 玩家战力，按全部军队的兵力计算
+func (s *PlayerService) Power(player *entity.PlayerEntity) int {
+	power := 0
+	player.ForEachArmies(func(k int, v entity.ArmyState) {
+		for _, soldiers := range v.Soldiers {
+			power += soldiers
+		}
+	})
+	return power
+}
+
+// CheckAllianceName 校验联盟名称
+func (s *PlayerService) CheckAllianceName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > basic.BasicConf.Union.NameLen {
+		return fmt.Errorf("alliance name length invalid")
+	}
+	if words.HasBanned(name) {
+		return fmt.Errorf("alliance name contains banned words")
+	}
+	return nil
+}
+
+func allianceReason(res *messages.AHCreateAlliance, fallback string) string {
+	if res == nil || res.Reason == "" {
+		return fallback
+	}
+	return res.Reason
+}
+
+func toPBAllianceLog(in messages.AllianceLog) *playerpb.AllianceLog {
+	return &playerpb.AllianceLog{
+		Kind:       playerpb.AllianceLogKind(in.Kind),
+		OpId:       int32(in.OpId),
+		OpName:     in.OpName,
+		TargetId:   int32(in.TargetId),
+		TargetName: in.TargetName,
+		Title:      playerpb.AllianceTitle(in.Title),
+		X:          int32(in.Pos.X),
+		Y:          int32(in.Pos.Y),
+		Ctime:      in.Ctime,
+	}
+}
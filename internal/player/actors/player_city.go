@@ -0,0 +1,282 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/facility"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 分城军队 id = seq*armyIDStep + order，主城 seq 为 0，军队 id 与 order 相同
+const armyIDStep = 10
+
+// 玩家城池，主城和分城统一用这个结构访问
+type cityRef struct {
+	id     CityID
+	seq    int
+	name   string
+	x      int
+	y      int
+	isMain bool
+}
+
+// findCity 按 id 查找玩家的城池，0 表示主城
+func findCity(player *entity.PlayerEntity, cityID int) (cityRef, bool) {
+	if player == nil {
+		return cityRef{}, false
+	}
+	if cityID == 0 || CityID(cityID) == player.CityID() {
+		city := player.City()
+		if city == nil {
+			return cityRef{}, false
+		}
+		return cityRef{id: player.CityID(), name: city.Name(), x: city.X(), y: city.Y(), isMain: true}, true
+	}
+	sub, ok := player.GetSubCities(CityID(cityID))
+	if !ok {
+		return cityRef{}, false
+	}
+	return subCityRef(sub), true
+}
+
+func subCityRef(sub entity.SubCityState) cityRef {
+	return cityRef{id: sub.CityId, seq: sub.Seq, name: sub.Name, x: sub.X, y: sub.Y}
+}
+
+// armyCity 按军队 id 找到所属城池
+func armyCity(player *entity.PlayerEntity, armyID int) (cityRef, bool) {
+	seq := armyID / armyIDStep
+	if seq == 0 {
+		return findCity(player, 0)
+	}
+	var (
+		ref   cityRef
+		found bool
+	)
+	player.RangeSubCities(func(k CityID, v entity.SubCityState) bool {
+		if v.Seq == seq {
+			ref, found = subCityRef(v), true
+			return false
+		}
+		return true
+	})
+	return ref, found
+}
+
+// armyCityID 军队所属城池 id，找不到时回落到主城
+func armyCityID(player *entity.PlayerEntity, armyID int) CityID {
+	if city, ok := armyCity(player, armyID); ok {
+		return city.id
+	}
+	return player.CityID()
+}
+
+// cityArmyID 城池第 order 队的军队 id
+func cityArmyID(city cityRef, order int) int {
+	return city.seq*armyIDStep + order
+}
+
+func forEachCityFacility(player *entity.PlayerEntity, city cityRef, fn func(i int, v entity.FacilityState)) {
+	if city.isMain {
+		player.ForEachFacility(fn)
+		return
+	}
+	sub, ok := player.GetSubCities(city.id)
+	if !ok {
+		return
+	}
+	for i, v := range sub.Facilities {
+		fn(i, v)
+	}
+}
+
+// forEachPlayerFacility 遍历主城和所有分城的设施，用于全局产出
+func forEachPlayerFacility(player *entity.PlayerEntity, fn func(v entity.FacilityState)) {
+	player.ForEachFacility(func(i int, v entity.FacilityState) {
+		fn(v)
+	})
+	player.ForEachSubCities(func(k CityID, sub entity.SubCityState) {
+		for _, v := range sub.Facilities {
+			fn(v)
+		}
+	})
+}
+
+// updateCityFacilityAt 修改城池的第 i 个设施
+func updateCityFacilityAt(player *entity.PlayerEntity, city cityRef, i int, fn func(fe *entity.FacilityEntity)) bool {
+	if city.isMain {
+		return player.UpdateFacilityAt(i, fn)
+	}
+	dirty := false
+	player.UpdateSubCities(city.id, func(v *entity.SubCityEntity) {
+		dirty = v.UpdateFacilitiesAt(i, fn)
+	})
+	return dirty
+}
+
+func cityFacilityLevel(player *entity.PlayerEntity, city cityRef, fType int8) int {
+	level := 0
+	forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
+		if v.FType == fType {
+			level = v.PrivateLevel
+		}
+	})
+	return level
+}
+
+// SettleFacilities 结算升级时间已到的设施，返回完成的设施。UpTime 为开始升级的时间
+func (s *PlayerService) SettleFacilities(player *entity.PlayerEntity, nowMS int64) []*gatepb.FacilityDone {
+	if player == nil {
+		return nil
+	}
+	cities := make([]cityRef, 0, 1+player.LenSubCities())
+	if main, ok := findCity(player, 0); ok {
+		cities = append(cities, main)
+	}
+	player.ForEachSubCities(func(k CityID, v entity.SubCityState) {
+		cities = append(cities, subCityRef(v))
+	})
+
+	var done []*gatepb.FacilityDone
+	for _, city := range cities {
+		var finished []int
+		forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
+			if v.UpTime <= 0 {
+				return
+			}
+			cfg, ok := facility.FacilityConf.GetFacility(v.FType)
+			if !ok || cfg == nil {
+				return
+			}
+			levelCfg, ok := cfg.LevelMap[v.PrivateLevel+1]
+			if !ok || v.UpTime+int64(levelCfg.Time)*1000 > nowMS {
+				return
+			}
+			finished = append(finished, i)
+		})
+		for _, i := range finished {
+			var state entity.FacilityState
+			updateCityFacilityAt(player, city, i, func(fe *entity.FacilityEntity) {
+				fe.SetPrivateLevel(fe.PrivateLevel() + 1)
+				fe.SetUpTime(0)
+				state = fe.Save()
+			})
+			done = append(done, &gatepb.FacilityDone{CityId: int64(city.id), Facility: toPBFacility(state)})
+		}
+	}
+	return done
+}
+
+// SubCityPreCheck 校验主城等级是否达到下一座分城的要求，pending 为等待 world 应答的分城数
+func (s *PlayerService) SubCityPreCheck(player *entity.PlayerEntity, pending int) error {
+	main, ok := findCity(player, 0)
+	if !ok {
+		return fmt.Errorf("main city not found")
+	}
+	levels := basic.BasicConf.City.SubCityLevels
+	count := player.LenSubCities() + pending
+	if count >= len(levels) {
+		return fmt.Errorf("sub city limit")
+	}
+	if cityFacilityLevel(player, main, facility.Main) < levels[count] {
+		return fmt.Errorf("main city level not enough")
+	}
+	return nil
+}
+
+// MovePreCheck 迁城冷却，且主城的军队必须都空闲在城内
+func (s *PlayerService) MovePreCheck(player *entity.PlayerEntity, now time.Time) error {
+	if _, ok := findCity(player, 0); !ok {
+		return fmt.Errorf("main city not found")
+	}
+	if attr := player.Attribute(); attr != nil {
+		cd := time.Duration(basic.BasicConf.City.MoveCD) * time.Second
+		if now.Sub(attr.LastMoveTime()) < cd {
+			return fmt.Errorf("move city is cooling down")
+		}
+	}
+	var err error
+	player.RangeArmies(func(k int, v entity.ArmyState) bool {
+		if k/armyIDStep == 0 && (v.Cmd != entity.ArmyCmdIdle || v.Frozen) {
+			err = fmt.Errorf("army is not idle")
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// CheckCityName 校验城池名称
+func (s *PlayerService) CheckCityName(name string) error {
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > basic.BasicConf.City.NameLen {
+		return fmt.Errorf("city name length invalid")
+	}
+	if words.HasBanned(name) {
+		return fmt.Errorf("city name contains banned words")
+	}
+	return nil
+}
+
+// nextSubCitySeq 分城序号只增不减，避免复用旧军队 id
+func (s *PlayerService) nextSubCitySeq(player *entity.PlayerEntity) int {
+	seq := 0
+	player.ForEachSubCities(func(k CityID, v entity.SubCityState) {
+		seq = max(seq, v.Seq)
+	})
+	return seq + 1
+}
+
+func toMessageFacilities(facilities []entity.FacilityState) []messages.Facility {
+	out := make([]messages.Facility, 0, len(facilities))
+	for _, v := range facilities {
+		out = append(out, messages.Facility{
+			Name:         v.Name,
+			PrivateLevel: v.PrivateLevel,
+			FType:        v.FType,
+			UpTime:       v.UpTime,
+		})
+	}
+	return out
+}
+
+func ToPBCity(player *entity.PlayerEntity, city cityRef) *playerpb.City {
+	return &playerpb.City{
+		PlayerId:   int32(player.PlayerID()),
+		CityId:     int64(city.id),
+		Name:       city.name,
+		UnionId:    int32(player.AllianceID()),
+		UnionName:  player.AllianceName(),
+		ParentId:   int32(PS.VassalParent(player, time.Now())),
+		X:          int32(city.x),
+		Y:          int32(city.y),
+		IsMain:     city.isMain,
+		Level:      int32(cityFacilityLevel(player, city, facility.Main)),
+		CurDurable: int32(basic.BasicConf.City.Durable),
+		MaxDurable: int32(basic.BasicConf.City.Durable),
+	}
+}
+
+// ToPBCities 主城在前，分城按序号排列
+func ToPBCities(player *entity.PlayerEntity) []*playerpb.City {
+	cities := make([]*playerpb.City, 0, player.LenSubCities()+1)
+	if main, ok := findCity(player, 0); ok {
+		cities = append(cities, ToPBCity(player, main))
+	}
+	subs := make([]cityRef, 0, player.LenSubCities())
+	player.ForEachSubCities(func(k CityID, v entity.SubCityState) {
+		subs = append(subs, subCityRef(v))
+	})
+	sort.Slice(subs, func(i, j int) bool { return subs[i].seq < subs[j].seq })
+	for _, sub := range subs {
+		cities = append(cities, ToPBCity(player, sub))
+	}
+	return cities
+}
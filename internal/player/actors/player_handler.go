@@ -13,6 +13,7 @@ import (
 	"ThreeKingdoms/internal/shared/utils"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -46,6 +47,7 @@ func (h *PlayerHandler) HandleEnterServerRequest(ctx actor.Context, p *PlayerAct
 		ctx.Respond(resp)
 		return
 	}
+	PS.TouchAlliance(ctx, p)
 
 	player := p.Entity()
 	worldPID := p.WorldPID()
@@ -98,6 +100,28 @@ func (h *PlayerHandler) HandleCreateRole(ctx actor.Context, p *PlayerActor, requ
 	ctx.Respond(resp)
 }
 
+// HandleLogoutRequest 网关会话结束时记录登出时间并清掉 world 里的视野，没有角色的玩家不记登出时间
+func (h *PlayerHandler) HandleLogoutRequest(ctx actor.Context, p *PlayerActor, request *playerpb.LogoutRequest) {
+	if role := p.Entity().Profile(); role != nil {
+		logoutTime := time.UnixMilli(request.LogoutTime)
+		if request.LogoutTime <= 0 {
+			logoutTime = time.Now()
+		}
+		role.SetLogoutTime(logoutTime)
+	}
+	if worldPID := p.WorldPID(); worldPID != nil && p.WorldId != nil {
+		ctx.Send(worldPID, &messages.HWClearView{
+			WorldBaseMessage: messages.WorldBaseMessage{
+				WorldId:  int(*p.WorldId),
+				PlayerId: int(*p.PlayerId),
+			},
+		})
+	}
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_LogoutResponse{LogoutResponse: &playerpb.LogoutResponse{}}
+	ctx.Respond(response)
+}
+
 func (h *PlayerHandler) HandleBuildingConfRequest(ctx actor.Context, p *PlayerActor, request *playerpb.BuildingConfRequest) {
 	buildingConf := building.BuildingConf
 	buildingCfgs := make([]*playerpb.BuildingCfg, 0, len(buildingConf.Cfgs))
@@ -183,17 +207,87 @@ func (h *PlayerHandler) HandleMyPropertyRequest(ctx actor.Context, p *PlayerActo
 }
 
 func (h *PlayerHandler) HandlePosTagListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.PosTagListRequest) {
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_PosTagListResponse{
+		PosTagListResponse: &playerpb.PosTagListResponse{PosTags: ToPBPosTags(p.Entity().Attribute())},
+	}
+	ctx.Respond(response)
+}
+
+func (h *PlayerHandler) HandlePosTagAddRequest(ctx actor.Context, p *PlayerActor, request *playerpb.PosTagAddRequest) {
+	x, y := int(request.X), int(request.Y)
+	if err := PS.CheckPosTag(x, y, request.Name); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
 	attribute := p.Entity().Attribute()
-	tags := make([]*playerpb.PosTag, 0, attribute.LenPosTags())
-	attribute.ForEachPosTags(func(i int, v entity.PosTagState) {
-		tags = append(tags, &playerpb.PosTag{
-			Name: v.Name,
-			X:    int32(v.X),
-			Y:    int32(v.Y),
-		})
+	if attribute.LenPosTags() >= int(basic.BasicConf.Role.PosTagLimit) {
+		ctx.Respond(fail("pos tag limit reached"))
+		return
+	}
+	if findPosTag(attribute, x, y) >= 0 {
+		ctx.Respond(fail("pos tag already exists"))
+		return
+	}
+
+	attribute.AppendPosTags(entity.PosTagState{X: x, Y: y, Name: request.Name})
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_PosTagAddResponse{
+		PosTagAddResponse: &playerpb.PosTagAddResponse{PosTags: ToPBPosTags(attribute)},
+	}
+	ctx.Respond(response)
+}
+
+func (h *PlayerHandler) HandlePosTagRenameRequest(ctx actor.Context, p *PlayerActor, request *playerpb.PosTagRenameRequest) {
+	x, y := int(request.X), int(request.Y)
+	if err := PS.CheckPosTag(x, y, request.Name); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+	attribute := p.Entity().Attribute()
+	index := findPosTag(attribute, x, y)
+	if index < 0 {
+		ctx.Respond(fail("pos tag not found"))
+		return
+	}
+
+	attribute.UpdatePosTagsAt(index, func(v *entity.PosTagEntity) {
+		v.SetName(request.Name)
 	})
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_PosTagRenameResponse{
+		PosTagRenameResponse: &playerpb.PosTagRenameResponse{PosTags: ToPBPosTags(attribute)},
+	}
+	ctx.Respond(response)
+}
 
-	ctx.Respond(&playerpb.PosTagListResponse{PosTags: tags})
+func (h *PlayerHandler) HandlePosTagDelRequest(ctx actor.Context, p *PlayerActor, request *playerpb.PosTagDelRequest) {
+	attribute := p.Entity().Attribute()
+	index := findPosTag(attribute, int(request.X), int(request.Y))
+	if index < 0 {
+		ctx.Respond(fail("pos tag not found"))
+		return
+	}
+
+	attribute.RemovePosTagsAt(index)
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_PosTagDelResponse{
+		PosTagDelResponse: &playerpb.PosTagDelResponse{PosTags: ToPBPosTags(attribute)},
+	}
+	ctx.Respond(response)
+}
+
+// 按坐标查找收藏，未找到返回 -1
+func findPosTag(attribute *entity.RoleAttributeEntity, x, y int) int {
+	index := -1
+	attribute.RangePosTags(func(i int, v entity.PosTagState) bool {
+		if v.X == x && v.Y == y {
+			index = i
+			return false
+		}
+		return true
+	})
+	return index
 }
 
 func (h *PlayerHandler) HandleMyGeneralsRequest(ctx actor.Context, p *PlayerActor, request *playerpb.MyGeneralsRequest) {
@@ -207,15 +301,22 @@ func (h *PlayerHandler) HandleMyGeneralsRequest(ctx actor.Context, p *PlayerActo
 
 func (h *PlayerHandler) HandleArmyListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.ArmyListRequest) {
 	player := p.Entity()
+	city, b := findCity(player, int(request.CityId))
 	pbArmies := make([]*playerpb.Army, 0, player.LenArmies())
 	player.ForEachArmies(func(i int, v entity.ArmyState) {
-		pbArmies = append(pbArmies, ToPBArmy(player.CityID(), v))
+		if b && v.Id/armyIDStep == city.seq {
+			pbArmies = append(pbArmies, ToPBArmy(city.id, v))
+		}
 	})
 
-	ctx.Respond(&playerpb.ArmyListResponse{
-		CityId: request.CityId,
-		Armies: pbArmies,
-	})
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_ArmyListResponse{
+		ArmyListResponse: &playerpb.ArmyListResponse{
+			CityId: request.CityId,
+			Armies: pbArmies,
+		},
+	}
+	ctx.Respond(response)
 }
 
 func (h *PlayerHandler) HandleWarReportRequest(ctx actor.Context, p *PlayerActor, request *playerpb.WarReportRequest) {
@@ -224,9 +325,13 @@ func (h *PlayerHandler) HandleWarReportRequest(ctx actor.Context, p *PlayerActor
 	player.ForEachWarReports(func(i int, v entity.WarReportState) {
 		warReports = append(warReports, ToPBWarReport(player.CityID(), v))
 	})
-	ctx.Respond(&playerpb.WarReportResponse{
-		WarReports: warReports,
-	})
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_WarReportResponse{
+		WarReportResponse: &playerpb.WarReportResponse{
+			WarReports: warReports,
+		},
+	}
+	ctx.Respond(response)
 }
 
 func (h *PlayerHandler) HandleWHWarReport(ctx actor.Context, p *PlayerActor, message *messages.WHWarReport) {
@@ -239,18 +344,38 @@ func (h *PlayerHandler) HandleWHWarReport(ctx actor.Context, p *PlayerActor, mes
 		return
 	}
 	player.PutWarReports(state.Id, state)
+	if err := pushToPlayer(context.Background(), p.pusher, player, &gatepb.PushItem{
+		Payload: &gatepb.PushItem_WarReport{WarReport: ToPBWarReport(player.CityID(), state)},
+	}); err != nil {
+		ctx.Logger().Error("push war report failed", "player_id", p.PlayerId, "report_id", state.Id, "err", err)
+	}
 }
 
 func (h *PlayerHandler) HandleWHBattleResult(ctx actor.Context, p *PlayerActor, message *messages.WHBattleResult) {
 	if p == nil || p.Entity() == nil || message == nil {
 		return
 	}
-	state, ok := applyBattleResult(p.Entity(), message.Army)
+	player := p.Entity()
+	state, ok := applyBattleResult(player, message.Army)
 	if !ok {
 		return
 	}
-	if err := pushArmyUpdate(context.Background(), p.pusher, p.Entity(), state); err != nil {
-		ctx.Logger().Error("push battle result army failed", "player_id", p.PlayerId, "army_id", state.Id, "err", err)
+	army := ToPBArmy(armyCityID(player, state.Id), state)
+	result := &gatepb.BattleResult{Army: army}
+	for _, id := range state.Generals {
+		if id <= 0 {
+			continue
+		}
+		if g, ok := player.GetGenerals(id); ok {
+			result.Generals = append(result.Generals, ToPBGeneral(g))
+		}
+	}
+	// army.push 保持原来的军队刷新，battle.push 另外带上参战武将的经验和兵力变化
+	if err := pushToPlayer(context.Background(), p.pusher, player,
+		&gatepb.PushItem{Payload: &gatepb.PushItem_Army{Army: army}},
+		&gatepb.PushItem{Payload: &gatepb.PushItem_BattleResult{BattleResult: result}},
+	); err != nil {
+		ctx.Logger().Error("push battle result failed", "player_id", p.PlayerId, "army_id", state.Id, "err", err)
 	}
 }
 
@@ -267,15 +392,47 @@ func (h *PlayerHandler) HandleWHArmySync(ctx actor.Context, p *PlayerActor, mess
 	}
 }
 
+// HandleWHReclamationYield 屯田到达后结算领地产出，沦陷期间同样按比例上供
+func (h *PlayerHandler) HandleWHReclamationYield(ctx actor.Context, p *PlayerActor, msg *messages.WHReclamationYield) {
+	player := p.Entity()
+	now := time.Now()
+	gain := entity.ResourceState{
+		Wood:  max(msg.Wood, 0),
+		Iron:  max(msg.Iron, 0),
+		Stone: max(msg.Stone, 0),
+		Grain: max(msg.Grain, 0),
+	}
+	tribute := PS.Tribute(player, gain, now)
+	gain.Wood -= tribute.Wood
+	gain.Iron -= tribute.Iron
+	gain.Stone -= tribute.Stone
+	gain.Grain -= tribute.Grain
+	Gain(player.Resource(), gain)
+	PS.RecordLedger(player, LedgerReclamation, gain)
+	PS.PayTribute(ctx, p, tribute, now)
+	if err := p.DC().FlushSync(context.TODO()); err != nil {
+		ctx.Logger().Error("player flush failed", "player_id", p.PlayerId, "err", err)
+	}
+	if err := pushToPlayer(context.Background(), p.pusher, player, &gatepb.PushItem{
+		Payload: &gatepb.PushItem_Resource{Resource: ToPBResource(player.Resource())},
+	}); err != nil {
+		ctx.Logger().Error("push reclamation yield failed", "player_id", p.PlayerId, "army_id", msg.ArmyId, "err", err)
+	}
+}
+
 func (h *PlayerHandler) HandleSkillListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.SkillListRequest) {
 	player := p.Entity()
 	skills := make([]*playerpb.Skill, 0, player.LenSkills())
 	player.ForEachSkills(func(i int, v entity.SkillState) {
 		skills = append(skills, ToPBSkill(v))
 	})
-	ctx.Respond(&playerpb.SkillListResponse{
-		Skills: skills,
-	})
+	response := ok()
+	response.Body = &playerpb.PlayerResponse_SkillListResponse{
+		SkillListResponse: &playerpb.SkillListResponse{
+			Skills: skills,
+		},
+	}
+	ctx.Respond(response)
 }
 
 func (h *PlayerHandler) HandleScanBlockRequest(ctx actor.Context, p *PlayerActor, request *playerpb.ScanBlockRequest) {
@@ -359,41 +516,72 @@ func (h *PlayerHandler) HandleOpenCollectionRequest(ctx actor.Context, p *Player
 }
 
 func (h *PlayerHandler) HandleCollectionRequest(ctx actor.Context, p *PlayerActor, request *playerpb.CollectionRequest) {
-	player := p.Entity()
-	interval := basic.BasicConf.Role.CollectInterval
-	limit := basic.BasicConf.Role.CollectTimesLimit
-	if p == nil || player == nil || player.Attribute() == nil {
-		ctx.Respond(fail("player attribute not initialized"))
+	if err := h.collectPreCheck(p, time.Now()); err != nil {
+		ctx.Respond(fail(err.Error()))
 		return
 	}
-	attribute := player.Attribute()
-	collectTimes := attribute.CollectTimes()
-
-	if collectTimes >= limit {
-		ctx.Respond(fail("collect times limit exceeded"))
+	// 联盟占领的系统建筑提供产出加成，查询失败时按无加成处理
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	if allianceID <= 0 || alliancePID == nil {
+		h.collect(ctx, p, 0)
 		return
 	}
+	f := ctx.RequestFuture(alliancePID, &messages.HAAllianceBonus{AllianceBaseMessage: p.allianceBase(allianceID)}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		bonus := 0
+		if result, isBonus := res.(*messages.AHAllianceBonus); err == nil && isBonus && result.OK {
+			bonus = result.Yield
+		}
+		h.collect(ctx, p, bonus)
+	})
+}
 
-	lastCollectTime := attribute.LastCollectTime()
-	now := time.Now()
-	nextCollectTime := lastCollectTime.Add(time.Duration(interval) * time.Second)
-
+func (h *PlayerHandler) collectPreCheck(p *PlayerActor, now time.Time) error {
+	if p == nil || p.Entity() == nil || p.Entity().Attribute() == nil {
+		return fmt.Errorf("player attribute not initialized")
+	}
+	attribute := p.Entity().Attribute()
+	collectTimes := attribute.CollectTimes()
+	if collectTimes >= basic.BasicConf.Role.CollectTimesLimit {
+		return fmt.Errorf("collect times limit exceeded")
+	}
+	nextCollectTime := attribute.LastCollectTime().Add(time.Duration(basic.BasicConf.Role.CollectInterval) * time.Second)
 	if collectTimes != 0 && nextCollectTime.After(now) {
-		ctx.Respond(fail("in cd can not operate"))
+		return fmt.Errorf("in cd can not operate")
+	}
+	return nil
+}
+
+// collect 征收金币，bonus 为联盟产出加成百分比
+func (h *PlayerHandler) collect(ctx actor.Context, p *PlayerActor, bonus int) {
+	now := time.Now()
+	// 等待联盟应答期间可能已经领取过，重新校验
+	if err := h.collectPreCheck(p, now); err != nil {
+		ctx.Respond(fail(err.Error()))
 		return
 	}
+	player := p.Entity()
+	attribute := player.Attribute()
+	interval := basic.BasicConf.Role.CollectInterval
+	limit := basic.BasicConf.Role.CollectTimesLimit
 
 	// 最终的产量 = 建筑 + 城池设施收益
 
 	// 城池设施收益
 	yield := ComputeFacilityYield(player)
+	yield.Gold += yield.Gold * max(bonus, 0) / 100
+	// 沦陷期间按比例上供给上级联盟
+	tribute := PS.Tribute(player, entity.ResourceState{Gold: yield.Gold}, now)
+	yield.Gold -= tribute.Gold
 
 	// 更新状态
 	attribute.SetLastCollectTime(now)
-	collectTimes = collectTimes + 1
+	collectTimes := attribute.CollectTimes() + 1
 	attribute.SetCollectTimes(collectTimes)
 	gold := player.Resource().Gold() + yield.Gold
 	player.Resource().SetGold(gold)
+	PS.PayTribute(ctx, p, tribute, now)
 
 	// 下一次领取时间
 	nextTime := now.Add(time.Duration(interval) * time.Second).UnixMilli()
@@ -424,6 +612,12 @@ func (h *PlayerHandler) HandleAllianceListRequest(ctx actor.Context, p *PlayerAc
 		AllianceBaseMessage: messages.AllianceBaseMessage{
 			WorldId: int(*p.WorldId),
 		},
+		Keyword: request.GetKeyword(),
+		Prefix:  request.GetPrefix(),
+		Sort:    messages.AllianceSort(request.GetSort()),
+		HasFree: request.GetHasFree(),
+		Cursor:  request.GetCursor(),
+		Size:    int(request.GetSize()),
 	}
 	f := ctx.RequestFuture(alliancePID, req, 500*time.Millisecond)
 	ctx.ReenterAfter(f, func(res interface{}, err error) {
@@ -433,11 +627,7 @@ func (h *PlayerHandler) HandleAllianceListRequest(ctx actor.Context, p *PlayerAc
 		}
 		switch msg := res.(type) {
 		case *messages.AHAllianceList:
-			if msg == nil {
-				ctx.Respond(okWithAllianceList(nil))
-				return
-			}
-			ctx.Respond(okWithAllianceList(msg.List))
+			ctx.Respond(okWithAllianceList(msg))
 		default:
 			ctx.Respond(fail("invalid alliance list response type"))
 		}
@@ -609,15 +799,20 @@ func (h *PlayerHandler) HandleFacilitiesRequest(ctx actor.Context, p *PlayerActo
 		ctx.Respond(fail("player state invalid"))
 		return
 	}
+	city, b := findCity(player, int(request.CityId))
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
 	facilities := make([]*playerpb.Facility, 0, player.LenFacility())
-	player.ForEachFacility(func(i int, v entity.FacilityState) {
+	forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
 		facilities = append(facilities, toPBFacility(v))
 	})
 
 	response := ok()
 	response.Body = &playerpb.PlayerResponse_FacilitiesResponse{
 		FacilitiesResponse: &playerpb.FacilitiesResponse{
-			CityId:     int32(player.CityID()),
+			CityId:     int32(city.id),
 			Facilities: facilities,
 		},
 	}
@@ -640,11 +835,12 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 		return
 	}
 
-	playerCityID := int32(player.CityID())
-	if request.GetCityId() <= 0 || request.GetCityId() != playerCityID {
+	city, b := findCity(player, int(request.GetCityId()))
+	if request.GetCityId() <= 0 || !b {
 		ctx.Respond(fail("city id mismatch"))
 		return
 	}
+	playerCityID := int32(city.id)
 
 	fType := int8(request.GetFType())
 	nowMS := time.Now().UnixMilli()
@@ -656,32 +852,36 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 		facilityState entity.FacilityState
 	)
 
-	player.RangeFacility(func(i int, v entity.FacilityState) bool {
+	facilities := make([]entity.FacilityState, 0, player.LenFacility())
+	forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
+		facilities = append(facilities, v)
+	})
+	for i, v := range facilities {
 		if v.FType != fType {
-			return true
+			continue
 		}
 		found = true
 
 		maxLevel := facility.FacilityConf.MaxLevel(fType)
 		if maxLevel <= 0 {
 			err = fmt.Errorf("facility config not found")
-			return false
+			break
 		}
 
 		// 结算已经完成但尚未清理的升级进度，避免永久卡在升级中状态。
 		if v.UpTime > 0 {
 			if v.UpTime > nowMS {
 				err = fmt.Errorf("facility is upgrading")
-				return false
+				break
 			}
 			if v.PrivateLevel < maxLevel {
-				dirty = player.UpdateFacilityAt(i, func(fe *entity.FacilityEntity) {
+				dirty = updateCityFacilityAt(player, city, i, func(fe *entity.FacilityEntity) {
 					fe.SetPrivateLevel(v.PrivateLevel + 1)
 					fe.SetUpTime(0)
 				}) || dirty
 				v.PrivateLevel++
 			} else {
-				dirty = player.UpdateFacilityAt(i, func(fe *entity.FacilityEntity) {
+				dirty = updateCityFacilityAt(player, city, i, func(fe *entity.FacilityEntity) {
 					fe.SetUpTime(0)
 				}) || dirty
 			}
@@ -690,19 +890,19 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 
 		if v.PrivateLevel >= maxLevel {
 			err = fmt.Errorf("facility level max")
-			return false
+			break
 		}
 
 		nextLevel := v.PrivateLevel + 1
 		cfg, ok := facility.FacilityConf.GetFacility(fType)
 		if !ok || cfg == nil {
 			err = fmt.Errorf("facility config not found")
-			return false
+			break
 		}
 		levelCfg, ok := cfg.LevelMap[nextLevel]
 		if !ok {
 			err = fmt.Errorf("facility level config not found")
-			return false
+			break
 		}
 
 		cost := entity.ResourceState{
@@ -713,17 +913,19 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 			Gold:   levelCfg.Need.Gold,
 			Decree: levelCfg.Need.Decree,
 		}
+		SettleDecree(player.Resource(), nowMS)
 		if !Consume(player.Resource(), cost) {
 			err = fmt.Errorf("resource is not enough")
-			return false
+			break
 		}
 
-		dirty = player.UpdateFacilityAt(i, func(fe *entity.FacilityEntity) {
+		dirty = updateCityFacilityAt(player, city, i, func(fe *entity.FacilityEntity) {
 			fe.SetUpTime(nowMS)
 		}) || dirty
-		facilityState, _ = player.AtFacility(i)
-		return false
-	})
+		facilityState = v
+		facilityState.UpTime = nowMS
+		break
+	}
 
 	if err != nil {
 		ctx.Respond(fail(err.Error()))
@@ -749,7 +951,7 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 		return
 	}
 
-	facilitiesForSync := collectFacilitiesForWorldSync(player)
+	facilitiesForSync := collectFacilitiesForWorldSync(player, city)
 	future := ctx.RequestFuture(worldPID, &messages.HWSyncCityFacility{
 		WorldBaseMessage: messages.WorldBaseMessage{
 			WorldId:  int(*p.WorldId),
@@ -777,63 +979,119 @@ func (h *PlayerHandler) HandleUpFacilityRequest(ctx actor.Context, p *PlayerActo
 	})
 }
 
-func collectFacilitiesForWorldSync(player *entity.PlayerEntity) []messages.Facility {
-	if player == nil || player.LenFacility() == 0 {
+func collectFacilitiesForWorldSync(player *entity.PlayerEntity, city cityRef) []messages.Facility {
+	if player == nil {
 		return nil
 	}
-	facilities := make([]messages.Facility, 0, player.LenFacility())
-	player.ForEachFacility(func(i int, v entity.FacilityState) {
-		facilities = append(facilities, messages.Facility{
-			Name:         v.Name,
-			PrivateLevel: v.PrivateLevel,
-			FType:        v.FType,
-			UpTime:       v.UpTime,
-		})
+	facilities := make([]entity.FacilityState, 0, player.LenFacility())
+	forEachCityFacility(player, city, func(i int, v entity.FacilityState) {
+		facilities = append(facilities, v)
 	})
-	return facilities
+	if len(facilities) == 0 {
+		return nil
+	}
+	return toMessageFacilities(facilities)
 }
 
 func (h *PlayerHandler) HandleTransformRequest(ctx actor.Context, p *PlayerActor, request *playerpb.TransformRequest) {
-	player := p.Entity()
-	if f, ok := player.AtFacility(facility.JiShi); !ok || f.PrivateLevel <= 0 {
-		ctx.Respond(fail("f is unlock"))
+	if len(request.From) != 4 || len(request.To) != 4 {
+		ctx.Respond(fail("request param invalid"))
+		return
+	}
+	var from, to [4]int
+	amount := 0
+	for i := 0; i < 4; i++ {
+		if request.From[i] < 0 || request.To[i] < 0 {
+			ctx.Respond(fail("request param invalid"))
+			return
+		}
+		from[i] = int(request.From[i])
+		to[i] = int(request.To[i])
+		amount += from[i]
+	}
+	if amount <= 0 {
+		ctx.Respond(fail("request param invalid"))
 		return
 	}
 
-	from := make([]int, 0, 4)
-	to := make([]int, 0, 4)
-	for _, v := range request.From {
-		from = append(from, int(v))
+	player := p.Entity()
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
 	}
-	for _, v := range request.To {
-		to = append(to, int(v))
+	rate, unlocked := PS.TransformRate(player)
+	if !unlocked {
+		ctx.Respond(fail("market is unlock"))
+		return
 	}
 
-	isEnough := Consume(player.Resource(), entity.ResourceState{
-		Wood:  from[0],
-		Iron:  from[1],
-		Stone: from[2],
-		Grain: from[3],
-	})
+	// 每日兑换上限按卖出的资源总量计算
+	now := time.Now()
+	attribute := player.Attribute()
+	used := attribute.TradeAmount()
+	if !IsSameDayCST(attribute.LastTradeTime(), now) {
+		used = 0
+	}
+	dailyLimit := basic.BasicConf.Market.DailyLimit
+	if used+amount > dailyLimit {
+		ctx.Respond(fail("daily exchange limit reached"))
+		return
+	}
 
-	if !isEnough {
+	// 先扣除卖出的资源，world 拒绝时退还
+	cost := entity.ResourceState{Wood: from[0], Iron: from[1], Stone: from[2], Grain: from[3]}
+	if !Consume(player.Resource(), cost) {
 		ctx.Respond(fail("resource not enough"))
 		return
 	}
 
-	Gain(player.Resource(), entity.ResourceState{
-		Wood:  to[0],
-		Iron:  to[1],
-		Stone: to[2],
-		Grain: to[3],
-	})
+	f := ctx.RequestFuture(worldPID, &messages.HWMarketTrade{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		From: from,
+		To:   to,
+		Rate: rate,
+	}, 500*time.Millisecond)
 
-	_ = p.DC().FlushSync(context.TODO())
-	response := ok()
-	response.Body = &playerpb.PlayerResponse_UpFacilityResponse{
-		UpFacilityResponse: &playerpb.UpFacilityResponse{},
-	}
-	ctx.Respond(response)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		tradeRes, isTrade := res.(*messages.WHMarketTrade)
+		if err != nil || !isTrade || !tradeRes.OK {
+			Gain(player.Resource(), cost)
+			ctx.Respond(fail("exchange rate mismatch"))
+			return
+		}
+
+		gain := entity.ResourceState{Wood: to[0], Iron: to[1], Stone: to[2], Grain: to[3]}
+		Gain(player.Resource(), gain)
+		attribute.SetTradeAmount(used + amount)
+		attribute.SetLastTradeTime(now)
+		PS.RecordLedger(player, LedgerMarketTransform, entity.ResourceState{
+			Wood:  gain.Wood - cost.Wood,
+			Iron:  gain.Iron - cost.Iron,
+			Stone: gain.Stone - cost.Stone,
+			Grain: gain.Grain - cost.Grain,
+		})
+		_ = p.DC().FlushSync(context.TODO())
+
+		prices := make([]int32, 0, len(tradeRes.Prices))
+		for _, v := range tradeRes.Prices {
+			prices = append(prices, int32(v))
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_TransformResponse{
+			TransformResponse: &playerpb.TransformResponse{
+				Resource:   ToPBResource(player.Resource()),
+				Rate:       int32(rate),
+				Prices:     prices,
+				DailyUsed:  int32(used + amount),
+				DailyLimit: int32(dailyLimit),
+			},
+		}
+		ctx.Respond(response)
+	})
 }
 
 func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor, request *playerpb.DisposeRequest) {
@@ -845,9 +1103,14 @@ func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor,
 		ctx.Respond(fail("request param is invalid"))
 		return
 	}
-	// 校场每升一级一个队伍
 	player := p.Entity()
-	if f, b := player.AtFacility(facility.JiaoChang); !b || f.PrivateLevel < order {
+	city, b := findCity(player, int(request.CityId))
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+	// 校场每升一级一个队伍，每座城池各自计算
+	if cityFacilityLevel(player, city, facility.JiaoChang) < order {
 		ctx.Respond(fail("order is unlock"))
 		return
 	}
@@ -858,15 +1121,15 @@ func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor,
 		return
 	}
 
-	army, b := player.GetArmies(order)
-	x := player.City().X()
-	y := player.City().Y()
+	armyID := cityArmyID(city, order)
+	army, b := player.GetArmies(armyID)
+	x := city.x
+	y := city.y
 	if !b {
-		army.Id = order
 		army = entity.ArmyState{
-			Id:                order,
+			Id:                armyID,
 			Order:             int8(order),
-			CityId:            player.CityID(),
+			CityId:            city.id,
 			Generals:          []int{0, 0, 0},
 			Soldiers:          []int{0, 0, 0},
 			ConscriptCounts:   []int{0, 0, 0},
@@ -939,21 +1202,8 @@ func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor,
 			return
 		}
 		// position == 2 是前锋，判断是否能配前锋
-		var err error
-		if position == 2 {
-			player.RangeFacility(func(i int, v entity.FacilityState) bool {
-				if v.FType == facility.TongShuaiTing {
-					if v.PrivateLevel <= 0 {
-						err = fmt.Errorf("TongShuaiTing is unlock")
-					}
-					return false
-				}
-				return true
-			})
-		}
-
-		if err != nil {
-			ctx.Respond(fail(err.Error()))
+		if position == 2 && cityFacilityLevel(player, city, facility.TongShuaiTing) <= 0 {
+			ctx.Respond(fail("TongShuaiTing is unlock"))
 			return
 		}
 
@@ -967,7 +1217,7 @@ func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor,
 			cost += general.General.Cost(curGeneral.CfgId)
 		}
 
-		if GetCost(player) < cost {
+		if GetCost(player, city) < cost {
 			ctx.Respond(fail("cost is insufficient"))
 			return
 		}
@@ -989,20 +1239,19 @@ func (h *PlayerHandler) HandleDisposeRequest(ctx actor.Context, p *PlayerActor,
 		player.UpdateGenerals(opGeneral.Id, func(value *entity.GeneralEntity) {
 			value.SetCurArms(order)
 			value.SetOrder(int8(order))
-			value.SetCityId(int(request.CityId))
+			value.SetCityId(int(city.id))
 		})
 
-		player.UpdateArmies(army.Id, func(value *entity.ArmyEntity) {
-			value.SetFromX(player.City().X())
-			value.SetFromY(player.City().Y())
-		})
+		army.FromX = x
+		army.FromY = y
+		player.PutArmies(army.Id, army)
 	}
 
-	army, _ = player.GetArmies(order)
+	army, _ = player.GetArmies(armyID)
 	response := ok()
 	response.Body = &playerpb.PlayerResponse_DisposeResponse{
 		DisposeResponse: &playerpb.DisposeResponse{
-			Army: ToPBArmy(player.CityID(), army),
+			Army: ToPBArmy(city.id, army),
 		},
 	}
 	ctx.Respond(response)
@@ -1017,7 +1266,7 @@ func (h *PlayerHandler) HandleResourceRecovery(ctx actor.Context, p *PlayerActor
 	player := p.Entity()
 	// 每秒的产出
 	var yield facility.FacilityYield
-	player.ForEachFacility(func(i int, v entity.FacilityState) {
+	forEachPlayerFacility(player, func(v entity.FacilityState) {
 		f, b := conf.GetFacility(v.FType)
 		if !b {
 			return
@@ -1045,13 +1294,22 @@ func (h *PlayerHandler) HandleResourceRecovery(ctx actor.Context, p *PlayerActor
 	// 计算产出了多少轮
 	turn := int(passedMills / recoveryMills)
 
-	Gain(player.Resource(), entity.ResourceState{
+	gain := entity.ResourceState{
 		Wood:  yield.Wood * turn,
 		Iron:  yield.Iron * turn,
 		Stone: yield.Stone * turn,
 		Grain: yield.Grain * turn,
-	})
+	}
+	// 沦陷期间按比例上供给上级联盟
+	now := time.UnixMilli(nowMills)
+	tribute := PS.Tribute(player, gain, now)
+	gain.Wood -= tribute.Wood
+	gain.Iron -= tribute.Iron
+	gain.Stone -= tribute.Stone
+	gain.Grain -= tribute.Grain
+	Gain(player.Resource(), gain)
 	player.Resource().SetLastClaim(nowMills)
+	PS.PayTribute(ctx, p, tribute, now)
 }
 
 func (h *PlayerHandler) HandleConscriptRequest(ctx actor.Context, p *PlayerActor, request *playerpb.ConscriptRequest) {
@@ -1087,15 +1345,13 @@ func (h *PlayerHandler) HandleConscriptRequest(ctx actor.Context, p *PlayerActor
 			}
 		}
 	}
+	city, b := armyCity(player, armyId)
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
 	// 募兵所等级
-	var level int
-	player.RangeFacility(func(i int, v entity.FacilityState) bool {
-		if v.FType == facility.MBS {
-			level = v.PrivateLevel
-			return false
-		}
-		return true
-	})
+	level := cityFacilityLevel(player, city, facility.MBS)
 	if level <= 0 {
 		ctx.Respond(fail("MBS is unlock"))
 		return
@@ -1112,7 +1368,7 @@ func (h *PlayerHandler) HandleConscriptRequest(ctx actor.Context, p *PlayerActor
 			ctx.Respond(fail("Request param Invalid"))
 			return
 		}
-		add := GetSoldierLimit(player)
+		add := GetSoldierLimit(player, city)
 		// 将领带兵数量+基础设施增加的数量 < 当前带兵数量 + 当前征兵数量
 		if lv.Soldiers+add < int(counts[i])+army.Soldiers[i] {
 			ctx.Respond(fail("out of army limit"))
@@ -1153,7 +1409,7 @@ func (h *PlayerHandler) HandleConscriptRequest(ctx actor.Context, p *PlayerActor
 	response := ok()
 	response.Body = &playerpb.PlayerResponse_ConscriptResponse{
 		ConscriptResponse: &playerpb.ConscriptResponse{
-			Army:     ToPBArmy(player.CityID(), army),
+			Army:     ToPBArmy(city.id, army),
 			Resource: ToPBResource(player.Resource()),
 		},
 	}
@@ -1168,7 +1424,13 @@ func (h *PlayerHandler) HandleArmyInfoRequest(ctx actor.Context, p *PlayerActor,
 	}
 
 	player := p.Entity()
-	army, b := player.GetArmies(order)
+	city, b := findCity(player, int(request.CityId))
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+	armyID := cityArmyID(city, order)
+	army, b := player.GetArmies(armyID)
 	if !b {
 		ctx.Respond(fail("Army Not Found"))
 		return
@@ -1192,14 +1454,14 @@ func (h *PlayerHandler) HandleArmyInfoRequest(ctx actor.Context, p *PlayerActor,
 			army.Cmd = entity.ArmyCmdIdle
 		}
 
-		player.PutArmies(order, army)
+		player.PutArmies(armyID, army)
 	}
 
-	army, _ = player.GetArmies(order)
+	army, _ = player.GetArmies(armyID)
 	response := ok()
 	response.Body = &playerpb.PlayerResponse_ArmyInfoResponse{
 		ArmyInfoResponse: &playerpb.ArmyInfoResponse{
-			Army: ToPBArmy(player.CityID(), army),
+			Army: ToPBArmy(city.id, army),
 		},
 	}
 	ctx.Respond(response)
@@ -1210,7 +1472,7 @@ func (h *PlayerHandler) HandleAssignArmyRequest(ctx actor.Context, p *PlayerActo
 	x := int(request.X)
 	y := int(request.Y)
 	cmd := int(request.Cmd)
-	if armyId <= 0 || armyId > 5 || x < 0 || x > _map.MapWidth || y < 0 || y > _map.MapHeight {
+	if order := armyId % armyIDStep; order <= 0 || order > 5 || x < 0 || x > _map.MapWidth || y < 0 || y > _map.MapHeight {
 		ctx.Respond(fail("Request param Invalid"))
 		return
 	}
@@ -1243,7 +1505,244 @@ func (h *PlayerHandler) HandleAssignArmyRequest(ctx actor.Context, p *PlayerActo
 		PS.Reclamation(ctx, p, army, x, y)
 	case entity.ArmyCmdTransfer:
 		PS.Transfer(ctx, p, army, x, y)
+	case entity.ArmyCmdReinforce:
+		PS.Reinforce(ctx, p, army, x, y)
+	}
+}
+
+func (h *PlayerHandler) HandleGiveUpRequest(ctx actor.Context, p *PlayerActor, request *playerpb.GiveUpRequest) {
+	x := int(request.X)
+	y := int(request.Y)
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	player := p.Entity()
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+
+	// 先扣政令，world 拒绝时退还
+	cost := basic.BasicConf.Build.GiveUpCost
+	if !PS.ConsumeDecree(player.Resource(), cost) {
+		ctx.Respond(fail("decree not enough"))
+		return
+	}
+
+	f := ctx.RequestFuture(worldPID, &messages.HWGiveUp{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		Pos: messages.Pos{X: x, Y: y},
+	}, 500*time.Millisecond)
+
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		giveUpRes, isGiveUp := res.(*messages.WHGiveUp)
+		if err != nil || !isGiveUp || !giveUpRes.OK {
+			Gain(player.Resource(), entity.ResourceState{Decree: cost})
+			ctx.Respond(fail("can't give up the aim"))
+			return
+		}
+
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_GiveUpResponse{
+			GiveUpResponse: &playerpb.GiveUpResponse{
+				X:          request.X,
+				Y:          request.Y,
+				GiveUpTime: timeToMillis(giveUpRes.GiveUpTime),
+				Resource:   ToPBResource(player.Resource()),
+			},
+		}
+		ctx.Respond(response)
+	})
+}
+
+func (h *PlayerHandler) HandleCreateSubCityRequest(ctx actor.Context, p *PlayerActor, request *playerpb.CreateSubCityRequest) {
+	x := int(request.X)
+	y := int(request.Y)
+	name := strings.TrimSpace(request.Name)
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	if err := PS.CheckCityName(name); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+	player := p.Entity()
+	if err := PS.SubCityPreCheck(player, p.subCityPending); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+
+	// 先扣资源，world 拒绝时退还
+	cost := entity.ResourceState{
+		Decree: basic.BasicConf.City.SubCityDecree,
+		Gold:   basic.BasicConf.City.SubCityGold,
+	}
+	SettleDecree(player.Resource(), time.Now().UnixMilli())
+	if !Consume(player.Resource(), cost) {
+		ctx.Respond(fail("resource is not enough"))
+		return
+	}
+
+	// 等待 world 应答期间占住分城名额，避免并发请求越过上限
+	p.subCityPending++
+	facilities := PS.buildInitialFacility()
+	f := ctx.RequestFuture(worldPID, &messages.HWCreateSubCity{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		Pos:        messages.Pos{X: x, Y: y},
+		Name:       name,
+		Facilities: toMessageFacilities(facilities),
+	}, 500*time.Millisecond)
+
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		p.subCityPending--
+		created, isCreated := res.(*messages.WHCreateSubCity)
+		if err != nil || !isCreated || !created.OK {
+			Gain(player.Resource(), cost)
+			ctx.Respond(fail("can't build sub city here"))
+			return
+		}
+
+		subCity := entity.SubCityState{
+			CityId:     CityID(created.CityId),
+			Seq:        PS.nextSubCitySeq(player),
+			Name:       name,
+			X:          x,
+			Y:          y,
+			Facilities: facilities,
+		}
+		player.PutSubCities(subCity.CityId, subCity)
+		PS.RecordLedger(player, LedgerSubCity, entity.ResourceState{
+			Decree: -cost.Decree,
+			Gold:   -cost.Gold,
+		})
+		if err := p.DC().FlushSync(context.TODO()); err != nil {
+			ctx.Respond(fail(err.Error()))
+			return
+		}
+
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_CreateSubCityResponse{
+			CreateSubCityResponse: &playerpb.CreateSubCityResponse{
+				City:     ToPBCity(player, subCityRef(subCity)),
+				Resource: ToPBResource(player.Resource()),
+			},
+		}
+		ctx.Respond(response)
+	})
+}
+
+func (h *PlayerHandler) HandleMoveCityRequest(ctx actor.Context, p *PlayerActor, request *playerpb.MoveCityRequest) {
+	x := int(request.X)
+	y := int(request.Y)
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight {
+		ctx.Respond(fail("Request param Invalid"))
+		return
+	}
+	player := p.Entity()
+	now := time.Now()
+	if err := PS.MovePreCheck(player, now); err != nil {
+		ctx.Respond(fail(err.Error()))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
 	}
+
+	// 先扣资源，world 拒绝时退还
+	cost := entity.ResourceState{
+		Decree: basic.BasicConf.City.MoveDecree,
+		Gold:   basic.BasicConf.City.MoveGold,
+	}
+	SettleDecree(player.Resource(), now.UnixMilli())
+	if !Consume(player.Resource(), cost) {
+		ctx.Respond(fail("resource is not enough"))
+		return
+	}
+	// 等待 world 应答期间先进入冷却，避免并发迁城，拒绝时还原
+	var lastMoveTime time.Time
+	if attr := player.Attribute(); attr != nil {
+		lastMoveTime = attr.LastMoveTime()
+	}
+	player.UpdateAttribute(func(a *entity.RoleAttributeEntity) {
+		a.SetLastMoveTime(now)
+	})
+
+	f := ctx.RequestFuture(worldPID, &messages.HWMoveCity{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId:  int(*p.WorldId),
+			PlayerId: int(*p.PlayerId),
+		},
+		Pos: messages.Pos{X: x, Y: y},
+	}, 500*time.Millisecond)
+
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		moveRes, isMove := res.(*messages.WHMoveCity)
+		if err != nil || !isMove || !moveRes.OK {
+			Gain(player.Resource(), cost)
+			player.UpdateAttribute(func(a *entity.RoleAttributeEntity) {
+				a.SetLastMoveTime(lastMoveTime)
+			})
+			ctx.Respond(fail("can't move city here"))
+			return
+		}
+
+		player.UpdateCity(func(c *entity.CityEntity) {
+			c.SetX(x)
+			c.SetY(y)
+		})
+		// 主城的军队都在城内，跟着一起迁移
+		armyIDs := make([]int, 0, player.LenArmies())
+		player.ForEachArmies(func(k int, v entity.ArmyState) {
+			if k/armyIDStep == 0 {
+				armyIDs = append(armyIDs, k)
+			}
+		})
+		for _, id := range armyIDs {
+			player.UpdateArmies(id, func(v *entity.ArmyEntity) {
+				v.SetCellX(x)
+				v.SetCellY(y)
+				v.SetFromX(x)
+				v.SetFromY(y)
+				v.SetToX(x)
+				v.SetToY(y)
+			})
+		}
+		PS.RecordLedger(player, LedgerMoveCity, entity.ResourceState{
+			Decree: -cost.Decree,
+			Gold:   -cost.Gold,
+		})
+		if err := p.DC().FlushSync(context.TODO()); err != nil {
+			ctx.Respond(fail(err.Error()))
+			return
+		}
+
+		city, _ := findCity(player, 0)
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_MoveCityResponse{
+			MoveCityResponse: &playerpb.MoveCityResponse{
+				City:         ToPBCity(player, city),
+				Resource:     ToPBResource(player.Resource()),
+				NextMoveTime: now.Add(time.Duration(basic.BasicConf.City.MoveCD) * time.Second).UnixMilli(),
+			},
+		}
+		ctx.Respond(response)
+	})
 }
 
 func draw(times int) ([]entity.GeneralState, error) {
@@ -1310,26 +1809,33 @@ func applyBattleResult(player *entity.PlayerEntity, army *messages.Army) (entity
 	return state, true
 }
 
-func pushArmyUpdate(ctx context.Context, pusher gatepb.GatePushServiceClient, player *entity.PlayerEntity, army entity.ArmyState) error {
-	if pusher == nil || player == nil || army.Id <= 0 || player.PlayerID() <= 0 {
+// pushToPlayer 经 gate 推送给玩家本人，items 只需要填 payload
+func pushToPlayer(ctx context.Context, pusher gatepb.GatePushServiceClient, player *entity.PlayerEntity, items ...*gatepb.PushItem) error {
+	if pusher == nil || player == nil || player.PlayerID() <= 0 || len(items) == 0 {
 		return nil
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	_, err := pusher.PushWorldBatch(ctx, &gatepb.PushWorldBatchRequest{
+	for _, item := range items {
+		item.PlayerId = int64(player.PlayerID())
+	}
+	_, err := pusher.PushBatch(ctx, &gatepb.PushBatchRequest{
 		WorldId: int32(player.WorldID()),
-		MsgType: messages.ArmyPush,
-		Items: []*gatepb.WorldPushItem{
-			{
-				PlayerId: int64(player.PlayerID()),
-				Army:     ToPBArmy(player.CityID(), army),
-			},
-		},
+		Items:   items,
 	})
 	return err
 }
 
+func pushArmyUpdate(ctx context.Context, pusher gatepb.GatePushServiceClient, player *entity.PlayerEntity, army entity.ArmyState) error {
+	if player == nil || army.Id <= 0 {
+		return nil
+	}
+	return pushToPlayer(ctx, pusher, player, &gatepb.PushItem{
+		Payload: &gatepb.PushItem_Army{Army: ToPBArmy(armyCityID(player, army.Id), army)},
+	})
+}
+
 func GeneralIsRepeat(p *entity.PlayerEntity, a entity.ArmyState, cfgId int) bool {
 	if p == nil {
 		return true
@@ -1343,9 +1849,9 @@ func GeneralIsRepeat(p *entity.PlayerEntity, a entity.ArmyState, cfgId int) bool
 	return false
 }
 
-func GetCost(p *entity.PlayerEntity) int8 {
+func GetCost(p *entity.PlayerEntity, city cityRef) int8 {
 	cost := 0
-	p.ForEachFacility(func(i int, v entity.FacilityState) {
+	forEachCityFacility(p, city, func(i int, v entity.FacilityState) {
 		if v.PrivateLevel > 0 {
 			f, ok := facility.FacilityConf.GetFacility(v.FType)
 			if ok {
@@ -1363,9 +1869,9 @@ func GetCost(p *entity.PlayerEntity) int8 {
 	return int8(cost)
 }
 
-func GetSoldierLimit(p *entity.PlayerEntity) int {
+func GetSoldierLimit(p *entity.PlayerEntity, city cityRef) int {
 	cost := 0
-	p.ForEachFacility(func(i int, v entity.FacilityState) {
+	forEachCityFacility(p, city, func(i int, v entity.FacilityState) {
 		if v.PrivateLevel > 0 {
 			f, ok := facility.FacilityConf.GetFacility(v.FType)
 			if ok {
@@ -1383,16 +1889,18 @@ func GetSoldierLimit(p *entity.PlayerEntity) int {
 	return cost
 }
 
-func okWithAllianceList(list []messages.Alliance) *playerpb.PlayerResponse {
-	pbList := make([]*playerpb.Alliance, 0, len(list))
-	for _, item := range list {
-		pbList = append(pbList, toPBAlliance(item))
+func okWithAllianceList(list *messages.AHAllianceList) *playerpb.PlayerResponse {
+	body := &playerpb.AllianceListResponse{List: make([]*playerpb.Alliance, 0)}
+	if list != nil {
+		for _, item := range list.List {
+			body.List = append(body.List, toPBAlliance(item))
+		}
+		body.NextCursor = list.NextCursor
+		body.Total = int32(list.Total)
 	}
 	resp := ok()
 	resp.Body = &playerpb.PlayerResponse_AllianceListResponse{
-		AllianceListResponse: &playerpb.AllianceListResponse{
-			List: pbList,
-		},
+		AllianceListResponse: body,
 	}
 	return resp
 }
@@ -1423,12 +1931,25 @@ func toPBAlliance(in messages.Alliance) *playerpb.Alliance {
 			Title: toPBAllianceTitle(major.Title),
 		})
 	}
+	buildings := make([]*playerpb.SysBuilding, 0, len(in.Buildings))
+	for _, b := range in.Buildings {
+		buildings = append(buildings, &playerpb.SysBuilding{
+			X:     int32(b.Pos.X),
+			Y:     int32(b.Pos.Y),
+			Kind:  int32(b.Kind),
+			Level: int32(b.Level),
+		})
+	}
 	return &playerpb.Alliance{
-		Id:     in.Id,
-		Name:   in.Name,
-		Cnt:    in.Cnt,
-		Notice: in.Notice,
-		Major:  majors,
+		Id:        in.Id,
+		Name:      in.Name,
+		Cnt:       in.Cnt,
+		Notice:    in.Notice,
+		Major:     majors,
+		Buildings: buildings,
+		Limit:     in.Limit,
+		Power:     in.Power,
+		Territory: in.Territory,
 	}
 }
 
@@ -0,0 +1,178 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// HandleRallyCreateRequest 先到联盟校验权限，再到 world 创建集结
+func (h *PlayerHandler) HandleRallyCreateRequest(ctx actor.Context, p *PlayerActor, request *playerpb.RallyCreateRequest) {
+	x, y := int(request.X), int(request.Y)
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight {
+		ctx.Respond(fail("request param invalid"))
+		return
+	}
+	allianceID := int(p.Entity().AllianceID())
+	alliancePID := p.AlliancePID()
+	worldPID := p.WorldPID()
+	if allianceID <= 0 || alliancePID == nil {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+
+	f := ctx.RequestFuture(alliancePID, &messages.HARallyCheck{
+		AllianceBaseMessage: p.allianceBase(allianceID),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		result, isResult := res.(*messages.AHResult)
+		if err != nil || !isResult || !result.OK {
+			reason := "rally permission denied"
+			if isResult && result.Reason != "" {
+				reason = result.Reason
+			}
+			ctx.Respond(fail(reason))
+			return
+		}
+		wf := ctx.RequestFuture(worldPID, &messages.HWRallyCreate{
+			WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+			AllianceId:       allianceID,
+			Target:           messages.Pos{X: x, Y: y},
+		}, 500*time.Millisecond)
+		ctx.ReenterAfter(wf, func(res interface{}, err error) {
+			rallyRes, isRally := res.(*messages.WHRally)
+			if err != nil || !isRally || !rallyRes.OK {
+				ctx.Respond(fail("can't rally the aim"))
+				return
+			}
+			response := ok()
+			response.Body = &playerpb.PlayerResponse_RallyCreateResponse{
+				RallyCreateResponse: &playerpb.RallyCreateResponse{Rally: toPBRally(rallyRes.Rally)},
+			}
+			ctx.Respond(response)
+		})
+	})
+}
+
+// HandleRallyJoinRequest 军队前往集结点，和出征一样冻结到 world 回推
+func (h *PlayerHandler) HandleRallyJoinRequest(ctx actor.Context, p *PlayerActor, request *playerpb.RallyJoinRequest) {
+	player := p.Entity()
+	army, b := player.GetArmies(int(request.ArmyId))
+	if !b {
+		ctx.Respond(fail("Army Not Found"))
+		return
+	}
+	if !PS.IsCanOutWar(army) {
+		ctx.Respond(fail("army is busy"))
+		return
+	}
+	if player.AllianceID() <= 0 {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+	city, b := armyCity(player, army.Id)
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+
+	f := ctx.RequestFuture(worldPID, &messages.HWRallyJoin{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+		RallyId:          int(request.RallyId),
+		Army:             PS.toMessageArmy(player, army),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		joinRes, isJoin := res.(*messages.WHRallyJoin)
+		if err != nil || !isJoin || !joinRes.OK {
+			ctx.Respond(fail("can't join the rally"))
+			return
+		}
+		updated := player.UpdateArmies(army.Id, func(v *entity.ArmyEntity) {
+			if v == nil {
+				return
+			}
+			v.SetCmd(entity.ArmyCmdRally)
+			v.SetState(entity.ArmyRunning)
+			v.SetFromX(city.x)
+			v.SetFromY(city.y)
+			v.SetToX(joinRes.RallyPos.X)
+			v.SetToY(joinRes.RallyPos.Y)
+			v.SetStartTime(joinRes.StartTime)
+			v.SetEndTime(joinRes.EndTime)
+			v.SetFrozen(true)
+		})
+		if !updated {
+			ctx.Respond(fail("army not found"))
+			return
+		}
+		a, _ := player.GetArmies(army.Id)
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_RallyJoinResponse{
+			RallyJoinResponse: &playerpb.RallyJoinResponse{Army: ToPBArmy(armyCityID(player, a.Id), a)},
+		}
+		ctx.Respond(response)
+	})
+}
+
+func (h *PlayerHandler) HandleRallyListRequest(ctx actor.Context, p *PlayerActor, request *playerpb.RallyListRequest) {
+	allianceID := int(p.Entity().AllianceID())
+	worldPID := p.WorldPID()
+	if allianceID <= 0 {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+	f := ctx.RequestFuture(worldPID, &messages.HWRallyList{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+		AllianceId:       allianceID,
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		listRes, isList := res.(*messages.WHRallyList)
+		if err != nil || !isList || !listRes.OK {
+			ctx.Respond(fail("query rally list failed"))
+			return
+		}
+		rallies := make([]*playerpb.Rally, 0, len(listRes.Rallies))
+		for _, v := range listRes.Rallies {
+			rallies = append(rallies, toPBRally(v))
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_RallyListResponse{
+			RallyListResponse: &playerpb.RallyListResponse{Rallies: rallies},
+		}
+		ctx.Respond(response)
+	})
+}
+
+func toPBRally(r messages.Rally) *playerpb.Rally {
+	armies := make([]*playerpb.RallyArmy, 0, len(r.Armies))
+	for _, v := range r.Armies {
+		armies = append(armies, &playerpb.RallyArmy{PlayerId: int32(v.PlayerId), ArmyId: int32(v.ArmyId)})
+	}
+	return &playerpb.Rally{
+		Id:       int32(r.Id),
+		LeaderId: int32(r.LeaderId),
+		TargetX:  int32(r.Target.X),
+		TargetY:  int32(r.Target.Y),
+		RallyX:   int32(r.RallyPos.X),
+		RallyY:   int32(r.RallyPos.Y),
+		Deadline: r.Deadline,
+		Armies:   armies,
+	}
+}
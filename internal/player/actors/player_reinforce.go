@@ -0,0 +1,103 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// Reinforce 派军队增援盟友的城池或领地，到达后由 world 回推军队状态
+func (s *PlayerService) Reinforce(ctx actor.Context, p *PlayerActor, army entity.ArmyState, x, y int) {
+	player := p.Entity()
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+	if player.AllianceID() <= 0 {
+		ctx.Respond(fail("not in alliance"))
+		return
+	}
+	city, b := armyCity(player, army.Id)
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+
+	f := ctx.RequestFuture(worldPID,
+		&messages.HWReinforce{
+			WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+			Pos:              messages.Pos{X: x, Y: y},
+			Army:             s.toMessageArmy(player, army),
+		},
+		500*time.Millisecond,
+	)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		reinforceRes, isReinforce := res.(*messages.WHReinforce)
+		if err != nil || !isReinforce || !reinforceRes.OK {
+			ctx.Respond(fail("can't reinforce the aim"))
+			return
+		}
+		updated := player.UpdateArmies(army.Id, func(v *entity.ArmyEntity) {
+			if v == nil {
+				return
+			}
+			v.SetCmd(entity.ArmyCmdReinforce)
+			v.SetState(entity.ArmyRunning)
+			v.SetFromX(city.x)
+			v.SetFromY(city.y)
+			v.SetToX(x)
+			v.SetToY(y)
+			v.SetStartTime(reinforceRes.StartTime)
+			v.SetEndTime(reinforceRes.EndTime)
+			v.SetFrozen(true)
+		})
+		if !updated {
+			ctx.Respond(fail("army not found"))
+			return
+		}
+		a, _ := player.GetArmies(army.Id)
+		AssignArmyResponse(ctx, player, a)
+	})
+}
+
+// HandleReinforceBackRequest 遣返增援军队，军队状态由 world 推送给所属玩家
+func (h *PlayerHandler) HandleReinforceBackRequest(ctx actor.Context, p *PlayerActor, request *playerpb.ReinforceBackRequest) {
+	x, y := int(request.X), int(request.Y)
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight || request.PlayerId <= 0 || request.ArmyId <= 0 {
+		ctx.Respond(fail("request param invalid"))
+		return
+	}
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+	f := ctx.RequestFuture(worldPID, &messages.HWReinforceBack{
+		WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+		Pos:              messages.Pos{X: x, Y: y},
+		OwnerId:          int(request.PlayerId),
+		ArmyId:           int(request.ArmyId),
+	}, 500*time.Millisecond)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		backRes, isBack := res.(*messages.WHReinforceBack)
+		if err != nil || !isBack || !backRes.OK {
+			ctx.Respond(fail("can't send the reinforce back"))
+			return
+		}
+		response := ok()
+		response.Body = &playerpb.PlayerResponse_ReinforceBackResponse{
+			ReinforceBackResponse: &playerpb.ReinforceBackResponse{
+				X:        request.X,
+				Y:        request.Y,
+				PlayerId: request.PlayerId,
+				ArmyId:   request.ArmyId,
+			},
+		}
+		ctx.Respond(response)
+	})
+}
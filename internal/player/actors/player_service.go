@@ -7,6 +7,7 @@ import (
 	"ThreeKingdoms/internal/shared/gameconfig/facility"
 	"ThreeKingdoms/internal/shared/gameconfig/general"
 	_map "ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
 	commonpb "ThreeKingdoms/internal/shared/gen/common"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
 	"ThreeKingdoms/internal/shared/security"
@@ -15,7 +16,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
@@ -36,6 +39,7 @@ func (s *PlayerService) EnterServer(p *PlayerActor) (*playerpb.PlayerResponse, e
 	if err := s.initPlayer(p); err != nil {
 		// 暂时忽略 flushSync 的 err
 	}
+	SettleDecree(player.Resource(), time.Now().UnixMilli())
 
 	token, err := security.Award(int(player.PlayerID()))
 	if err != nil {
@@ -101,6 +105,7 @@ func (s *PlayerService) CreateRole(p *PlayerActor, request *playerpb.CreateRoleR
 }
 
 func (s *PlayerService) MyProperty(player *entity.PlayerEntity) *playerpb.PlayerResponse {
+	SettleDecree(player.Resource(), time.Now().UnixMilli())
 	//建筑
 	buildings := make([]*playerpb.Building, 0, player.LenBuildings())
 	player.ForEachBuildings(func(i int, v entity.BuildingState) {
@@ -116,7 +121,7 @@ func (s *PlayerService) MyProperty(player *entity.PlayerEntity) *playerpb.Player
 	//军队
 	armies := make([]*playerpb.Army, 0, player.LenArmies())
 	player.ForEachArmies(func(k int, v entity.ArmyState) {
-		armies = append(armies, ToPBArmy(player.CityID(), v))
+		armies = append(armies, ToPBArmy(armyCityID(player, v.Id), v))
 	})
 
 	return &playerpb.PlayerResponse{
@@ -126,7 +131,9 @@ func (s *PlayerService) MyProperty(player *entity.PlayerEntity) *playerpb.Player
 				Resource:  resource,
 				Buildings: buildings,
 				Generals:  generals,
+				Cities:    ToPBCities(player),
 				Armies:    armies,
+				PosTags:   ToPBPosTags(player.Attribute()),
 			},
 		},
 	}
@@ -310,6 +317,11 @@ func (s *PlayerService) Attack(ctx actor.Context, p *PlayerActor, army entity.Ar
 		ctx.Respond(fail("world actor unavailable"))
 		return
 	}
+	city, b := armyCity(player, army.Id)
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
 
 	f := ctx.RequestFuture(worldPID,
 		&messages.HWAttack{
@@ -333,8 +345,8 @@ func (s *PlayerService) Attack(ctx actor.Context, p *PlayerActor, army entity.Ar
 
 				v.SetCmd(entity.ArmyCmdAttack)
 				v.SetState(entity.ArmyRunning)
-				v.SetFromX(player.City().X())
-				v.SetFromY(player.City().Y())
+				v.SetFromX(city.x)
+				v.SetFromY(city.y)
 				v.SetToX(x)
 				v.SetToY(y)
 				v.SetStartTime(attackRes.StartTime)
@@ -372,7 +384,7 @@ func (s *PlayerService) toMessageArmy(player *entity.PlayerEntity, army entity.A
 
 	return messages.Army{
 		Id:         army.Id,
-		CityId:     int(army.CityId),
+		CityId:     int(armyCityID(player, army.Id)),
 		PlayerId:   int(army.PlayerId),
 		AllianceId: int(army.AllianceId),
 		Order:      army.Order,
@@ -454,7 +466,62 @@ func (s *PlayerService) Defend(ctx actor.Context, p *PlayerActor, army entity.Ar
 }
 
 func (s *PlayerService) Reclamation(ctx actor.Context, p *PlayerActor, army entity.ArmyState, x int, y int) {
+	player := p.Entity()
+	worldPID := p.WorldPID()
+	if worldPID == nil || p.WorldId == nil {
+		ctx.Respond(fail("world actor unavailable"))
+		return
+	}
+	city, b := armyCity(player, army.Id)
+	if !b {
+		ctx.Respond(fail("city not found"))
+		return
+	}
+
+	// 先扣政令，world 拒绝时退还
+	cost := basic.BasicConf.General.ReclamationCost
+	if !s.ConsumeDecree(player.Resource(), cost) {
+		ctx.Respond(fail("decree not enough"))
+		return
+	}
+
+	f := ctx.RequestFuture(worldPID,
+		&messages.HWReclamation{
+			WorldBaseMessage: messages.WorldBaseMessage{WorldId: int(*p.WorldId), PlayerId: int(*p.PlayerId)},
+			Pos:              messages.Pos{X: x, Y: y},
+			Army:             s.toMessageArmy(player, army),
+		},
+		500*time.Millisecond,
+	)
+	ctx.ReenterAfter(f, func(res interface{}, err error) {
+		reclamationRes, isReclamation := res.(*messages.WHReclamation)
+		if err != nil || !isReclamation || !reclamationRes.OK {
+			Gain(player.Resource(), entity.ResourceState{Decree: cost})
+			ctx.Respond(fail("can't reclaim the aim"))
+			return
+		}
 
+		updated := player.UpdateArmies(army.Id, func(v *entity.ArmyEntity) {
+			if v == nil {
+				return
+			}
+			v.SetCmd(entity.ArmyCmdReclamation)
+			v.SetState(entity.ArmyRunning)
+			v.SetFromX(city.x)
+			v.SetFromY(city.y)
+			v.SetToX(x)
+			v.SetToY(y)
+			v.SetStartTime(reclamationRes.StartTime)
+			v.SetEndTime(reclamationRes.EndTime)
+			v.SetFrozen(true)
+		})
+		if !updated {
+			ctx.Respond(fail("army not found"))
+			return
+		}
+		a, _ := player.GetArmies(army.Id)
+		AssignArmyResponse(ctx, player, a)
+	})
 }
 
 func (s *PlayerService) Transfer(ctx actor.Context, p *PlayerActor, army entity.ArmyState, x int, y int) {
@@ -465,7 +532,7 @@ func AssignArmyResponse(ctx actor.Context, player *entity.PlayerEntity, army ent
 	response := ok()
 	response.Body = &playerpb.PlayerResponse_ArmyInfoResponse{
 		ArmyInfoResponse: &playerpb.ArmyInfoResponse{
-			Army: ToPBArmy(player.CityID(), army),
+			Army: ToPBArmy(armyCityID(player, army.Id), army),
 		},
 	}
 	ctx.Respond(response)
@@ -488,13 +555,134 @@ func (s *PlayerService) AssignPreCheck(army entity.ArmyState, x int, y int) erro
 	return nil
 }
 
+// IsCanOutWar 有主将、空闲且没有冻结的军队才能出城
 func (s *PlayerService) IsCanOutWar(a entity.ArmyState) bool {
-	return a.Generals[0] == 0 && a.Cmd == entity.ArmyCmdIdle
+	return len(a.Generals) > 0 && a.Generals[0] != 0 && a.Cmd == entity.ArmyCmdIdle && !a.Frozen
+}
+
+// CheckPosTag 校验收藏坐标和名称
+func (s *PlayerService) CheckPosTag(x, y int, name string) error {
+	if x < 0 || x >= _map.MapWidth || y < 0 || y >= _map.MapHeight {
+		return fmt.Errorf("request param invalid")
+	}
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > basic.BasicConf.Role.PosTagNameLen {
+		return fmt.Errorf("pos tag name length invalid")
+	}
+	if words.HasBanned(name) {
+		return fmt.Errorf("pos tag name contains banned words")
+	}
+	return nil
+}
+
+// 资源流水原因
+const (
+	LedgerMarketTransform = "market.transform"
+	LedgerSubCity         = "city.subCity"
+	LedgerMoveCity        = "city.move"
+	LedgerAllianceCreate  = "union.create"
+	LedgerReclamation     = "army.reclamation"
+)
+
+// 资源流水保留条数
+const ledgerLimit = 100
+
+// RecordLedger 记录一条资源流水，超出上限时丢弃最旧的记录
+func (s *PlayerService) RecordLedger(player *entity.PlayerEntity, reason string, delta entity.ResourceState) {
+	if player == nil {
+		return
+	}
+	player.AppendLedger(entity.ResourceLogState{
+		Reason:    reason,
+		Wood:      delta.Wood,
+		Iron:      delta.Iron,
+		Stone:     delta.Stone,
+		Grain:     delta.Grain,
+		Gold:      delta.Gold,
+		Decree:    delta.Decree,
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	for player.LenLedger() > ledgerLimit {
+		player.RemoveLedgerAt(0)
+	}
+}
+
+// TransformRate 集市兑换率（百分比），基础兑换率加上集市等级的交易加成
+func (s *PlayerService) TransformRate(player *entity.PlayerEntity) (int, bool) {
+	level := 0
+	player.ForEachFacility(func(i int, v entity.FacilityState) {
+		if v.FType == facility.JiShi {
+			level = v.PrivateLevel
+		}
+	})
+	if level <= 0 {
+		return 0, false
+	}
+	rate := basic.BasicConf.City.TransformRate
+	if cfg, ok := facility.FacilityConf.GetFacility(facility.JiShi); ok {
+		rate += cfg.GetAddition(level, facility.TypeDealTaxRate)
+	}
+	return min(rate, 100), true
+}
+
+// ConsumeDecree 先结算恢复再扣除政令
+func (s *PlayerService) ConsumeDecree(res *entity.ResourceEntity, cost int) bool {
+	if res == nil {
+		return false
+	}
+	SettleDecree(res, time.Now().UnixMilli())
+	if res.Decree() < cost {
+		return false
+	}
+	res.SetDecree(res.Decree() - cost)
+	return true
+}
+
+// SettleDecree 惰性结算政令恢复，每 DecreeRecovery 秒恢复一点，最多到 DecreeLimit
+func SettleDecree(res *entity.ResourceEntity, nowMS int64) bool {
+	if res == nil {
+		return false
+	}
+	limit := basic.BasicConf.Role.DecreeLimit
+	intervalMS := int64(basic.BasicConf.Role.DecreeRecovery) * 1000
+	if intervalMS <= 0 {
+		return false
+	}
+	// 政令已满时不累积恢复进度，计时从最近一次结算开始
+	lastClaim := res.DecreeClaim()
+	if lastClaim <= 0 || res.Decree() >= limit {
+		return res.SetDecreeClaim(nowMS)
+	}
+
+	turn := (nowMS - lastClaim) / intervalMS
+	if turn <= 0 {
+		return false
+	}
+	decree := res.Decree() + int(turn)
+	if decree >= limit {
+		decree = limit
+		lastClaim = nowMS
+	} else {
+		lastClaim += turn * intervalMS
+	}
+	dirty := res.SetDecree(decree)
+	return res.SetDecreeClaim(lastClaim) || dirty
+}
+
+// NextDecreeTime 下一点政令恢复的时间，已满时返回 0
+func NextDecreeTime(res *entity.ResourceEntity) int64 {
+	if res == nil || res.Decree() >= basic.BasicConf.Role.DecreeLimit {
+		return 0
+	}
+	intervalMS := int64(basic.BasicConf.Role.DecreeRecovery) * 1000
+	if intervalMS <= 0 || res.DecreeClaim() <= 0 {
+		return 0
+	}
+	return res.DecreeClaim() + intervalMS
 }
 
 func ComputeFacilityYield(player *entity.PlayerEntity) facility.FacilityYield {
 	var yield facility.FacilityYield
-	player.ForEachFacility(func(i int, v entity.FacilityState) {
+	forEachPlayerFacility(player, func(v entity.FacilityState) {
 		facility, ok := facility.FacilityConf.GetFacility(v.FType)
 		if !ok {
 			return
@@ -545,15 +733,28 @@ func ToPBResource(res *entity.ResourceEntity) *playerpb.Resource {
 		return &playerpb.Resource{}
 	}
 	return &playerpb.Resource{
-		Wood:   int32(res.Wood()),
-		Iron:   int32(res.Iron()),
-		Stone:  int32(res.Stone()),
-		Grain:  int32(res.Grain()),
-		Gold:   int32(res.Gold()),
-		Decree: int32(res.Decree()),
+		Wood:           int32(res.Wood()),
+		Iron:           int32(res.Iron()),
+		Stone:          int32(res.Stone()),
+		Grain:          int32(res.Grain()),
+		Gold:           int32(res.Gold()),
+		Decree:         int32(res.Decree()),
+		DecreeNextTime: NextDecreeTime(res),
 	}
 }
 
+func ToPBPosTags(attribute *entity.RoleAttributeEntity) []*playerpb.PosTag {
+	tags := make([]*playerpb.PosTag, 0, attribute.LenPosTags())
+	attribute.ForEachPosTags(func(i int, v entity.PosTagState) {
+		tags = append(tags, &playerpb.PosTag{
+			Name: v.Name,
+			X:    int32(v.X),
+			Y:    int32(v.Y),
+		})
+	})
+	return tags
+}
+
 func ToPBBuilding(b entity.BuildingState) *playerpb.Building {
 	// BuildingEntity 当前不携带联盟/上级/昵称信息，proto 对应字段保持默认值。
 	// GiveUpTime 历史实现按“秒 -> 毫秒”返回，这里沿用；若实体内已是毫秒需去掉 *1000。
@@ -837,6 +1038,7 @@ func ToPBWarReport(cityId CityID, v entity.WarReportState) *playerpb.WarReport {
 		X:                 int32(v.X),
 		Y:                 int32(v.Y),
 		Ctime:             int64(v.CTime),
+		Defenders:         ConvertIntToInt32(v.Defenders),
 	}
 }
 
@@ -881,6 +1083,7 @@ func ToWarReport(v messages.WarReport) entity.WarReportState {
 		X:                 v.X,
 		Y:                 v.Y,
 		CTime:             v.CTime,
+		Defenders:         append([]int(nil), v.Defenders...),
 	}
 	if state.CTime <= 0 {
 		state.CTime = int(time.Now().UnixMilli())
@@ -0,0 +1,74 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/player/entity"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"context"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// HandleWHVassalChanged world 通知沦陷或脱离
+func (h *PlayerHandler) HandleWHVassalChanged(ctx actor.Context, p *PlayerActor, msg *messages.WHVassalChanged) {
+	attr := p.Entity().Attribute()
+	if attr == nil {
+		return
+	}
+	dirty := attr.SetParentId(msg.ParentId)
+	dirty = attr.SetVassalEnd(msg.VassalEnd) || dirty
+	if !dirty {
+		return
+	}
+	if err := p.DC().FlushSync(context.TODO()); err != nil {
+		ctx.Logger().Error("player flush failed", "player_id", p.PlayerId, "err", err)
+	}
+}
+
+// VassalParent 沦陷中的上级联盟，未沦陷或已到期返回 0
+func (s *PlayerService) VassalParent(player *entity.PlayerEntity, now time.Time) int {
+	attr := player.Attribute()
+	if attr == nil || attr.ParentId() <= 0 || !attr.VassalEnd().After(now) {
+		return 0
+	}
+	return attr.ParentId()
+}
+
+// Tribute 附庸上供的部分，各项产出都按 vassal.tribute 百分比扣除
+func (s *PlayerService) Tribute(player *entity.PlayerEntity, yield entity.ResourceState, now time.Time) entity.ResourceState {
+	if s.VassalParent(player, now) <= 0 {
+		return entity.ResourceState{}
+	}
+	rate := min(max(basic.BasicConf.Vassal.Tribute, 0), 100)
+	share := func(v int) int {
+		return max(v, 0) * rate / 100
+	}
+	return entity.ResourceState{
+		Wood:  share(yield.Wood),
+		Iron:  share(yield.Iron),
+		Stone: share(yield.Stone),
+		Grain: share(yield.Grain),
+		Gold:  share(yield.Gold),
+	}
+}
+
+// PayTribute 把上供的产出交给上级联盟
+func (s *PlayerService) PayTribute(ctx actor.Context, p *PlayerActor, tribute entity.ResourceState, now time.Time) {
+	parentID := s.VassalParent(p.Entity(), now)
+	alliancePID := p.AlliancePID()
+	if parentID <= 0 || alliancePID == nil {
+		return
+	}
+	if tribute.Wood <= 0 && tribute.Iron <= 0 && tribute.Stone <= 0 && tribute.Grain <= 0 && tribute.Gold <= 0 {
+		return
+	}
+	ctx.Send(alliancePID, &messages.HATribute{
+		AllianceBaseMessage: p.allianceBase(parentID),
+		Wood:                tribute.Wood,
+		Iron:                tribute.Iron,
+		Stone:               tribute.Stone,
+		Grain:               tribute.Grain,
+		Gold:                tribute.Gold,
+	})
+}
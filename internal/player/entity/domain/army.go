@@ -12,6 +12,8 @@ const (
 	ArmyCmdBack        = 4 //撤退
 	ArmyCmdConscript   = 5 //征兵
 	ArmyCmdTransfer    = 6 //调动
+	ArmyCmdRally       = 7 //集结
+	ArmyCmdReinforce   = 8 //增援盟友
 )
 
 const (
@@ -22,7 +24,7 @@ const (
 // 军队
 // entity
 type Army struct {
-	id                int    // order 第几编队 同 order
+	id                int    // 主城同 order，分城为 seq*10+order
 	cityId            CityID // 城市id
 	playerId          PlayerID
 	allianceId        AllianceID
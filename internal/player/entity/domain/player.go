@@ -22,4 +22,6 @@ type Player struct {
 	warReports   map[int]*WarReport
 	skills       map[int]*Skill
 	city         *City
+	subCities    map[CityID]*SubCity // 分城
+	ledger       []*ResourceLog      // 资源流水，只保留最近的记录
 }
@@ -9,6 +9,8 @@ type Resource struct {
 	gold      int   // 金币
 	decree    int   // 令牌
 	lastClaim int64 // 上次领取产出的时间
+
+	decreeClaim int64 // 上次结算政令恢复的时间，单位毫秒
 }
 
 func (r *Resource) IsEnoughGold(cost int) bool {
@@ -0,0 +1,14 @@
+package domain
+
+// 资源流水
+// entity
+type ResourceLog struct {
+	reason    string // 变动原因，如 market.transform
+	wood      int    // 变动量，正数为获得，负数为消耗
+	iron      int
+	stone     int
+	grain     int
+	gold      int
+	decree    int
+	createdAt int64 // 毫秒
+}
@@ -11,6 +11,10 @@ type RoleAttribute struct {
 	collectTimes    int8
 	lastCollectTime time.Time
 	posTags         []PosTag
+	tradeAmount     int       // 当日已兑换的资源量
+	lastTradeTime   time.Time // 上次兑换时间，用于跨天重置
+	lastMoveTime    time.Time // 上次迁城时间
+	vassalEnd       time.Time // 沦陷到期时间，沦陷期间 parentId 为上级联盟
 }
 
 // entity
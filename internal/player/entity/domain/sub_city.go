@@ -0,0 +1,12 @@
+package domain
+
+// 分城，主城的信息仍保存在 Player.city / Player.facility
+// entity
+type SubCity struct {
+	cityId     CityID
+	seq        int // 分城序号，从 1 开始，军队 id = seq*10+order
+	name       string
+	x          int
+	y          int
+	facilities []*Facility
+}
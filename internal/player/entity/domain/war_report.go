@@ -22,4 +22,5 @@ type WarReport struct {
 	x                 int
 	y                 int
 	cTime             int
+	defenders         []int // 参与防守的玩家，含增援的盟友
 }
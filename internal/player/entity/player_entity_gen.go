@@ -23,6 +23,8 @@ const (
 	FieldPlayer_warReports   Field = "warReports"
 	FieldPlayer_skills       Field = "skills"
 	FieldPlayer_city         Field = "city"
+	FieldPlayer_subCities    Field = "subCities"
+	FieldPlayer_ledger       Field = "ledger"
 )
 
 var emptyPlayerEntity = &PlayerEntity{}
@@ -55,6 +57,7 @@ type PlayerEntityTrace struct {
 	childDirty_generals   map[int]struct{}
 	childDirty_warReports map[int]struct{}
 	childDirty_skills     map[int]struct{}
+	childDirty_subCities  map[CityID]struct{}
 }
 
 func (t *PlayerEntityTrace) mark(f Field) {
@@ -269,6 +272,33 @@ func (t *PlayerEntityTrace) childDirtyKeys_skills() []int {
 	return out
 }
 
+func (t *PlayerEntityTrace) markChildDirty_subCities(f Field, key CityID) {
+	t.mark(f)
+	if t.childDirty_subCities == nil {
+		t.childDirty_subCities = make(map[CityID]struct{}, 8)
+	}
+	t.childDirty_subCities[key] = struct{}{}
+}
+
+func (t *PlayerEntityTrace) clearChildDirty_subCities(key CityID) {
+	if t.childDirty_subCities == nil {
+		return
+	}
+	delete(t.childDirty_subCities, key)
+}
+
+func (t *PlayerEntityTrace) childDirtyKeys_subCities() []CityID {
+	if len(t.childDirty_subCities) == 0 {
+		return nil
+	}
+	out := make([]CityID, 0, len(t.childDirty_subCities))
+	for key := range t.childDirty_subCities {
+		out = append(out, key)
+	}
+	sort.Slice(out, func(i, j int) bool { return fmt.Sprint(out[i]) < fmt.Sprint(out[j]) })
+	return out
+}
+
 type PlayerState struct {
 	PlayerID     PlayerID
 	WorldID      WorldID
@@ -285,6 +315,8 @@ type PlayerState struct {
 	WarReports   map[int]WarReportState
 	Skills       map[int]SkillState
 	City         CityState
+	SubCities    map[CityID]SubCityState
+	Ledger       []ResourceLogState
 }
 
 type PlayerEntitySnap struct {
@@ -296,6 +328,7 @@ type PlayerEntitySnap struct {
 	GeneralsDirtyKeys   []int
 	WarReportsDirtyKeys []int
 	SkillsDirtyKeys     []int
+	SubCitiesDirtyKeys  []CityID
 }
 
 type PlayerEntity struct {
@@ -314,6 +347,8 @@ type PlayerEntity struct {
 	warReports   map[int]*WarReportEntity
 	skills       map[int]*SkillEntity
 	city         *CityEntity
+	subCities    map[CityID]*SubCityEntity
+	ledger       []*ResourceLogEntity
 	_dt          PlayerEntityTrace
 }
 
@@ -587,6 +622,96 @@ func (e *PlayerEntity) snapshotMapSkills(in map[int]*SkillEntity) map[int]SkillS
 	return out
 }
 
+func (e *PlayerEntity) copyMapSubCities(in map[CityID]SubCityState) map[CityID]SubCityState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[CityID]SubCityState, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (e *PlayerEntity) mapsEqualSubCities(a, b map[CityID]SubCityState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	return false
+}
+
+func (e *PlayerEntity) hydrateMapSubCities(in map[CityID]SubCityState) map[CityID]*SubCityEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[CityID]*SubCityEntity, len(in))
+	for k, v := range in {
+		out[k] = HydrateSubCityEntity(v)
+	}
+	return out
+}
+
+func (e *PlayerEntity) snapshotMapSubCities(in map[CityID]*SubCityEntity) map[CityID]SubCityState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[CityID]SubCityState, len(in))
+	for k, v := range in {
+		if v == nil {
+			var z SubCityState
+			out[k] = z
+			continue
+		}
+		out[k] = v.Save()
+	}
+	return out
+}
+
+func (e *PlayerEntity) hydrateSliceLedger(in []ResourceLogState) []*ResourceLogEntity {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ResourceLogEntity, len(in))
+	for i, v := range in {
+		out[i] = HydrateResourceLogEntity(v)
+	}
+	return out
+}
+
+func (e *PlayerEntity) snapshotSliceLedger(in []*ResourceLogEntity) []ResourceLogState {
+	if in == nil {
+		return nil
+	}
+	out := make([]ResourceLogState, len(in))
+	for i, v := range in {
+		if v == nil {
+			var z ResourceLogState
+			out[i] = z
+			continue
+		}
+		out[i] = v.Save()
+	}
+	return out
+}
+
+func (e *PlayerEntity) slicesEqualLedger(a, b []ResourceLogState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func HydratePlayerEntity(s PlayerState) *PlayerEntity {
 	return &PlayerEntity{
 		playerID:     s.PlayerID,
@@ -604,6 +729,8 @@ func HydratePlayerEntity(s PlayerState) *PlayerEntity {
 		warReports:   emptyPlayerEntity.hydrateMapWarReports(s.WarReports),
 		skills:       emptyPlayerEntity.hydrateMapSkills(s.Skills),
 		city:         HydrateCityEntity(s.City),
+		subCities:    emptyPlayerEntity.hydrateMapSubCities(s.SubCities),
+		ledger:       emptyPlayerEntity.hydrateSliceLedger(s.Ledger),
 	}
 }
 
@@ -794,6 +921,8 @@ func (e *PlayerEntity) Save() PlayerState {
 		var z CityState
 		s.City = z
 	}
+	s.SubCities = e.snapshotMapSubCities(e.subCities)
+	s.Ledger = e.snapshotSliceLedger(e.ledger)
 	return s
 }
 
@@ -812,6 +941,7 @@ func NewPlayerEntitySnap(version uint64, e *PlayerEntity) *PlayerEntitySnap {
 		GeneralsDirtyKeys:   e._dt.childDirtyKeys_generals(),
 		WarReportsDirtyKeys: e._dt.childDirtyKeys_warReports(),
 		SkillsDirtyKeys:     e._dt.childDirtyKeys_skills(),
+		SubCitiesDirtyKeys:  e._dt.childDirtyKeys_subCities(),
 	}
 }
 
@@ -832,12 +962,15 @@ func (s *PlayerEntitySnap) Clone() *PlayerEntitySnap {
 	out.GeneralsDirtyKeys = append([]int(nil), s.GeneralsDirtyKeys...)
 	out.WarReportsDirtyKeys = append([]int(nil), s.WarReportsDirtyKeys...)
 	out.SkillsDirtyKeys = append([]int(nil), s.SkillsDirtyKeys...)
+	out.SubCitiesDirtyKeys = append([]CityID(nil), s.SubCitiesDirtyKeys...)
 	out.State.Buildings = append([]BuildingState(nil), s.State.Buildings...)
 	out.State.Armies = emptyPlayerEntity.copyMapArmies(s.State.Armies)
 	out.State.Generals = emptyPlayerEntity.copyMapGenerals(s.State.Generals)
 	out.State.Facility = append([]FacilityState(nil), s.State.Facility...)
 	out.State.WarReports = emptyPlayerEntity.copyMapWarReports(s.State.WarReports)
 	out.State.Skills = emptyPlayerEntity.copyMapSkills(s.State.Skills)
+	out.State.SubCities = emptyPlayerEntity.copyMapSubCities(s.State.SubCities)
+	out.State.Ledger = append([]ResourceLogState(nil), s.State.Ledger...)
 	return out
 }
 
@@ -2024,3 +2157,307 @@ func (e *PlayerEntity) UpdateCity(fn func(value *CityEntity)) bool {
 	e._dt.mark(FieldPlayer_city)
 	return true
 }
+
+func (e *PlayerEntity) GetSubCities(key CityID) (SubCityState, bool) {
+	var z SubCityState
+	if e == nil || e.subCities == nil {
+		return z, false
+	}
+	v, ok := e.subCities[key]
+	if !ok || v == nil {
+		return z, false
+	}
+	return v.Save(), true
+}
+
+func (e *PlayerEntity) LenSubCities() int {
+	if e == nil || e.subCities == nil {
+		return 0
+	}
+	return len(e.subCities)
+}
+
+func (e *PlayerEntity) ForEachSubCities(fn func(key CityID, value SubCityState)) {
+	if e == nil || e.subCities == nil || fn == nil {
+		return
+	}
+	for k, v := range e.subCities {
+		if v == nil {
+			continue
+		}
+		fn(k, v.Save())
+	}
+}
+
+func (e *PlayerEntity) RangeSubCities(fn func(key CityID, value SubCityState) bool) {
+	if e == nil || e.subCities == nil || fn == nil {
+		return
+	}
+	for k, v := range e.subCities {
+		if v == nil {
+			continue
+		}
+		if !fn(k, v.Save()) {
+			return
+		}
+	}
+}
+
+func (e *PlayerEntity) DirtySubCitiesKeys() []CityID {
+	if e == nil {
+		return nil
+	}
+	return e._dt.childDirtyKeys_subCities()
+}
+
+func (e *PlayerEntity) ReplaceSubCities(v map[CityID]SubCityState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualSubCities(e.snapshotMapSubCities(e.subCities), v) {
+		return false
+	}
+	e.subCities = e.hydrateMapSubCities(v)
+	e._dt.markFullReplace(FieldPlayer_subCities)
+	return true
+}
+
+func (e *PlayerEntity) PutSubCities(key CityID, value SubCityState) bool {
+	if e == nil {
+		return false
+	}
+	if e.subCities == nil {
+		e.subCities = make(map[CityID]*SubCityEntity)
+	}
+	e.subCities[key] = HydrateSubCityEntity(value)
+	e._dt.markMapSet(FieldPlayer_subCities, fmt.Sprint(key), value)
+	e._dt.markChildDirty_subCities(FieldPlayer_subCities, key)
+	return true
+}
+
+func (e *PlayerEntity) PutSubCitiesMany(entries map[CityID]SubCityState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.subCities == nil {
+		e.subCities = make(map[CityID]*SubCityEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		e.subCities[k] = HydrateSubCityEntity(v)
+		e._dt.markMapSet(FieldPlayer_subCities, fmt.Sprint(k), v)
+		e._dt.markChildDirty_subCities(FieldPlayer_subCities, k)
+		changed = true
+	}
+	return changed
+}
+
+func (e *PlayerEntity) UpdateSubCities(key CityID, fn func(value *SubCityEntity)) bool {
+	if e == nil || fn == nil || e.subCities == nil {
+		return false
+	}
+	v, ok := e.subCities[key]
+	if !ok || v == nil {
+		return false
+	}
+	fn(v)
+	e._dt.markChildDirty_subCities(FieldPlayer_subCities, key)
+	return true
+}
+
+func (e *PlayerEntity) DelSubCities(key CityID) bool {
+	if e == nil || e.subCities == nil {
+		return false
+	}
+	if _, ok := e.subCities[key]; !ok {
+		return false
+	}
+	delete(e.subCities, key)
+	e._dt.markMapDelete(FieldPlayer_subCities, fmt.Sprint(key))
+	e._dt.clearChildDirty_subCities(key)
+	return true
+}
+
+func (e *PlayerEntity) DelSubCitiesMany(keys []CityID) bool {
+	if e == nil || e.subCities == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.subCities[key]; !ok {
+			continue
+		}
+		delete(e.subCities, key)
+		e._dt.markMapDelete(FieldPlayer_subCities, fmt.Sprint(key))
+		e._dt.clearChildDirty_subCities(key)
+		changed = true
+	}
+	return changed
+}
+
+func (e *PlayerEntity) ClearSubCities() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.subCities) == 0 {
+		return false
+	}
+	e.subCities = nil
+	e._dt.markFullReplace(FieldPlayer_subCities)
+	e._dt.childDirty_subCities = nil
+	return true
+}
+
+func (e *PlayerEntity) LenLedger() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.ledger)
+}
+
+func (e *PlayerEntity) AtLedger(index int) (ResourceLogState, bool) {
+	var z ResourceLogState
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.ledger) {
+		return z, false
+	}
+	v := e.ledger[index]
+	if v == nil {
+		return z, true
+	}
+	return v.Save(), true
+}
+
+func (e *PlayerEntity) ForEachLedger(fn func(index int, value ResourceLogState)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.ledger {
+		var state ResourceLogState
+		if v != nil {
+			state = v.Save()
+		}
+		fn(i, state)
+	}
+}
+
+func (e *PlayerEntity) RangeLedger(fn func(index int, value ResourceLogState) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.ledger {
+		var state ResourceLogState
+		if v != nil {
+			state = v.Save()
+		}
+		if !fn(i, state) {
+			return
+		}
+	}
+}
+
+func (e *PlayerEntity) ReplaceLedger(v []ResourceLogState) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualLedger(e.snapshotSliceLedger(e.ledger), v) {
+		return false
+	}
+	e.ledger = e.hydrateSliceLedger(v)
+	e._dt.markFullReplace(FieldPlayer_ledger)
+	return true
+}
+
+func (e *PlayerEntity) AppendLedger(values ...ResourceLogState) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		rv := HydrateResourceLogEntity(v)
+		e.ledger = append(e.ledger, rv)
+		e._dt.markSliceAppend(FieldPlayer_ledger, v)
+	}
+	return true
+}
+
+func (e *PlayerEntity) SetLedgerAt(index int, value ResourceLogState) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.ledger) {
+		return false
+	}
+	var oldState ResourceLogState
+	if e.ledger[index] != nil {
+		oldState = e.ledger[index].Save()
+	}
+	if reflect.DeepEqual(oldState, value) {
+		return false
+	}
+	e.ledger[index] = HydrateResourceLogEntity(value)
+	e._dt.markSliceSet(FieldPlayer_ledger, index, value)
+	return true
+}
+
+func (e *PlayerEntity) UpdateLedgerAt(index int, fn func(value *ResourceLogEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.ledger) {
+		return false
+	}
+	v := e.ledger[index]
+	if v == nil {
+		return false
+	}
+	before := v.Save()
+	fn(v)
+	after := v.Save()
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markSliceSet(FieldPlayer_ledger, index, after)
+	return true
+}
+
+func (e *PlayerEntity) RemoveLedgerAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.ledger) {
+		return false
+	}
+	e.ledger = append(e.ledger[:index], e.ledger[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldPlayer_ledger, index)
+	return true
+}
+
+func (e *PlayerEntity) SwapRemoveLedgerAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.ledger) {
+		return false
+	}
+	last := len(e.ledger) - 1
+	if index != last {
+		e.ledger[index] = e.ledger[last]
+	}
+	e.ledger = e.ledger[:last]
+	e._dt.markSliceSwapRemoveAt(FieldPlayer_ledger, index)
+	return true
+}
+
+func (e *PlayerEntity) ClearLedger() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.ledger) == 0 {
+		return false
+	}
+	e.ledger = nil
+	e._dt.markFullReplace(FieldPlayer_ledger)
+	return true
+}
@@ -6,13 +6,14 @@ import (
 )
 
 const (
-	FieldResource_wood      Field = "wood"
-	FieldResource_iron      Field = "iron"
-	FieldResource_stone     Field = "stone"
-	FieldResource_grain     Field = "grain"
-	FieldResource_gold      Field = "gold"
-	FieldResource_decree    Field = "decree"
-	FieldResource_lastClaim Field = "lastClaim"
+	FieldResource_wood        Field = "wood"
+	FieldResource_iron        Field = "iron"
+	FieldResource_stone       Field = "stone"
+	FieldResource_grain       Field = "grain"
+	FieldResource_gold        Field = "gold"
+	FieldResource_decree      Field = "decree"
+	FieldResource_lastClaim   Field = "lastClaim"
+	FieldResource_decreeClaim Field = "decreeClaim"
 )
 
 var emptyResourceEntity = &ResourceEntity{}
@@ -148,13 +149,14 @@ func (t *ResourceEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
 }
 
 type ResourceState struct {
-	Wood      int
-	Iron      int
-	Stone     int
-	Grain     int
-	Gold      int
-	Decree    int
-	LastClaim int64
+	Wood        int
+	Iron        int
+	Stone       int
+	Grain       int
+	Gold        int
+	Decree      int
+	LastClaim   int64
+	DecreeClaim int64
 }
 
 type ResourceEntitySnap struct {
@@ -165,25 +167,27 @@ type ResourceEntitySnap struct {
 }
 
 type ResourceEntity struct {
-	wood      int
-	iron      int
-	stone     int
-	grain     int
-	gold      int
-	decree    int
-	lastClaim int64
-	_dt       ResourceEntityTrace
+	wood        int
+	iron        int
+	stone       int
+	grain       int
+	gold        int
+	decree      int
+	lastClaim   int64
+	decreeClaim int64
+	_dt         ResourceEntityTrace
 }
 
 func HydrateResourceEntity(s ResourceState) *ResourceEntity {
 	return &ResourceEntity{
-		wood:      s.Wood,
-		iron:      s.Iron,
-		stone:     s.Stone,
-		grain:     s.Grain,
-		gold:      s.Gold,
-		decree:    s.Decree,
-		lastClaim: s.LastClaim,
+		wood:        s.Wood,
+		iron:        s.Iron,
+		stone:       s.Stone,
+		grain:       s.Grain,
+		gold:        s.Gold,
+		decree:      s.Decree,
+		lastClaim:   s.LastClaim,
+		decreeClaim: s.DecreeClaim,
 	}
 }
 
@@ -310,6 +314,7 @@ func (e *ResourceEntity) Save() ResourceState {
 	s.Gold = e.gold
 	s.Decree = e.decree
 	s.LastClaim = e.lastClaim
+	s.DecreeClaim = e.decreeClaim
 	return s
 }
 
@@ -486,3 +491,23 @@ func (e *ResourceEntity) SetLastClaim(v int64) bool {
 	e._dt.mark(FieldResource_lastClaim)
 	return true
 }
+
+func (e *ResourceEntity) DecreeClaim() int64 {
+	if e == nil {
+		var z int64
+		return z
+	}
+	return e.decreeClaim
+}
+
+func (e *ResourceEntity) SetDecreeClaim(v int64) bool {
+	if e == nil {
+		return false
+	}
+	if e.decreeClaim == v {
+		return false
+	}
+	e.decreeClaim = v
+	e._dt.mark(FieldResource_decreeClaim)
+	return true
+}
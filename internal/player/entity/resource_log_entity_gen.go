@@ -0,0 +1,509 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+)
+
+const (
+	FieldResourceLog_reason    Field = "reason"
+	FieldResourceLog_wood      Field = "wood"
+	FieldResourceLog_iron      Field = "iron"
+	FieldResourceLog_stone     Field = "stone"
+	FieldResourceLog_grain     Field = "grain"
+	FieldResourceLog_gold      Field = "gold"
+	FieldResourceLog_decree    Field = "decree"
+	FieldResourceLog_createdAt Field = "createdAt"
+)
+
+var emptyResourceLogEntity = &ResourceLogEntity{}
+
+type ResourceLogEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type ResourceLogEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type ResourceLogEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*ResourceLogEntityCollectionChangeInner
+}
+
+func (t *ResourceLogEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *ResourceLogEntityTrace) ensureChange(f Field) *ResourceLogEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*ResourceLogEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &ResourceLogEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *ResourceLogEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *ResourceLogEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *ResourceLogEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *ResourceLogEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *ResourceLogEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *ResourceLogEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *ResourceLogEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type ResourceLogState struct {
+	Reason    string
+	Wood      int
+	Iron      int
+	Stone     int
+	Grain     int
+	Gold      int
+	Decree    int
+	CreatedAt int64
+}
+
+type ResourceLogEntitySnap struct {
+	Version     uint64
+	State       ResourceLogState
+	DirtyFields []Field
+	Changes     map[Field]ResourceLogEntityCollectionChange
+}
+
+type ResourceLogEntity struct {
+	reason    string
+	wood      int
+	iron      int
+	stone     int
+	grain     int
+	gold      int
+	decree    int
+	createdAt int64
+	_dt       ResourceLogEntityTrace
+}
+
+func HydrateResourceLogEntity(s ResourceLogState) *ResourceLogEntity {
+	return &ResourceLogEntity{
+		reason:    s.Reason,
+		wood:      s.Wood,
+		iron:      s.Iron,
+		stone:     s.Stone,
+		grain:     s.Grain,
+		gold:      s.Gold,
+		decree:    s.Decree,
+		createdAt: s.CreatedAt,
+	}
+}
+
+func (e *ResourceLogEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *ResourceLogEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = ResourceLogEntityTrace{}
+}
+
+func (e *ResourceLogEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *ResourceLogEntity) DirtyChanges() map[Field]ResourceLogEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]ResourceLogEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := ResourceLogEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneResourceLogEntityCollectionChange(in ResourceLogEntityCollectionChange) ResourceLogEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *ResourceLogEntity) Save() ResourceLogState {
+	var s ResourceLogState
+	if e == nil {
+		return s
+	}
+	s.Reason = e.reason
+	s.Wood = e.wood
+	s.Iron = e.iron
+	s.Stone = e.stone
+	s.Grain = e.grain
+	s.Gold = e.gold
+	s.Decree = e.decree
+	s.CreatedAt = e.createdAt
+	return s
+}
+
+func NewResourceLogEntitySnap(version uint64, e *ResourceLogEntity) *ResourceLogEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &ResourceLogEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *ResourceLogEntitySnap) Clone() *ResourceLogEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &ResourceLogEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]ResourceLogEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneResourceLogEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *ResourceLogEntity) Reason() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.reason
+}
+
+func (e *ResourceLogEntity) SetReason(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.reason == v {
+		return false
+	}
+	e.reason = v
+	e._dt.mark(FieldResourceLog_reason)
+	return true
+}
+
+func (e *ResourceLogEntity) Wood() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.wood
+}
+
+func (e *ResourceLogEntity) SetWood(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.wood == v {
+		return false
+	}
+	e.wood = v
+	e._dt.mark(FieldResourceLog_wood)
+	return true
+}
+
+func (e *ResourceLogEntity) Iron() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.iron
+}
+
+func (e *ResourceLogEntity) SetIron(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.iron == v {
+		return false
+	}
+	e.iron = v
+	e._dt.mark(FieldResourceLog_iron)
+	return true
+}
+
+func (e *ResourceLogEntity) Stone() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.stone
+}
+
+func (e *ResourceLogEntity) SetStone(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.stone == v {
+		return false
+	}
+	e.stone = v
+	e._dt.mark(FieldResourceLog_stone)
+	return true
+}
+
+func (e *ResourceLogEntity) Grain() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.grain
+}
+
+func (e *ResourceLogEntity) SetGrain(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.grain == v {
+		return false
+	}
+	e.grain = v
+	e._dt.mark(FieldResourceLog_grain)
+	return true
+}
+
+func (e *ResourceLogEntity) Gold() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.gold
+}
+
+func (e *ResourceLogEntity) SetGold(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.gold == v {
+		return false
+	}
+	e.gold = v
+	e._dt.mark(FieldResourceLog_gold)
+	return true
+}
+
+func (e *ResourceLogEntity) Decree() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.decree
+}
+
+func (e *ResourceLogEntity) SetDecree(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.decree == v {
+		return false
+	}
+	e.decree = v
+	e._dt.mark(FieldResourceLog_decree)
+	return true
+}
+
+func (e *ResourceLogEntity) CreatedAt() int64 {
+	if e == nil {
+		var z int64
+		return z
+	}
+	return e.createdAt
+}
+
+func (e *ResourceLogEntity) SetCreatedAt(v int64) bool {
+	if e == nil {
+		return false
+	}
+	if e.createdAt == v {
+		return false
+	}
+	e.createdAt = v
+	e._dt.mark(FieldResourceLog_createdAt)
+	return true
+}
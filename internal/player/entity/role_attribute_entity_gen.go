@@ -12,6 +12,10 @@ const (
 	FieldRoleAttribute_collectTimes    Field = "collectTimes"
 	FieldRoleAttribute_lastCollectTime Field = "lastCollectTime"
 	FieldRoleAttribute_posTags         Field = "posTags"
+	FieldRoleAttribute_tradeAmount     Field = "tradeAmount"
+	FieldRoleAttribute_lastTradeTime   Field = "lastTradeTime"
+	FieldRoleAttribute_lastMoveTime    Field = "lastMoveTime"
+	FieldRoleAttribute_vassalEnd       Field = "vassalEnd"
 )
 
 var emptyRoleAttributeEntity = &RoleAttributeEntity{}
@@ -151,6 +155,10 @@ type RoleAttributeState struct {
 	CollectTimes    int8
 	LastCollectTime time.Time
 	PosTags         []PosTagState
+	TradeAmount     int
+	LastTradeTime   time.Time
+	LastMoveTime    time.Time
+	VassalEnd       time.Time
 }
 
 type RoleAttributeEntitySnap struct {
@@ -165,6 +173,10 @@ type RoleAttributeEntity struct {
 	collectTimes    int8
 	lastCollectTime time.Time
 	posTags         []*PosTagEntity
+	tradeAmount     int
+	lastTradeTime   time.Time
+	lastMoveTime    time.Time
+	vassalEnd       time.Time
 	_dt             RoleAttributeEntityTrace
 }
 
@@ -219,6 +231,10 @@ func HydrateRoleAttributeEntity(s RoleAttributeState) *RoleAttributeEntity {
 		collectTimes:    s.CollectTimes,
 		lastCollectTime: s.LastCollectTime,
 		posTags:         emptyRoleAttributeEntity.hydrateSlicePosTags(s.PosTags),
+		tradeAmount:     s.TradeAmount,
+		lastTradeTime:   s.LastTradeTime,
+		lastMoveTime:    s.LastMoveTime,
+		vassalEnd:       s.VassalEnd,
 	}
 }
 
@@ -342,6 +358,10 @@ func (e *RoleAttributeEntity) Save() RoleAttributeState {
 	s.CollectTimes = e.collectTimes
 	s.LastCollectTime = e.lastCollectTime
 	s.PosTags = e.snapshotSlicePosTags(e.posTags)
+	s.TradeAmount = e.tradeAmount
+	s.LastTradeTime = e.lastTradeTime
+	s.LastMoveTime = e.lastMoveTime
+	s.VassalEnd = e.vassalEnd
 	return s
 }
 
@@ -589,3 +609,83 @@ func (e *RoleAttributeEntity) ClearPosTags() bool {
 	e._dt.markFullReplace(FieldRoleAttribute_posTags)
 	return true
 }
+
+func (e *RoleAttributeEntity) TradeAmount() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.tradeAmount
+}
+
+func (e *RoleAttributeEntity) SetTradeAmount(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.tradeAmount == v {
+		return false
+	}
+	e.tradeAmount = v
+	e._dt.mark(FieldRoleAttribute_tradeAmount)
+	return true
+}
+
+func (e *RoleAttributeEntity) LastTradeTime() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.lastTradeTime
+}
+
+func (e *RoleAttributeEntity) SetLastTradeTime(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.lastTradeTime.Equal(v) {
+		return false
+	}
+	e.lastTradeTime = v
+	e._dt.mark(FieldRoleAttribute_lastTradeTime)
+	return true
+}
+
+func (e *RoleAttributeEntity) LastMoveTime() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.lastMoveTime
+}
+
+func (e *RoleAttributeEntity) SetLastMoveTime(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.lastMoveTime.Equal(v) {
+		return false
+	}
+	e.lastMoveTime = v
+	e._dt.mark(FieldRoleAttribute_lastMoveTime)
+	return true
+}
+
+func (e *RoleAttributeEntity) VassalEnd() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.vassalEnd
+}
+
+func (e *RoleAttributeEntity) SetVassalEnd(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.vassalEnd.Equal(v) {
+		return false
+	}
+	e.vassalEnd = v
+	e._dt.mark(FieldRoleAttribute_vassalEnd)
+	return true
+}
@@ -0,0 +1,640 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"reflect"
+	"sort"
+)
+
+const (
+	FieldSubCity_cityId     Field = "cityId"
+	FieldSubCity_seq        Field = "seq"
+	FieldSubCity_name       Field = "name"
+	FieldSubCity_x          Field = "x"
+	FieldSubCity_y          Field = "y"
+	FieldSubCity_facilities Field = "facilities"
+)
+
+var emptySubCityEntity = &SubCityEntity{}
+
+type SubCityEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type SubCityEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type SubCityEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*SubCityEntityCollectionChangeInner
+}
+
+func (t *SubCityEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *SubCityEntityTrace) ensureChange(f Field) *SubCityEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*SubCityEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &SubCityEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *SubCityEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *SubCityEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *SubCityEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *SubCityEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *SubCityEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *SubCityEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *SubCityEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type SubCityState struct {
+	CityId     CityID
+	Seq        int
+	Name       string
+	X          int
+	Y          int
+	Facilities []FacilityState
+}
+
+type SubCityEntitySnap struct {
+	Version     uint64
+	State       SubCityState
+	DirtyFields []Field
+	Changes     map[Field]SubCityEntityCollectionChange
+}
+
+type SubCityEntity struct {
+	cityId     CityID
+	seq        int
+	name       string
+	x          int
+	y          int
+	facilities []*FacilityEntity
+	_dt        SubCityEntityTrace
+}
+
+func (e *SubCityEntity) hydrateSliceFacilities(in []FacilityState) []*FacilityEntity {
+	if in == nil {
+		return nil
+	}
+	out := make([]*FacilityEntity, len(in))
+	for i, v := range in {
+		out[i] = HydrateFacilityEntity(v)
+	}
+	return out
+}
+
+func (e *SubCityEntity) snapshotSliceFacilities(in []*FacilityEntity) []FacilityState {
+	if in == nil {
+		return nil
+	}
+	out := make([]FacilityState, len(in))
+	for i, v := range in {
+		if v == nil {
+			var z FacilityState
+			out[i] = z
+			continue
+		}
+		out[i] = v.Save()
+	}
+	return out
+}
+
+func (e *SubCityEntity) slicesEqualFacilities(a, b []FacilityState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func HydrateSubCityEntity(s SubCityState) *SubCityEntity {
+	return &SubCityEntity{
+		cityId:     s.CityId,
+		seq:        s.Seq,
+		name:       s.Name,
+		x:          s.X,
+		y:          s.Y,
+		facilities: emptySubCityEntity.hydrateSliceFacilities(s.Facilities),
+	}
+}
+
+func (e *SubCityEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *SubCityEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = SubCityEntityTrace{}
+}
+
+func (e *SubCityEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *SubCityEntity) DirtyChanges() map[Field]SubCityEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]SubCityEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := SubCityEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneSubCityEntityCollectionChange(in SubCityEntityCollectionChange) SubCityEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *SubCityEntity) Save() SubCityState {
+	var s SubCityState
+	if e == nil {
+		return s
+	}
+	s.CityId = e.cityId
+	s.Seq = e.seq
+	s.Name = e.name
+	s.X = e.x
+	s.Y = e.y
+	s.Facilities = e.snapshotSliceFacilities(e.facilities)
+	return s
+}
+
+func NewSubCityEntitySnap(version uint64, e *SubCityEntity) *SubCityEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &SubCityEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *SubCityEntitySnap) Clone() *SubCityEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &SubCityEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]SubCityEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneSubCityEntityCollectionChange(ch)
+		}
+	}
+	out.State.Facilities = append([]FacilityState(nil), s.State.Facilities...)
+	return out
+}
+
+func (e *SubCityEntity) CityId() CityID {
+	if e == nil {
+		var z CityID
+		return z
+	}
+	return e.cityId
+}
+
+func (e *SubCityEntity) SetCityId(v CityID) bool {
+	if e == nil {
+		return false
+	}
+	if e.cityId == v {
+		return false
+	}
+	e.cityId = v
+	e._dt.mark(FieldSubCity_cityId)
+	return true
+}
+
+func (e *SubCityEntity) Seq() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.seq
+}
+
+func (e *SubCityEntity) SetSeq(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.seq == v {
+		return false
+	}
+	e.seq = v
+	e._dt.mark(FieldSubCity_seq)
+	return true
+}
+
+func (e *SubCityEntity) Name() string {
+	if e == nil {
+		var z string
+		return z
+	}
+	return e.name
+}
+
+func (e *SubCityEntity) SetName(v string) bool {
+	if e == nil {
+		return false
+	}
+	if e.name == v {
+		return false
+	}
+	e.name = v
+	e._dt.mark(FieldSubCity_name)
+	return true
+}
+
+func (e *SubCityEntity) X() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.x
+}
+
+func (e *SubCityEntity) SetX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.x == v {
+		return false
+	}
+	e.x = v
+	e._dt.mark(FieldSubCity_x)
+	return true
+}
+
+func (e *SubCityEntity) Y() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.y
+}
+
+func (e *SubCityEntity) SetY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.y == v {
+		return false
+	}
+	e.y = v
+	e._dt.mark(FieldSubCity_y)
+	return true
+}
+
+func (e *SubCityEntity) LenFacilities() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.facilities)
+}
+
+func (e *SubCityEntity) AtFacilities(index int) (FacilityState, bool) {
+	var z FacilityState
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.facilities) {
+		return z, false
+	}
+	v := e.facilities[index]
+	if v == nil {
+		return z, true
+	}
+	return v.Save(), true
+}
+
+func (e *SubCityEntity) ForEachFacilities(fn func(index int, value FacilityState)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.facilities {
+		var state FacilityState
+		if v != nil {
+			state = v.Save()
+		}
+		fn(i, state)
+	}
+}
+
+func (e *SubCityEntity) RangeFacilities(fn func(index int, value FacilityState) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.facilities {
+		var state FacilityState
+		if v != nil {
+			state = v.Save()
+		}
+		if !fn(i, state) {
+			return
+		}
+	}
+}
+
+func (e *SubCityEntity) ReplaceFacilities(v []FacilityState) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualFacilities(e.snapshotSliceFacilities(e.facilities), v) {
+		return false
+	}
+	e.facilities = e.hydrateSliceFacilities(v)
+	e._dt.markFullReplace(FieldSubCity_facilities)
+	return true
+}
+
+func (e *SubCityEntity) AppendFacilities(values ...FacilityState) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		rv := HydrateFacilityEntity(v)
+		e.facilities = append(e.facilities, rv)
+		e._dt.markSliceAppend(FieldSubCity_facilities, v)
+	}
+	return true
+}
+
+func (e *SubCityEntity) SetFacilitiesAt(index int, value FacilityState) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.facilities) {
+		return false
+	}
+	var oldState FacilityState
+	if e.facilities[index] != nil {
+		oldState = e.facilities[index].Save()
+	}
+	if reflect.DeepEqual(oldState, value) {
+		return false
+	}
+	e.facilities[index] = HydrateFacilityEntity(value)
+	e._dt.markSliceSet(FieldSubCity_facilities, index, value)
+	return true
+}
+
+func (e *SubCityEntity) UpdateFacilitiesAt(index int, fn func(value *FacilityEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.facilities) {
+		return false
+	}
+	v := e.facilities[index]
+	if v == nil {
+		return false
+	}
+	before := v.Save()
+	fn(v)
+	after := v.Save()
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markSliceSet(FieldSubCity_facilities, index, after)
+	return true
+}
+
+func (e *SubCityEntity) RemoveFacilitiesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.facilities) {
+		return false
+	}
+	e.facilities = append(e.facilities[:index], e.facilities[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldSubCity_facilities, index)
+	return true
+}
+
+func (e *SubCityEntity) SwapRemoveFacilitiesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.facilities) {
+		return false
+	}
+	last := len(e.facilities) - 1
+	if index != last {
+		e.facilities[index] = e.facilities[last]
+	}
+	e.facilities = e.facilities[:last]
+	e._dt.markSliceSwapRemoveAt(FieldSubCity_facilities, index)
+	return true
+}
+
+func (e *SubCityEntity) ClearFacilities() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.facilities) == 0 {
+		return false
+	}
+	e.facilities = nil
+	e._dt.markFullReplace(FieldSubCity_facilities)
+	return true
+}
@@ -27,6 +27,7 @@ const (
 	FieldWarReport_x                 Field = "x"
 	FieldWarReport_y                 Field = "y"
 	FieldWarReport_cTime             Field = "cTime"
+	FieldWarReport_defenders         Field = "defenders"
 )
 
 var emptyWarReportEntity = &WarReportEntity{}
@@ -182,6 +183,7 @@ type WarReportState struct {
 	X                 int
 	Y                 int
 	CTime             int
+	Defenders         []int
 }
 
 type WarReportEntitySnap struct {
@@ -212,6 +214,7 @@ type WarReportEntity struct {
 	x                 int
 	y                 int
 	cTime             int
+	defenders         []int
 	_dt               WarReportEntityTrace
 }
 
@@ -395,6 +398,24 @@ func (e *WarReportEntity) slicesEqualEndDefenseGeneral(a, b []GeneralState) bool
 	return true
 }
 
+func (e *WarReportEntity) slicesEqualDefenders(a, b []int) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func HydrateWarReportEntity(s WarReportState) *WarReportEntity {
 	return &WarReportEntity{
 		id:                s.Id,
@@ -417,6 +438,7 @@ func HydrateWarReportEntity(s WarReportState) *WarReportEntity {
 		x:                 s.X,
 		y:                 s.Y,
 		cTime:             s.CTime,
+		defenders:         append([]int(nil), s.Defenders...),
 	}
 }
 
@@ -612,6 +634,7 @@ func (e *WarReportEntity) Save() WarReportState {
 	s.X = e.x
 	s.Y = e.y
 	s.CTime = e.cTime
+	s.Defenders = append([]int(nil), e.defenders...)
 	return s
 }
 
@@ -646,6 +669,7 @@ func (s *WarReportEntitySnap) Clone() *WarReportEntitySnap {
 	out.State.BegDefenseGeneral = append([]GeneralState(nil), s.State.BegDefenseGeneral...)
 	out.State.EndAttackGeneral = append([]GeneralState(nil), s.State.EndAttackGeneral...)
 	out.State.EndDefenseGeneral = append([]GeneralState(nil), s.State.EndDefenseGeneral...)
+	out.State.Defenders = append([]int(nil), s.State.Defenders...)
 	return out
 }
 
@@ -1680,3 +1704,119 @@ func (e *WarReportEntity) SetCTime(v int) bool {
 	e._dt.mark(FieldWarReport_cTime)
 	return true
 }
+
+func (e *WarReportEntity) LenDefenders() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.defenders)
+}
+
+func (e *WarReportEntity) AtDefenders(index int) (int, bool) {
+	var z int
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.defenders) {
+		return z, false
+	}
+	return e.defenders[index], true
+}
+
+func (e *WarReportEntity) ForEachDefenders(fn func(index int, value int)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.defenders {
+		fn(i, v)
+	}
+}
+
+func (e *WarReportEntity) RangeDefenders(fn func(index int, value int) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.defenders {
+		if !fn(i, v) {
+			return
+		}
+	}
+}
+
+func (e *WarReportEntity) ReplaceDefenders(v []int) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualDefenders(e.defenders, v) {
+		return false
+	}
+	e.defenders = append([]int(nil), v...)
+	e._dt.markFullReplace(FieldWarReport_defenders)
+	return true
+}
+
+func (e *WarReportEntity) AppendDefenders(values ...int) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	e.defenders = append(e.defenders, values...)
+	for _, v := range values {
+		e._dt.markSliceAppend(FieldWarReport_defenders, v)
+	}
+	return true
+}
+
+func (e *WarReportEntity) SetDefendersAt(index int, value int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.defenders) {
+		return false
+	}
+	if e.defenders[index] == value {
+		return false
+	}
+	e.defenders[index] = value
+	e._dt.markSliceSet(FieldWarReport_defenders, index, value)
+	return true
+}
+
+func (e *WarReportEntity) RemoveDefendersAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.defenders) {
+		return false
+	}
+	e.defenders = append(e.defenders[:index], e.defenders[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldWarReport_defenders, index)
+	return true
+}
+
+func (e *WarReportEntity) SwapRemoveDefendersAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.defenders) {
+		return false
+	}
+	last := len(e.defenders) - 1
+	if index != last {
+		e.defenders[index] = e.defenders[last]
+	}
+	e.defenders = e.defenders[:last]
+	e._dt.markSliceSwapRemoveAt(FieldWarReport_defenders, index)
+	return true
+}
+
+func (e *WarReportEntity) ClearDefenders() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.defenders) == 0 {
+		return false
+	}
+	e.defenders = nil
+	e._dt.markFullReplace(FieldWarReport_defenders)
+	return true
+}
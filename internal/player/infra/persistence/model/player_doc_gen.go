@@ -6,21 +6,23 @@ import (
 )
 
 type PlayerDoc struct {
-	PlayerID     PlayerID             `bson:"player_id"`
-	WorldID      WorldID              `bson:"world_id"`
-	AllianceID   AllianceID           `bson:"alliance_id"`
-	AllianceName string               `bson:"alliance_name"`
-	CityID       CityID               `bson:"city_id"`
-	Profile      RoleDoc              `bson:"profile"`
-	Resource     ResourceDoc          `bson:"resource"`
-	Attribute    RoleAttributeDoc     `bson:"attribute"`
-	Buildings    []BuildingDoc        `bson:"buildings"`
-	Armies       map[int]ArmyDoc      `bson:"armies"`
-	Generals     map[int]GeneralDoc   `bson:"generals"`
-	Facility     []FacilityDoc        `bson:"facility"`
-	WarReports   map[int]WarReportDoc `bson:"war_reports"`
-	Skills       map[int]SkillDoc     `bson:"skills"`
-	City         CityDoc              `bson:"city"`
+	PlayerID     PlayerID              `bson:"player_id"`
+	WorldID      WorldID               `bson:"world_id"`
+	AllianceID   AllianceID            `bson:"alliance_id"`
+	AllianceName string                `bson:"alliance_name"`
+	CityID       CityID                `bson:"city_id"`
+	Profile      RoleDoc               `bson:"profile"`
+	Resource     ResourceDoc           `bson:"resource"`
+	Attribute    RoleAttributeDoc      `bson:"attribute"`
+	Buildings    []BuildingDoc         `bson:"buildings"`
+	Armies       map[int]ArmyDoc       `bson:"armies"`
+	Generals     map[int]GeneralDoc    `bson:"generals"`
+	Facility     []FacilityDoc         `bson:"facility"`
+	WarReports   map[int]WarReportDoc  `bson:"war_reports"`
+	Skills       map[int]SkillDoc      `bson:"skills"`
+	City         CityDoc               `bson:"city"`
+	SubCities    map[CityID]SubCityDoc `bson:"sub_cities"`
+	Ledger       []ResourceLogDoc      `bson:"ledger"`
 }
 
 func toDocSlice_buildings(in []entity.BuildingState) []BuildingDoc {
@@ -155,6 +157,50 @@ func toStateMap_skills(in map[int]SkillDoc) map[int]entity.SkillState {
 	return out
 }
 
+func toDocMap_subCities(in map[CityID]entity.SubCityState) map[CityID]SubCityDoc {
+	if in == nil {
+		return nil
+	}
+	out := make(map[CityID]SubCityDoc, len(in))
+	for k, v := range in {
+		out[k] = SubCityStateToDoc(v)
+	}
+	return out
+}
+
+func toStateMap_subCities(in map[CityID]SubCityDoc) map[CityID]entity.SubCityState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[CityID]entity.SubCityState, len(in))
+	for k, v := range in {
+		out[k] = SubCityDocToState(v)
+	}
+	return out
+}
+
+func toDocSlice_ledger(in []entity.ResourceLogState) []ResourceLogDoc {
+	if in == nil {
+		return nil
+	}
+	out := make([]ResourceLogDoc, len(in))
+	for i, v := range in {
+		out[i] = ResourceLogStateToDoc(v)
+	}
+	return out
+}
+
+func toStateSlice_ledger(in []ResourceLogDoc) []entity.ResourceLogState {
+	if in == nil {
+		return nil
+	}
+	out := make([]entity.ResourceLogState, len(in))
+	for i, v := range in {
+		out[i] = ResourceLogDocToState(v)
+	}
+	return out
+}
+
 func PlayerStateToDoc(s entity.PlayerState) PlayerDoc {
 	state := entity.HydratePlayerEntity(s).Save()
 	return PlayerDoc{
@@ -173,6 +219,8 @@ func PlayerStateToDoc(s entity.PlayerState) PlayerDoc {
 		WarReports:   toDocMap_warReports(state.WarReports),
 		Skills:       toDocMap_skills(state.Skills),
 		City:         CityStateToDoc(state.City),
+		SubCities:    toDocMap_subCities(state.SubCities),
+		Ledger:       toDocSlice_ledger(state.Ledger),
 	}
 }
 
@@ -193,6 +241,8 @@ func PlayerDocToState(d PlayerDoc) entity.PlayerState {
 		WarReports:   toStateMap_warReports(d.WarReports),
 		Skills:       toStateMap_skills(d.Skills),
 		City:         CityDocToState(d.City),
+		SubCities:    toStateMap_subCities(d.SubCities),
+		Ledger:       toStateSlice_ledger(d.Ledger),
 	}
 	return entity.HydratePlayerEntity(state).Save()
 }
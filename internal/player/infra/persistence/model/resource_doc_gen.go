@@ -6,37 +6,40 @@ import (
 )
 
 type ResourceDoc struct {
-	Wood      int   `bson:"wood"`
-	Iron      int   `bson:"iron"`
-	Stone     int   `bson:"stone"`
-	Grain     int   `bson:"grain"`
-	Gold      int   `bson:"gold"`
-	Decree    int   `bson:"decree"`
-	LastClaim int64 `bson:"last_claim"`
+	Wood        int   `bson:"wood"`
+	Iron        int   `bson:"iron"`
+	Stone       int   `bson:"stone"`
+	Grain       int   `bson:"grain"`
+	Gold        int   `bson:"gold"`
+	Decree      int   `bson:"decree"`
+	LastClaim   int64 `bson:"last_claim"`
+	DecreeClaim int64 `bson:"decree_claim"`
 }
 
 func ResourceStateToDoc(s entity.ResourceState) ResourceDoc {
 	state := entity.HydrateResourceEntity(s).Save()
 	return ResourceDoc{
-		Wood:      state.Wood,
-		Iron:      state.Iron,
-		Stone:     state.Stone,
-		Grain:     state.Grain,
-		Gold:      state.Gold,
-		Decree:    state.Decree,
-		LastClaim: state.LastClaim,
+		Wood:        state.Wood,
+		Iron:        state.Iron,
+		Stone:       state.Stone,
+		Grain:       state.Grain,
+		Gold:        state.Gold,
+		Decree:      state.Decree,
+		LastClaim:   state.LastClaim,
+		DecreeClaim: state.DecreeClaim,
 	}
 }
 
 func ResourceDocToState(d ResourceDoc) entity.ResourceState {
 	state := entity.ResourceState{
-		Wood:      d.Wood,
-		Iron:      d.Iron,
-		Stone:     d.Stone,
-		Grain:     d.Grain,
-		Gold:      d.Gold,
-		Decree:    d.Decree,
-		LastClaim: d.LastClaim,
+		Wood:        d.Wood,
+		Iron:        d.Iron,
+		Stone:       d.Stone,
+		Grain:       d.Grain,
+		Gold:        d.Gold,
+		Decree:      d.Decree,
+		LastClaim:   d.LastClaim,
+		DecreeClaim: d.DecreeClaim,
 	}
 	return entity.HydrateResourceEntity(state).Save()
 }
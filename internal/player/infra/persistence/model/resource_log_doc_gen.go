@@ -0,0 +1,45 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/player/entity"
+)
+
+type ResourceLogDoc struct {
+	Reason    string `bson:"reason"`
+	Wood      int    `bson:"wood"`
+	Iron      int    `bson:"iron"`
+	Stone     int    `bson:"stone"`
+	Grain     int    `bson:"grain"`
+	Gold      int    `bson:"gold"`
+	Decree    int    `bson:"decree"`
+	CreatedAt int64  `bson:"created_at"`
+}
+
+func ResourceLogStateToDoc(s entity.ResourceLogState) ResourceLogDoc {
+	state := entity.HydrateResourceLogEntity(s).Save()
+	return ResourceLogDoc{
+		Reason:    state.Reason,
+		Wood:      state.Wood,
+		Iron:      state.Iron,
+		Stone:     state.Stone,
+		Grain:     state.Grain,
+		Gold:      state.Gold,
+		Decree:    state.Decree,
+		CreatedAt: state.CreatedAt,
+	}
+}
+
+func ResourceLogDocToState(d ResourceLogDoc) entity.ResourceLogState {
+	state := entity.ResourceLogState{
+		Reason:    d.Reason,
+		Wood:      d.Wood,
+		Iron:      d.Iron,
+		Stone:     d.Stone,
+		Grain:     d.Grain,
+		Gold:      d.Gold,
+		Decree:    d.Decree,
+		CreatedAt: d.CreatedAt,
+	}
+	return entity.HydrateResourceLogEntity(state).Save()
+}
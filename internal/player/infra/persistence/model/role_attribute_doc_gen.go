@@ -11,6 +11,10 @@ type RoleAttributeDoc struct {
 	CollectTimes    int8        `bson:"collect_times"`
 	LastCollectTime time.Time   `bson:"last_collect_time"`
 	PosTags         []PosTagDoc `bson:"pos_tags"`
+	TradeAmount     int         `bson:"trade_amount"`
+	LastTradeTime   time.Time   `bson:"last_trade_time"`
+	LastMoveTime    time.Time   `bson:"last_move_time"`
+	VassalEnd       time.Time   `bson:"vassal_end"`
 }
 
 func toDocSlice_posTags(in []entity.PosTagState) []PosTagDoc {
@@ -42,6 +46,10 @@ func RoleAttributeStateToDoc(s entity.RoleAttributeState) RoleAttributeDoc {
 		CollectTimes:    state.CollectTimes,
 		LastCollectTime: state.LastCollectTime,
 		PosTags:         toDocSlice_posTags(state.PosTags),
+		TradeAmount:     state.TradeAmount,
+		LastTradeTime:   state.LastTradeTime,
+		LastMoveTime:    state.LastMoveTime,
+		VassalEnd:       state.VassalEnd,
 	}
 }
 
@@ -51,6 +59,10 @@ func RoleAttributeDocToState(d RoleAttributeDoc) entity.RoleAttributeState {
 		CollectTimes:    d.CollectTimes,
 		LastCollectTime: d.LastCollectTime,
 		PosTags:         toStateSlice_posTags(d.PosTags),
+		TradeAmount:     d.TradeAmount,
+		LastTradeTime:   d.LastTradeTime,
+		LastMoveTime:    d.LastMoveTime,
+		VassalEnd:       d.VassalEnd,
 	}
 	return entity.HydrateRoleAttributeEntity(state).Save()
 }
@@ -0,0 +1,61 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/player/entity"
+)
+
+type SubCityDoc struct {
+	CityId     CityID        `bson:"city_id"`
+	Seq        int           `bson:"seq"`
+	Name       string        `bson:"name"`
+	X          int           `bson:"x"`
+	Y          int           `bson:"y"`
+	Facilities []FacilityDoc `bson:"facilities"`
+}
+
+func toDocSlice_facilities(in []entity.FacilityState) []FacilityDoc {
+	if in == nil {
+		return nil
+	}
+	out := make([]FacilityDoc, len(in))
+	for i, v := range in {
+		out[i] = FacilityStateToDoc(v)
+	}
+	return out
+}
+
+func toStateSlice_facilities(in []FacilityDoc) []entity.FacilityState {
+	if in == nil {
+		return nil
+	}
+	out := make([]entity.FacilityState, len(in))
+	for i, v := range in {
+		out[i] = FacilityDocToState(v)
+	}
+	return out
+}
+
+func SubCityStateToDoc(s entity.SubCityState) SubCityDoc {
+	state := entity.HydrateSubCityEntity(s).Save()
+	return SubCityDoc{
+		CityId:     state.CityId,
+		Seq:        state.Seq,
+		Name:       state.Name,
+		X:          state.X,
+		Y:          state.Y,
+		Facilities: toDocSlice_facilities(state.Facilities),
+	}
+}
+
+func SubCityDocToState(d SubCityDoc) entity.SubCityState {
+	state := entity.SubCityState{
+		CityId:     d.CityId,
+		Seq:        d.Seq,
+		Name:       d.Name,
+		X:          d.X,
+		Y:          d.Y,
+		Facilities: toStateSlice_facilities(d.Facilities),
+	}
+	return entity.HydrateSubCityEntity(state).Save()
+}
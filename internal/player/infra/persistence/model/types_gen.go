@@ -23,6 +23,8 @@ const (
 	ArmyCmdBack        = 4 //撤退
 	ArmyCmdConscript   = 5 //征兵
 	ArmyCmdTransfer    = 6 //调动
+	ArmyCmdRally       = 7 //集结
+	ArmyCmdReinforce   = 8 //增援盟友
 )
 
 const (
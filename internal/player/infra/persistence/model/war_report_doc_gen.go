@@ -26,6 +26,7 @@ type WarReportDoc struct {
 	X                 int          `bson:"x"`
 	Y                 int          `bson:"y"`
 	CTime             int          `bson:"c_time"`
+	Defenders         []int        `bson:"defenders"`
 }
 
 func toDocSlice_begAttackGeneral(in []entity.GeneralState) []GeneralDoc {
@@ -139,6 +140,7 @@ func WarReportStateToDoc(s entity.WarReportState) WarReportDoc {
 		X:                 state.X,
 		Y:                 state.Y,
 		CTime:             state.CTime,
+		Defenders:         state.Defenders,
 	}
 }
 
@@ -164,6 +166,7 @@ func WarReportDocToState(d WarReportDoc) entity.WarReportState {
 		X:                 d.X,
 		Y:                 d.Y,
 		CTime:             d.CTime,
+		Defenders:         d.Defenders,
 	}
 	return entity.HydrateWarReportEntity(state).Save()
 }
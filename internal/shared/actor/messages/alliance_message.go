@@ -1,5 +1,7 @@
 package messages
 
+import "time"
+
 type AllianceMessage interface {
 	WorldID() int
 	AllianceID() int
@@ -33,12 +35,21 @@ func (a *AllianceBaseMessage) PlayerID() int {
 	return a.PlayerId
 }
 
+// HAAllianceList 联盟列表查询，Cursor 为上一页返回的 NextCursor，首页为空
 type HAAllianceList struct {
 	AllianceBaseMessage
+	Keyword string // 名称关键字，不区分大小写
+	Prefix  bool   // true 按前缀匹配，否则按子串匹配
+	Sort    AllianceSort
+	HasFree bool // 只返回还有空位的联盟
+	Cursor  string
+	Size    int
 }
 
 type AHAllianceList struct {
-	List []Alliance
+	List       []Alliance
+	NextCursor string // 为空表示没有下一页
+	Total      int    // 满足条件的联盟数
 }
 
 type HAAllianceInfo struct {
@@ -56,3 +67,208 @@ type HAAllianceApplyList struct {
 type AHAllianceApplyList struct {
 	ApplyItem []ApplyItem
 }
+
+type HACreateAlliance struct {
+	AllianceBaseMessage
+	Name     string
+	NickName string
+	Pos      Pos
+	Deadline time.Time // manager 分配 id 时填写，过期后联盟 actor 拒绝创建
+}
+
+type AHCreateAlliance struct {
+	OK       bool
+	Reason   string
+	Alliance Alliance
+	Pending  bool // 创建请求仍在处理，稍后再查
+}
+
+// HACreatedAlliance 创建应答丢失时按盟主查询联盟，PlayerId 为创建者，应答为 AHCreateAlliance
+type HACreatedAlliance struct {
+	AllianceBaseMessage
+}
+
+type HAJoinAlliance struct {
+	AllianceBaseMessage
+	NickName string
+}
+
+type HAVerifyApply struct {
+	AllianceBaseMessage
+	TargetId int
+	Decide   AllianceApplyStatus
+}
+
+type HALeaveAlliance struct {
+	AllianceBaseMessage
+}
+
+type HAKickMember struct {
+	AllianceBaseMessage
+	TargetId int
+}
+
+type HADismissAlliance struct {
+	AllianceBaseMessage
+}
+
+// AHResult 联盟写操作的统一应答，Reason 直接返回给前端
+type AHResult struct {
+	OK     bool
+	Reason string
+}
+
+// AllianceDismissed 联盟解散后通知 manager 移除摘要并停止 actor
+type AllianceDismissed struct {
+	WorldId    int
+	AllianceId int
+}
+
+// WASysBuildingChanged 系统建筑易主，由 world 发给 manager，manager 更新归属索引后通知新旧联盟
+type WASysBuildingChanged struct {
+	WorldId    int
+	AllianceId int // 新的所属联盟
+	Building   SysBuilding
+}
+
+// HASysBuilding manager 通知联盟系统建筑的得失
+type HASysBuilding struct {
+	AllianceBaseMessage
+	Building SysBuilding
+	Add      bool
+}
+
+// HAAllianceBonus 查询联盟占领系统建筑带来的加成
+type HAAllianceBonus struct {
+	AllianceBaseMessage
+}
+
+type AHAllianceBonus struct {
+	OK     bool
+	Yield  int // 产出加成，百分比
+	Speed  int // 行军速度加成，百分比
+	Member int // 成员上限加成
+}
+
+// HARallyCheck 发起集结前校验发起人是否有标记目标的权限
+type HARallyCheck struct {
+	AllianceBaseMessage
+}
+
+// HAAddMark 添加联盟地图标记，Expire 为有效期（秒），0 表示取配置的最长有效期
+type HAAddMark struct {
+	AllianceBaseMessage
+	Pos    Pos
+	Kind   AllianceMarkKind
+	Label  string
+	Expire int
+}
+
+// HADelMark 删除联盟地图标记
+type HADelMark struct {
+	AllianceBaseMessage
+	Id int
+}
+
+// HAMarkList 成员查看联盟地图标记
+type HAMarkList struct {
+	AllianceBaseMessage
+}
+
+type AHMarkList struct {
+	OK    bool
+	Marks []AllianceMark
+}
+
+// HAProposeDiplomacy 盟主向其他联盟提出外交变更，宣战立即生效，停战和互不侵犯需要对方同意；
+// TargetName 由 manager 按联盟摘要填入
+type HAProposeDiplomacy struct {
+	AllianceBaseMessage
+	TargetId   int
+	TargetName string
+	State      DiplomacyState
+}
+
+// HAAcceptDiplomacy 盟主同意对方的停战或互不侵犯提议
+type HAAcceptDiplomacy struct {
+	AllianceBaseMessage
+	TargetId int
+}
+
+// HADiplomacyList 查看和其他联盟的外交关系
+type HADiplomacyList struct {
+	AllianceBaseMessage
+}
+
+type AHDiplomacyList struct {
+	OK        bool
+	Relations []Diplomacy
+}
+
+// AADiplomacy 联盟之间同步外交变更，经 manager 转发给 AllianceId 对应的联盟；
+// Propose 为 true 表示只是提议，否则 State 和 Until 立即生效
+type AADiplomacy struct {
+	AllianceBaseMessage
+	FromId   int
+	FromName string
+	State    DiplomacyState
+	Until    time.Time
+	Propose  bool
+}
+
+// HAAppointTitle 任免副盟主，Title 为副盟主或普通成员
+type HAAppointTitle struct {
+	AllianceBaseMessage
+	TargetId int
+	Title    AllianceTitle
+}
+
+// HATransferChairman 盟主让位
+type HATransferChairman struct {
+	AllianceBaseMessage
+	TargetId int
+}
+
+// HAMemberActive 成员上线，刷新活跃时间和战力
+type HAMemberActive struct {
+	AllianceBaseMessage
+	Power int
+}
+
+// HATribute 附庸上供，PlayerId 为附庸玩家，不要求是联盟成员
+type HATribute struct {
+	AllianceBaseMessage
+	Wood  int
+	Iron  int
+	Stone int
+	Grain int
+	Gold  int
+}
+
+// HAEditNotice 修改联盟公告
+type HAEditNotice struct {
+	AllianceBaseMessage
+	Text string
+}
+
+// HAAllianceLog 分页查询联盟动态，Page 从 1 开始
+type HAAllianceLog struct {
+	AllianceBaseMessage
+	Page int
+	Size int
+}
+
+type AHAllianceLog struct {
+	OK    bool
+	Logs  []AllianceLog
+	Total int
+}
+
+// WAAllianceLog world 上报的领地事件，PlayerId 为 0，事件玩家放在 TargetId
+type WAAllianceLog struct {
+	AllianceBaseMessage
+	Kind       AllianceLogKind
+	TargetId   int
+	TargetName string
+	Pos        Pos
+}
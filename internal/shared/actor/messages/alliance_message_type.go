@@ -18,13 +18,81 @@ const (
 	ALLIANCE_ADOPT     AllianceApplyStatus = 2 // 通过
 )
 
+// 联盟列表排序，除按 id 外都是从大到小
+type AllianceSort int32
+
+const (
+	ALLIANCE_SORT_ID        AllianceSort = 0 // 按创建顺序
+	ALLIANCE_SORT_CNT       AllianceSort = 1 // 按成员数
+	ALLIANCE_SORT_TERRITORY AllianceSort = 2 // 按占领的系统建筑数
+	ALLIANCE_SORT_POWER     AllianceSort = 3 // 按战力
+)
+
+// 联盟动态类型
+type AllianceLogKind int32
+
+const (
+	ALLIANCE_LOG_CREATE   AllianceLogKind = 0  // 创建联盟
+	ALLIANCE_LOG_JOIN     AllianceLogKind = 1  // 加入
+	ALLIANCE_LOG_LEAVE    AllianceLogKind = 2  // 退出
+	ALLIANCE_LOG_KICK     AllianceLogKind = 3  // 踢出
+	ALLIANCE_LOG_APPOINT  AllianceLogKind = 4  // 任免
+	ALLIANCE_LOG_TRANSFER AllianceLogKind = 5  // 盟主变更
+	ALLIANCE_LOG_NOTICE   AllianceLogKind = 6  // 修改公告
+	ALLIANCE_LOG_CAPTURE  AllianceLogKind = 7  // 占领领地
+	ALLIANCE_LOG_LOSE     AllianceLogKind = 8  // 失去领地
+	ALLIANCE_LOG_WAR      AllianceLogKind = 9  // 宣战
+	ALLIANCE_LOG_TRUCE    AllianceLogKind = 10 // 停战
+	ALLIANCE_LOG_NAP      AllianceLogKind = 11 // 互不侵犯
+)
+
+// 联盟地图标记类型
+type AllianceMarkKind int32
+
+const (
+	ALLIANCE_MARK_ATTACK AllianceMarkKind = 0 // 进攻
+	ALLIANCE_MARK_DEFEND AllianceMarkKind = 1 // 防守
+	ALLIANCE_MARK_GATHER AllianceMarkKind = 2 // 集合
+)
+
+// 联盟外交状态，除中立外都有期限，到期回到中立
+type DiplomacyState int32
+
+const (
+	DIPLOMACY_NEUTRAL DiplomacyState = 0 // 中立
+	DIPLOMACY_WAR     DiplomacyState = 1 // 宣战
+	DIPLOMACY_TRUCE   DiplomacyState = 2 // 停战
+	DIPLOMACY_NAP     DiplomacyState = 3 // 互不侵犯
+)
+
+// Diplomacy 和另一个联盟的外交关系
+type Diplomacy struct {
+	AllianceId int
+	Name       string
+	State      DiplomacyState
+	Until      int64          // 到期时间，毫秒
+	Proposal   DiplomacyState // 待确认的提议，中立表示没有
+	Incoming   bool           // 提议是否由对方发起
+}
+
 type Alliance struct {
 	// 联盟摘要：当前用于联盟列表；后续可按业务演进持续补充字段。
-	Id     int32
-	Name   string
-	Cnt    int32
-	Notice string
-	Major  []*Major
+	Id        int32
+	Name      string
+	Cnt       int32
+	Notice    string
+	Major     []*Major
+	Buildings []SysBuilding // 占领的系统城市和要塞
+	Limit     int32         // 成员上限
+	Power     int32         // 成员战力合计
+	Territory int32         // 占领的系统建筑数
+}
+
+// SysBuilding 联盟占领的系统建筑
+type SysBuilding struct {
+	Pos   Pos
+	Kind  int8
+	Level int8
 }
 
 type Major struct {
@@ -52,3 +120,23 @@ type ApplyItem struct {
 	PlayerId int
 	NickName string
 }
+
+type AllianceLog struct {
+	Kind       AllianceLogKind
+	OpId       int
+	OpName     string
+	TargetId   int
+	TargetName string
+	Title      AllianceTitle
+	Pos        Pos
+	Ctime      int64 // 毫秒
+}
+
+type AllianceMark struct {
+	Id        int
+	Pos       Pos
+	Kind      AllianceMarkKind
+	Label     string
+	CreatorId int
+	Expire    int64 // 毫秒
+}
@@ -1,5 +1,7 @@
 package messages
 
+import "time"
+
 type PlayerMessage interface {
 	PlayerID() int
 	WorldID() int
@@ -33,3 +35,34 @@ type WHArmySync struct {
 	PlayerBaseMessage
 	Army *Army
 }
+
+// WHReclamationYield 屯田到达后按领地的资源产量给玩家结算
+type WHReclamationYield struct {
+	PlayerBaseMessage
+	ArmyId int
+	Pos    Pos
+	Wood   int
+	Iron   int
+	Stone  int
+	Grain  int
+}
+
+// WHVassalChanged 沦陷状态变化通知玩家，ParentId 为 0 表示脱离
+type WHVassalChanged struct {
+	PlayerBaseMessage
+	ParentId  int
+	VassalEnd time.Time
+}
+
+// AHAllianceChanged 联盟成员变化通知玩家，Join 为 false 表示离开该联盟
+type AHAllianceChanged struct {
+	PlayerBaseMessage
+	AllianceId   int
+	AllianceName string
+	Join         bool
+}
+
+type PHAllianceChanged struct {
+	OK  bool
+	Pos Pos
+}
@@ -1,7 +1,7 @@
 package messages
 
 import (
-	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
 	"time"
 )
 
@@ -54,6 +54,11 @@ type HWScanBlock struct {
 	X, Y, Length int
 }
 
+// HWClearView 玩家登出后清掉 world 记录的视野，不再推送视野内的变化
+type HWClearView struct {
+	WorldBaseMessage
+}
+
 type WHScanBlock struct {
 	Cities    []WorldCity
 	Armies    []Army
@@ -82,6 +87,72 @@ type WHBack struct {
 	Army Army
 }
 
+type HWReclamation struct {
+	WorldBaseMessage
+	Pos  Pos
+	Army Army
+}
+
+type WHReclamation struct {
+	OK        bool
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type HWGiveUp struct {
+	WorldBaseMessage
+	Pos Pos
+}
+
+type WHGiveUp struct {
+	OK         bool
+	GiveUpTime time.Time
+}
+
+type HWCreateSubCity struct {
+	WorldBaseMessage
+	Pos        Pos
+	Name       string
+	Facilities []Facility
+}
+
+type WHCreateSubCity struct {
+	OK     bool
+	CityId int
+}
+
+type HWMarketTrade struct {
+	WorldBaseMessage
+	From [4]int // 0 木 1 铁 2 石 3 粮
+	To   [4]int
+	Rate int // 兑换率，百分比
+}
+
+type WHMarketTrade struct {
+	OK     bool
+	Prices [4]int // 成交后的行情，千分比
+}
+
+type HWMoveCity struct {
+	WorldBaseMessage
+	Pos Pos
+}
+
+type WHMoveCity struct {
+	OK     bool
+	CityId int
+}
+
+type HWSyncAlliance struct {
+	WorldBaseMessage
+	AllianceId   int
+	AllianceName string
+}
+
+type WHSyncAlliance struct {
+	OK bool
+}
+
 type HWSyncCityFacility struct {
 	WorldBaseMessage
 	CityId     int
@@ -92,19 +163,97 @@ type WHSyncCityFacility struct {
 	OK bool
 }
 
-type WorldPushBatch struct {
+// HWTeleportArmy 运营把空闲的军队直接送回所属城池，Pos 为城池坐标
+type HWTeleportArmy struct {
 	WorldBaseMessage
-	MsgType MsgType
-	Items   []WorldPushItem
+	ArmyId int
+	CityId int
+	Pos    Pos
 }
 
-type MsgType string
+type WHTeleportArmy struct {
+	OK bool
+}
 
-const (
-	ArmyPush = "army.push"
-)
+// HWRallyCreate 发起集结，集结点为发起人主城
+type HWRallyCreate struct {
+	WorldBaseMessage
+	AllianceId int
+	Target     Pos
+}
+
+type WHRally struct {
+	OK    bool
+	Rally Rally
+}
+
+// HWRallyJoin 派军队参加集结，需在截止前到达集结点
+type HWRallyJoin struct {
+	WorldBaseMessage
+	RallyId int
+	Army    Army
+}
+
+type WHRallyJoin struct {
+	OK        bool
+	RallyPos  Pos
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// HWRallyList 本联盟等待中的集结
+type HWRallyList struct {
+	WorldBaseMessage
+	AllianceId int
+}
+
+type WHRallyList struct {
+	OK      bool
+	Rallies []Rally
+}
+
+// HWReinforce 派军队增援盟友的城池或领地
+type HWReinforce struct {
+	WorldBaseMessage
+	Pos  Pos
+	Army Army
+}
+
+type WHReinforce struct {
+	OK        bool
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// HWReinforceBack 遣返增援军队，领主和增援方都可以发起
+type HWReinforceBack struct {
+	WorldBaseMessage
+	Pos     Pos
+	OwnerId int // 增援军队所属玩家
+	ArmyId  int
+}
 
-type WorldPushItem struct {
-	PlayerID int64
-	Army     *playerpb.Army // ArmyPush
+type WHReinforceBack struct {
+	OK bool
+}
+
+// AWDiplomacy 联盟外交变更后同步给 world，攻打和免战判定以此为准
+type AWDiplomacy struct {
+	WorldBaseMessage
+	AllianceId int
+	TargetId   int
+	State      DiplomacyState
+	Until      time.Time
+}
+
+// AWAllianceDismissed 联盟解散后通知 world 清掉它的外交关系，并释放它的附庸
+type AWAllianceDismissed struct {
+	WorldBaseMessage
+	AllianceId int
+}
+
+// WorldPushBatch 经 world 发给 gate 的推送，ws 的推送路由由 gate 按 item 的 payload 类型决定
+type WorldPushBatch struct {
+	WorldBaseMessage
+	Items []*gatepb.PushItem
 }
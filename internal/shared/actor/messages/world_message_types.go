@@ -105,6 +105,21 @@ type Army struct {
 	End        int64 //出征结束时间（毫秒时间戳）
 }
 
+// Rally 联盟集结
+type Rally struct {
+	Id       int
+	LeaderId int
+	Target   Pos
+	RallyPos Pos   // 集结点
+	Deadline int64 // 出发时间（毫秒时间戳）
+	Armies   []RallyArmy
+}
+
+type RallyArmy struct {
+	PlayerId int
+	ArmyId   int
+}
+
 type BattleResult int
 
 const (
@@ -115,8 +130,9 @@ const (
 
 type WarReport struct {
 	Id                int
-	Attacker          int // 进攻方 id
-	Defender          int // 防守方 id
+	Attacker          int   // 进攻方 id
+	Defender          int   // 防守方 id
+	Defenders         []int // 参与防守的玩家，含增援的盟友
 	BegAttackArmy     *Army
 	BegDefenseArmy    *Army
 	EndAttackArmy     *Army
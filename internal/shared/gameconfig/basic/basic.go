@@ -2,6 +2,7 @@ package basic
 
 import (
 	"ThreeKingdoms/internal/shared/config"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
 	"path/filepath"
 	"runtime"
 )
@@ -46,9 +47,11 @@ type role struct {
 	BuildLimit        int    `json:"build_limit"`    //野外建筑上限
 	RecoveryTime      int    `json:"recovery_time"`
 	DecreeLimit       int    `json:"decree_limit"`        //令牌上限
+	DecreeRecovery    int    `json:"decree_recovery"`     //政令恢复间隔，单位秒，每个间隔恢复一点
 	CollectTimesLimit int8   `json:"collect_times_limit"` //每日征收次数上限
 	CollectInterval   int    `json:"collect_interval"`    //征收间隔
 	PosTagLimit       int8   `json:"pos_tag_limit"`       //位置标签上限
+	PosTagNameLen     int    `json:"pos_tag_name_len"`    //位置标签名称最大长度（字符数）
 }
 
 type city struct {
@@ -57,22 +60,109 @@ type city struct {
 	Durable       int    `json:"durable"`
 	RecoveryTime  int    `json:"recovery_time"`
 	TransformRate int    `json:"transform_rate"`
+	SubCityLevels []int  `json:"sub_city_levels"` //第 n 座分城需要的主城等级，长度即分城上限
+	SubCityDecree int    `json:"sub_city_decree"` //建分城消耗政令
+	SubCityGold   int    `json:"sub_city_gold"`   //建分城消耗金币
+	NameLen       int    `json:"name_len"`        //城池名称最大长度（字符数）
+	MoveDecree    int    `json:"move_decree"`     //迁城消耗政令
+	MoveGold      int    `json:"move_gold"`       //迁城消耗金币
+	MoveCD        int64  `json:"move_cd"`         //迁城冷却时间，单位秒
 }
 
 type build struct {
 	Des           string `json:"des"`
 	WarFree       int64  `json:"war_free"`       //免战时间，单位秒
 	GiveUpTime    int64  `json:"giveUp_time"`    //建筑放弃时间
+	GiveUpCost    int    `json:"giveUp_cost"`    //放弃领地消耗政令
 	FortressLimit int    `json:"fortress_limit"` //要塞上限
 }
 
+type market struct {
+	Des        string `json:"des"`
+	PriceBand  int    `json:"price_band"`  //价格浮动范围，千分比
+	VolumeStep int    `json:"volume_step"` //成交量每达到该值价格浮动 1‰
+	DriftTime  int    `json:"drift_time"`  //价格每隔多少秒向基准回归 1‰
+	DailyLimit int    `json:"daily_limit"` //每日可卖出的资源总量
+}
+
+type vassal struct {
+	Des      string `json:"des"`
+	Duration int    `json:"duration"` //沦陷持续时间，秒
+	Tribute  int    `json:"tribute"`  //附庸上供的产出比例，百分比
+}
+
 type npcLevel struct {
 	Soilders int `json:"soilders"`
 }
 
-type union struct {
+type sysBonus struct {
+	Yield  int `json:"yield"`  //成员资源产出加成，百分比
+	Speed  int `json:"speed"`  //成员行军速度加成，百分比
+	Member int `json:"member"` //联盟成员上限加成
+}
+
+type sysBuild struct {
+	Des       string     `json:"des"`
+	Fortress  sysBonus   `json:"fortress"`   //系统要塞加成
+	City      sysBonus   `json:"city"`       //系统城市加成
+	NpcLevels []npcLevel `json:"npc_levels"` //守军每队兵力，按建筑等级取
+}
+
+// BonusOf 按建筑类型取加成，不是系统建筑返回零值
+func (s sysBuild) BonusOf(kind int8) sysBonus {
+	switch kind {
+	case _map.MapBuildSysFortress:
+		return s.Fortress
+	case _map.MapBuildSysCity:
+		return s.City
+	}
+	return sysBonus{}
+}
+
+// NpcSoldiers 守军每队兵力，等级超出配置时取最高一级
+func (s sysBuild) NpcSoldiers(level int8) int {
+	if len(s.NpcLevels) == 0 {
+		return 0
+	}
+	i := min(max(int(level)-1, 0), len(s.NpcLevels)-1)
+	return s.NpcLevels[i].Soilders
+}
+
+type rally struct {
 	Des         string `json:"des"`
-	MemberLimit int    `json:"member_limit"`
+	WaitTime    int    `json:"wait_time"`    //集结等待时间，秒
+	MemberLimit int    `json:"member_limit"` //参与集结的军队上限
+	CityLevel   int8   `json:"city_level"`   //该等级及以上的系统城市只能集结攻打
+}
+
+type reinforce struct {
+	Des          string `json:"des"`
+	CityPerLevel int    `json:"city_per_level"` //城池每级可容纳的增援军队数
+	CellPerLevel int    `json:"cell_per_level"` //领地每级可容纳的增援军队数
+	Max          int    `json:"max"`            //增援军队数上限
+}
+
+type diplomacy struct {
+	Des           string `json:"des"`
+	WarDuration   int    `json:"war_duration"`   //宣战持续时间，秒
+	TruceDuration int    `json:"truce_duration"` //停战持续时间，秒
+	NapDuration   int    `json:"nap_duration"`   //互不侵犯持续时间，秒
+	WarDestroy    int    `json:"war_destroy"`    //宣战期间攻城破坏力，百分比
+}
+
+type union struct {
+	Des          string            `json:"des"`
+	MemberLimit  int               `json:"member_limit"`
+	CreateGold   int               `json:"create_gold"`    //创建联盟消耗金币
+	NameLen      int               `json:"name_len"`       //联盟名称最大长度（字符数）
+	ViceLimit    int               `json:"vice_limit"`     //副盟主人数上限
+	AbdicateDays int               `json:"abdicate_days"`  //盟主连续不活跃天数，超过后自动让位
+	Permission   map[string][]int8 `json:"permission"`     //权限表，key 为权限名，value 为允许的职位
+	NoticeLen    int               `json:"notice_len"`     //公告最大长度（字符数）
+	LogLimit     int               `json:"log_limit"`      //联盟动态保留条数
+	MarkLimit    int               `json:"mark_limit"`     //地图标记数量上限
+	MarkLabelLen int               `json:"mark_label_len"` //地图标记说明最大长度（字符数）
+	MarkExpire   int               `json:"mark_expire"`    //地图标记最长有效期，秒
 }
 
 type basic struct {
@@ -82,6 +172,12 @@ type basic struct {
 	City      city      `json:"city"`
 	Union     union     `json:"union"`
 	Build     build     `json:"build"`
+	Market    market    `json:"market"`
+	Vassal    vassal    `json:"vassal"`
+	SysBuild  sysBuild  `json:"sys_build"`
+	Rally     rally     `json:"rally"`
+	Reinforce reinforce `json:"reinforce"`
+	Diplomacy diplomacy `json:"diplomacy"`
 }
 
 var BasicConf = basic{}
@@ -92,6 +188,9 @@ const MaxRound = 10
 // 军队武将数量
 const ArmyGCnt = 3
 
+// 集市基准价格，千分比
+const MarketBasePrice = 1000
+
 func Load() {
 	_, file, _, ok := runtime.Caller(0)
 	if !ok {
@@ -220,6 +220,27 @@ func (f *Facility) GetFacilityYield(level int) FacilityYield {
 	return y
 }
 
+// GetAddition 返回设施在某等级下指定加成类型的数值
+func (f *Facility) GetAddition(level int, aType int8) int {
+	if f == nil || f.LevelMap == nil {
+		return 0
+	}
+	l, ok := f.LevelMap[level]
+	if !ok {
+		return 0
+	}
+	value := 0
+	for i, t := range f.Additions {
+		if i >= len(l.Values) {
+			break
+		}
+		if t == aType {
+			value += l.Values[i]
+		}
+	}
+	return value
+}
+
 func (f *facilityConf) MaxLevel(t int8) int {
 	facility, b := f.GetFacility(t)
 
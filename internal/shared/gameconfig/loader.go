@@ -7,6 +7,7 @@ import (
 	"ThreeKingdoms/internal/shared/gameconfig/general"
 	_map "ThreeKingdoms/internal/shared/gameconfig/map"
 	"ThreeKingdoms/internal/shared/gameconfig/skill"
+	"ThreeKingdoms/internal/shared/gameconfig/words"
 
 	"go.uber.org/zap"
 )
@@ -18,5 +19,6 @@ func LoadGameConfig(logger *zap.Logger) {
 	facility.Load()
 	general.Load()
 	skill.Load()
+	words.Load()
 	//logger.Info()
 }
@@ -0,0 +1,38 @@
+package words
+
+import (
+	"ThreeKingdoms/internal/shared/config"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+type bannedWords struct {
+	Des   string   `json:"des"`
+	Words []string `json:"words"`
+}
+
+var BannedConf = bannedWords{}
+
+func Load() {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		panic("load banned words config failed: runtime.Caller(0) error")
+	}
+	configPath := filepath.Join(filepath.Dir(file), "banned_words.json")
+	config.Load(configPath, &BannedConf)
+}
+
+// HasBanned 文本中是否包含屏蔽词，忽略大小写
+func HasBanned(text string) bool {
+	text = strings.ToLower(text)
+	for _, w := range BannedConf.Words {
+		if w == "" {
+			continue
+		}
+		if strings.Contains(text, strings.ToLower(w)) {
+			return true
+		}
+	}
+	return false
+}
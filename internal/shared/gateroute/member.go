@@ -0,0 +1,57 @@
+package gateroute
+
+import (
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	"context"
+)
+
+// Member 单个 gate 在路由表里的登记，id 为其他进程访问这个 gate 的 grpc 地址
+type Member struct {
+	id       string
+	registry Registry
+	router   *Router
+}
+
+func NewMember(id string, registry Registry) *Member {
+	return &Member{id: id, registry: registry, router: NewRouter(registry)}
+}
+
+func (m *Member) ID() string {
+	return m.id
+}
+
+// Claim 登记 uid 的会话在本 gate 上，之前在别的 gate 上时通知那边踢掉旧会话
+func (m *Member) Claim(ctx context.Context, uid int) error {
+	prev, err := m.registry.Claim(ctx, uid, m.id)
+	if err != nil || prev == "" || prev == m.id {
+		return err
+	}
+	_, err = m.router.Kick(ctx, &gatepb.KickRequest{PlayerId: int64(uid), Gate: prev})
+	return err
+}
+
+// Locate uid 的会话所在的 gate，没有会话时为空
+func (m *Member) Locate(ctx context.Context, uid int) (string, error) {
+	gates, err := m.registry.Lookup(ctx, []int{uid})
+	if err != nil {
+		return "", err
+	}
+	return gates[uid], nil
+}
+
+// Router 发往其他 gate 的推送和踢人
+func (m *Member) Router() *Router {
+	return m.router
+}
+
+func (m *Member) Release(ctx context.Context, uid int) error {
+	return m.registry.Release(ctx, uid, m.id)
+}
+
+func (m *Member) Reset(ctx context.Context) error {
+	return m.registry.Reset(ctx, m.id)
+}
+
+func (m *Member) Close() error {
+	return m.router.Close()
+}
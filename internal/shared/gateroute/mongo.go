@@ -0,0 +1,100 @@
+package gateroute
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const defaultCollectionName = "gate_session"
+
+type sessionDoc struct {
+	UID       int       `bson:"_id"`
+	Gate      string    `bson:"gate"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// MongoRegistry 多个 gate 和 player 进程共用的路由表
+type MongoRegistry struct {
+	coll *mongo.Collection
+}
+
+func NewMongoRegistry(db *mongo.Database) *MongoRegistry {
+	return &MongoRegistry{
+		coll: db.Collection(defaultCollectionName),
+	}
+}
+
+func (r *MongoRegistry) Claim(ctx context.Context, uid int, gate string) (string, error) {
+	if r == nil || r.coll == nil {
+		return "", errors.New("mongodb gate session collection is nil")
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+	update := bson.M{"$set": bson.M{"gate": gate, "updated_at": time.Now()}}
+	var prev sessionDoc
+	err := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": uid}, update, opts).Decode(&prev)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return prev.Gate, nil
+}
+
+func (r *MongoRegistry) Release(ctx context.Context, uid int, gate string) error {
+	if r == nil || r.coll == nil {
+		return errors.New("mongodb gate session collection is nil")
+	}
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": uid, "gate": gate})
+	return err
+}
+
+func (r *MongoRegistry) Lookup(ctx context.Context, uids []int) (map[int]string, error) {
+	if r == nil || r.coll == nil {
+		return nil, errors.New("mongodb gate session collection is nil")
+	}
+	out := make(map[int]string, len(uids))
+	if len(uids) == 0 {
+		return out, nil
+	}
+	cur, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": uids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var doc sessionDoc
+		if err := cur.Decode(&doc); err != nil {
+			return nil, err
+		}
+		out[doc.UID] = doc.Gate
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MongoRegistry) Reset(ctx context.Context, gate string) error {
+	if r == nil || r.coll == nil {
+		return errors.New("mongodb gate session collection is nil")
+	}
+	_, err := r.coll.DeleteMany(ctx, bson.M{"gate": gate})
+	return err
+}
+
+// EnsureIndexes 创建 gate 启动时按 gate 清理用的索引，已存在时不会重复创建
+func (r *MongoRegistry) EnsureIndexes(ctx context.Context) error {
+	if r == nil || r.coll == nil {
+		return errors.New("mongodb gate session collection is nil")
+	}
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "gate", Value: 1}}})
+	return err
+}
+
+var _ Registry = (*MongoRegistry)(nil)
@@ -0,0 +1,75 @@
+package gateroute
+
+import (
+	"context"
+	"sync"
+)
+
+// RegistryMongo 多个 gate 部署时配置的路由表后端，其他值都按单个 gate 处理
+const RegistryMongo = "mongo"
+
+// Registry 记录每个 uid 的会话在哪个 gate 上，gate 用它的 grpc 地址标识。
+// gate 登录和重连时登记，会话结束时删除；player、world 推送时按它把消息发到对应的 gate
+type Registry interface {
+	// Claim 登记 uid 由 gate 持有，返回之前持有的 gate，没有时为空
+	Claim(ctx context.Context, uid int, gate string) (string, error)
+	// Release 只在 uid 仍由 gate 持有时删除，已经被别的 gate 接走的不动
+	Release(ctx context.Context, uid int, gate string) error
+	// Lookup 批量查询 uid 所在的 gate，没有会话的 uid 不在结果里
+	Lookup(ctx context.Context, uids []int) (map[int]string, error)
+	// Reset 删除 gate 持有的所有记录，gate 启动时清掉上次异常退出留下的
+	Reset(ctx context.Context, gate string) error
+}
+
+// MemoryRegistry 进程内的路由表，只能在同一个进程里共享，
+// 用于单个 gate 部署和测试
+type MemoryRegistry struct {
+	mu    sync.RWMutex
+	gates map[int]string
+}
+
+func NewMemoryRegistry() *MemoryRegistry {
+	return &MemoryRegistry{gates: make(map[int]string)}
+}
+
+func (r *MemoryRegistry) Claim(_ context.Context, uid int, gate string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prev := r.gates[uid]
+	r.gates[uid] = gate
+	return prev, nil
+}
+
+func (r *MemoryRegistry) Release(_ context.Context, uid int, gate string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.gates[uid] == gate {
+		delete(r.gates, uid)
+	}
+	return nil
+}
+
+func (r *MemoryRegistry) Lookup(_ context.Context, uids []int) (map[int]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make(map[int]string, len(uids))
+	for _, uid := range uids {
+		if gate, ok := r.gates[uid]; ok {
+			out[uid] = gate
+		}
+	}
+	return out, nil
+}
+
+func (r *MemoryRegistry) Reset(_ context.Context, gate string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for uid, g := range r.gates {
+		if g == gate {
+			delete(r.gates, uid)
+		}
+	}
+	return nil
+}
+
+var _ Registry = (*MemoryRegistry)(nil)
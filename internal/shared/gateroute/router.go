@@ -0,0 +1,147 @@
+package gateroute
+
+import (
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	transportgrpc "ThreeKingdoms/internal/shared/transport/grpc"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Router 按路由表把推送拆开发给 uid 所在的 gate，到各个 gate 的连接按需建立并复用。
+// 实现 GatePushServiceClient，可以直接替换只连一个 gate 的 client
+type Router struct {
+	registry Registry
+
+	mu      sync.Mutex
+	conns   map[string]*grpc.ClientConn
+	clients map[string]gatepb.GatePushServiceClient
+}
+
+func NewRouter(registry Registry) *Router {
+	return &Router{
+		registry: registry,
+		conns:    make(map[string]*grpc.ClientConn),
+		clients:  make(map[string]gatepb.GatePushServiceClient),
+	}
+}
+
+func (r *Router) client(gate string) (gatepb.GatePushServiceClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok := r.clients[gate]; ok {
+		return c, nil
+	}
+	conn, c, err := transportgrpc.DialGatePushService(gate)
+	if err != nil {
+		return nil, err
+	}
+	r.conns[gate] = conn
+	r.clients[gate] = c
+	return c, nil
+}
+
+// PushBatch 按 uid 所在的 gate 分组后并发发送，没有会话的 uid 直接丢弃。
+// 部分 gate 失败时其余 gate 照常推送，错误合并返回
+func (r *Router) PushBatch(ctx context.Context, in *gatepb.PushBatchRequest, opts ...grpc.CallOption) (*gatepb.PushBatchReply, error) {
+	if in == nil || len(in.Items) == 0 {
+		return &gatepb.PushBatchReply{Ok: true}, nil
+	}
+	uids := make([]int, 0, len(in.Items))
+	for _, item := range in.Items {
+		if item != nil && item.PlayerId > 0 {
+			uids = append(uids, int(item.PlayerId))
+		}
+	}
+	gates, err := r.registry.Lookup(ctx, uids)
+	if err != nil {
+		return nil, fmt.Errorf("lookup gate failed: %w", err)
+	}
+
+	batches := make(map[string]*gatepb.PushBatchRequest)
+	for _, item := range in.Items {
+		if item == nil {
+			continue
+		}
+		gate, ok := gates[int(item.PlayerId)]
+		if !ok {
+			continue
+		}
+		batch, ok := batches[gate]
+		if !ok {
+			batch = &gatepb.PushBatchRequest{WorldId: in.WorldId}
+			batches[gate] = batch
+		}
+		batch.Items = append(batch.Items, item)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for gate, batch := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := r.push(ctx, gate, batch, opts...); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("push to gate %s: %w", gate, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &gatepb.PushBatchReply{Ok: true}, nil
+}
+
+func (r *Router) push(ctx context.Context, gate string, batch *gatepb.PushBatchRequest, opts ...grpc.CallOption) error {
+	c, err := r.client(gate)
+	if err != nil {
+		return err
+	}
+	_, err = c.PushBatch(ctx, batch, opts...)
+	return err
+}
+
+// Kick 发给 in.Gate 指定的 gate，为空时按路由表查 uid 当前所在的 gate
+func (r *Router) Kick(ctx context.Context, in *gatepb.KickRequest, opts ...grpc.CallOption) (*gatepb.KickReply, error) {
+	if in == nil || in.PlayerId <= 0 {
+		return &gatepb.KickReply{Ok: true}, nil
+	}
+	gate := in.Gate
+	if gate == "" {
+		gates, err := r.registry.Lookup(ctx, []int{int(in.PlayerId)})
+		if err != nil {
+			return nil, fmt.Errorf("lookup gate failed: %w", err)
+		}
+		if gate = gates[int(in.PlayerId)]; gate == "" {
+			return &gatepb.KickReply{Ok: true}, nil
+		}
+	}
+	c, err := r.client(gate)
+	if err != nil {
+		return nil, err
+	}
+	return c.Kick(ctx, in, opts...)
+}
+
+func (r *Router) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for gate, conn := range r.conns {
+		errs = append(errs, conn.Close())
+		delete(r.conns, gate)
+		delete(r.clients, gate)
+	}
+	return errors.Join(errs...)
+}
+
+var _ gatepb.GatePushServiceClient = (*Router)(nil)
@@ -271,6 +271,102 @@ func (x *RegisterReply) GetResult() *common.BizResult {
 	return nil
 }
 
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int32                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	LogoutTime    int64                  `protobuf:"varint,2,opt,name=logout_time,json=logoutTime,proto3" json:"logout_time,omitempty"` // 毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_account_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_account_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogoutRequest) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *LogoutRequest) GetLogoutTime() int64 {
+	if x != nil {
+		return x.LogoutTime
+	}
+	return 0
+}
+
+type LogoutReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *common.BizResult      `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutReply) Reset() {
+	*x = LogoutReply{}
+	mi := &file_account_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutReply) ProtoMessage() {}
+
+func (x *LogoutReply) ProtoReflect() protoreflect.Message {
+	mi := &file_account_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutReply.ProtoReflect.Descriptor instead.
+func (*LogoutReply) Descriptor() ([]byte, []int) {
+	return file_account_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LogoutReply) GetResult() *common.BizResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 var File_account_account_proto protoreflect.FileDescriptor
 
 const file_account_account_proto_rawDesc = "" +
@@ -293,10 +389,17 @@ const file_account_account_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
 	"\bhardware\x18\x03 \x01(\tR\bhardware\"I\n" +
 	"\rRegisterReply\x128\n" +
-	"\x06result\x18\x01 \x01(\v2 .three_kingdoms.common.BizResultR\x06result2\xbf\x01\n" +
+	"\x06result\x18\x01 \x01(\v2 .three_kingdoms.common.BizResultR\x06result\"B\n" +
+	"\rLogoutRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x05R\x03uid\x12\x1f\n" +
+	"\vlogout_time\x18\x02 \x01(\x03R\n" +
+	"logoutTime\"G\n" +
+	"\vLogoutReply\x128\n" +
+	"\x06result\x18\x01 \x01(\v2 .three_kingdoms.common.BizResultR\x06result2\x95\x02\n" +
 	"\x0eAccountService\x12Q\n" +
 	"\x05Login\x12$.three_kingdoms.account.LoginRequest\x1a\".three_kingdoms.account.LoginReply\x12Z\n" +
-	"\bRegister\x12'.three_kingdoms.account.RegisterRequest\x1a%.three_kingdoms.account.RegisterReplyB5Z3ThreeKingdoms/internal/shared/gen/account;accountpbb\x06proto3"
+	"\bRegister\x12'.three_kingdoms.account.RegisterRequest\x1a%.three_kingdoms.account.RegisterReply\x12T\n" +
+	"\x06Logout\x12%.three_kingdoms.account.LogoutRequest\x1a#.three_kingdoms.account.LogoutReplyB5Z3ThreeKingdoms/internal/shared/gen/account;accountpbb\x06proto3"
 
 var (
 	file_account_account_proto_rawDescOnce sync.Once
@@ -310,26 +413,31 @@ func file_account_account_proto_rawDescGZIP() []byte {
 	return file_account_account_proto_rawDescData
 }
 
-var file_account_account_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_account_account_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_account_account_proto_goTypes = []any{
 	(*LoginRequest)(nil),     // 0: three_kingdoms.account.LoginRequest
 	(*LoginReply)(nil),       // 1: three_kingdoms.account.LoginReply
 	(*RegisterRequest)(nil),  // 2: three_kingdoms.account.RegisterRequest
 	(*RegisterReply)(nil),    // 3: three_kingdoms.account.RegisterReply
-	(*common.BizResult)(nil), // 4: three_kingdoms.common.BizResult
+	(*LogoutRequest)(nil),    // 4: three_kingdoms.account.LogoutRequest
+	(*LogoutReply)(nil),      // 5: three_kingdoms.account.LogoutReply
+	(*common.BizResult)(nil), // 6: three_kingdoms.common.BizResult
 }
 var file_account_account_proto_depIdxs = []int32{
-	4, // 0: three_kingdoms.account.LoginReply.result:type_name -> three_kingdoms.common.BizResult
-	4, // 1: three_kingdoms.account.RegisterReply.result:type_name -> three_kingdoms.common.BizResult
-	0, // 2: three_kingdoms.account.AccountService.Login:input_type -> three_kingdoms.account.LoginRequest
-	2, // 3: three_kingdoms.account.AccountService.Register:input_type -> three_kingdoms.account.RegisterRequest
-	1, // 4: three_kingdoms.account.AccountService.Login:output_type -> three_kingdoms.account.LoginReply
-	3, // 5: three_kingdoms.account.AccountService.Register:output_type -> three_kingdoms.account.RegisterReply
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: three_kingdoms.account.LoginReply.result:type_name -> three_kingdoms.common.BizResult
+	6, // 1: three_kingdoms.account.RegisterReply.result:type_name -> three_kingdoms.common.BizResult
+	6, // 2: three_kingdoms.account.LogoutReply.result:type_name -> three_kingdoms.common.BizResult
+	0, // 3: three_kingdoms.account.AccountService.Login:input_type -> three_kingdoms.account.LoginRequest
+	2, // 4: three_kingdoms.account.AccountService.Register:input_type -> three_kingdoms.account.RegisterRequest
+	4, // 5: three_kingdoms.account.AccountService.Logout:input_type -> three_kingdoms.account.LogoutRequest
+	1, // 6: three_kingdoms.account.AccountService.Login:output_type -> three_kingdoms.account.LoginReply
+	3, // 7: three_kingdoms.account.AccountService.Register:output_type -> three_kingdoms.account.RegisterReply
+	5, // 8: three_kingdoms.account.AccountService.Logout:output_type -> three_kingdoms.account.LogoutReply
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_account_account_proto_init() }
@@ -343,7 +451,7 @@ func file_account_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_account_proto_rawDesc), len(file_account_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
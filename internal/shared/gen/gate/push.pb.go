@@ -22,28 +22,29 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type WorldPushItem struct {
+// BattleResult 战斗结束后军队和参战武将的变化
+type BattleResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Army          *player.Army           `protobuf:"bytes,2,opt,name=army,proto3" json:"army,omitempty"`
+	Army          *player.Army           `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	Generals      []*player.General      `protobuf:"bytes,2,rep,name=generals,proto3" json:"generals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorldPushItem) Reset() {
-	*x = WorldPushItem{}
+func (x *BattleResult) Reset() {
+	*x = BattleResult{}
 	mi := &file_gate_push_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorldPushItem) String() string {
+func (x *BattleResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldPushItem) ProtoMessage() {}
+func (*BattleResult) ProtoMessage() {}
 
-func (x *WorldPushItem) ProtoReflect() protoreflect.Message {
+func (x *BattleResult) ProtoReflect() protoreflect.Message {
 	mi := &file_gate_push_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -55,48 +56,48 @@ func (x *WorldPushItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorldPushItem.ProtoReflect.Descriptor instead.
-func (*WorldPushItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use BattleResult.ProtoReflect.Descriptor instead.
+func (*BattleResult) Descriptor() ([]byte, []int) {
 	return file_gate_push_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *WorldPushItem) GetPlayerId() int64 {
+func (x *BattleResult) GetArmy() *player.Army {
 	if x != nil {
-		return x.PlayerId
+		return x.Army
 	}
-	return 0
+	return nil
 }
 
-func (x *WorldPushItem) GetArmy() *player.Army {
+func (x *BattleResult) GetGenerals() []*player.General {
 	if x != nil {
-		return x.Army
+		return x.Generals
 	}
 	return nil
 }
 
-type PushWorldBatchRequest struct {
+// FacilityDone 设施升级完成
+type FacilityDone struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorldId       int32                  `protobuf:"varint,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
-	MsgType       string                 `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
-	Items         []*WorldPushItem       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CityId        int64                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Facility      *player.Facility       `protobuf:"bytes,2,opt,name=facility,proto3" json:"facility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PushWorldBatchRequest) Reset() {
-	*x = PushWorldBatchRequest{}
+func (x *FacilityDone) Reset() {
+	*x = FacilityDone{}
 	mi := &file_gate_push_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushWorldBatchRequest) String() string {
+func (x *FacilityDone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushWorldBatchRequest) ProtoMessage() {}
+func (*FacilityDone) ProtoMessage() {}
 
-func (x *PushWorldBatchRequest) ProtoReflect() protoreflect.Message {
+func (x *FacilityDone) ProtoReflect() protoreflect.Message {
 	mi := &file_gate_push_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -108,54 +109,478 @@ func (x *PushWorldBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushWorldBatchRequest.ProtoReflect.Descriptor instead.
-func (*PushWorldBatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FacilityDone.ProtoReflect.Descriptor instead.
+func (*FacilityDone) Descriptor() ([]byte, []int) {
 	return file_gate_push_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *PushWorldBatchRequest) GetWorldId() int32 {
+func (x *FacilityDone) GetCityId() int64 {
 	if x != nil {
-		return x.WorldId
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *FacilityDone) GetFacility() *player.Facility {
+	if x != nil {
+		return x.Facility
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       int32                  `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"` // 0 世界，1 联盟
+	Rid           int32                  `protobuf:"varint,2,opt,name=rid,proto3" json:"rid,omitempty"`
+	NickName      string                 `protobuf:"bytes,3,opt,name=nick_name,json=nickName,proto3" json:"nick_name,omitempty"`
+	Msg           string                 `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Ctime         int64                  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"` // 毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_gate_push_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChatMessage) GetChannel() int32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetRid() int32 {
+	if x != nil {
+		return x.Rid
 	}
 	return 0
 }
 
-func (x *PushWorldBatchRequest) GetMsgType() string {
+func (x *ChatMessage) GetNickName() string {
 	if x != nil {
-		return x.MsgType
+		return x.NickName
 	}
 	return ""
 }
 
-func (x *PushWorldBatchRequest) GetItems() []*WorldPushItem {
+func (x *ChatMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Ctime         int64                  `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"` // 毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_gate_push_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Announcement) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Announcement) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// PushItem 推给单个玩家的一条消息，gate 按 payload 的类型找到 ws 的推送路由
+type PushItem struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*PushItem_Army
+	//	*PushItem_City
+	//	*PushItem_Alliance
+	//	*PushItem_Marks
+	//	*PushItem_AllianceLog
+	//	*PushItem_WarReport
+	//	*PushItem_BattleResult
+	//	*PushItem_Resource
+	//	*PushItem_Facility
+	//	*PushItem_Chat
+	//	*PushItem_Announcement
+	Payload       isPushItem_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushItem) Reset() {
+	*x = PushItem{}
+	mi := &file_gate_push_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushItem) ProtoMessage() {}
+
+func (x *PushItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushItem.ProtoReflect.Descriptor instead.
+func (*PushItem) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushItem) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PushItem) GetPayload() isPushItem_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PushItem) GetArmy() *player.Army {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Army); ok {
+			return x.Army
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetCity() *player.City {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_City); ok {
+			return x.City
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetAlliance() *player.Alliance {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Alliance); ok {
+			return x.Alliance
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetMarks() *player.AllianceMarkList {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Marks); ok {
+			return x.Marks
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetAllianceLog() *player.AllianceLog {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_AllianceLog); ok {
+			return x.AllianceLog
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetWarReport() *player.WarReport {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_WarReport); ok {
+			return x.WarReport
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetBattleResult() *BattleResult {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_BattleResult); ok {
+			return x.BattleResult
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetResource() *player.Resource {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Resource); ok {
+			return x.Resource
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetFacility() *FacilityDone {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Facility); ok {
+			return x.Facility
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *PushItem) GetAnnouncement() *Announcement {
+	if x != nil {
+		if x, ok := x.Payload.(*PushItem_Announcement); ok {
+			return x.Announcement
+		}
+	}
+	return nil
+}
+
+type isPushItem_Payload interface {
+	isPushItem_Payload()
+}
+
+type PushItem_Army struct {
+	Army *player.Army `protobuf:"bytes,2,opt,name=army,proto3,oneof"`
+}
+
+type PushItem_City struct {
+	City *player.City `protobuf:"bytes,3,opt,name=city,proto3,oneof"`
+}
+
+type PushItem_Alliance struct {
+	Alliance *player.Alliance `protobuf:"bytes,4,opt,name=alliance,proto3,oneof"`
+}
+
+type PushItem_Marks struct {
+	Marks *player.AllianceMarkList `protobuf:"bytes,5,opt,name=marks,proto3,oneof"`
+}
+
+type PushItem_AllianceLog struct {
+	AllianceLog *player.AllianceLog `protobuf:"bytes,6,opt,name=alliance_log,json=allianceLog,proto3,oneof"`
+}
+
+type PushItem_WarReport struct {
+	WarReport *player.WarReport `protobuf:"bytes,7,opt,name=war_report,json=warReport,proto3,oneof"`
+}
+
+type PushItem_BattleResult struct {
+	BattleResult *BattleResult `protobuf:"bytes,8,opt,name=battle_result,json=battleResult,proto3,oneof"`
+}
+
+type PushItem_Resource struct {
+	Resource *player.Resource `protobuf:"bytes,9,opt,name=resource,proto3,oneof"`
+}
+
+type PushItem_Facility struct {
+	Facility *FacilityDone `protobuf:"bytes,10,opt,name=facility,proto3,oneof"`
+}
+
+type PushItem_Chat struct {
+	Chat *ChatMessage `protobuf:"bytes,11,opt,name=chat,proto3,oneof"`
+}
+
+type PushItem_Announcement struct {
+	Announcement *Announcement `protobuf:"bytes,12,opt,name=announcement,proto3,oneof"`
+}
+
+func (*PushItem_Army) isPushItem_Payload() {}
+
+func (*PushItem_City) isPushItem_Payload() {}
+
+func (*PushItem_Alliance) isPushItem_Payload() {}
+
+func (*PushItem_Marks) isPushItem_Payload() {}
+
+func (*PushItem_AllianceLog) isPushItem_Payload() {}
+
+func (*PushItem_WarReport) isPushItem_Payload() {}
+
+func (*PushItem_BattleResult) isPushItem_Payload() {}
+
+func (*PushItem_Resource) isPushItem_Payload() {}
+
+func (*PushItem_Facility) isPushItem_Payload() {}
+
+func (*PushItem_Chat) isPushItem_Payload() {}
+
+func (*PushItem_Announcement) isPushItem_Payload() {}
+
+type PushBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldId       int32                  `protobuf:"varint,1,opt,name=world_id,json=worldId,proto3" json:"world_id,omitempty"`
+	Items         []*PushItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushBatchRequest) Reset() {
+	*x = PushBatchRequest{}
+	mi := &file_gate_push_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushBatchRequest) ProtoMessage() {}
+
+func (x *PushBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushBatchRequest.ProtoReflect.Descriptor instead.
+func (*PushBatchRequest) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PushBatchRequest) GetWorldId() int32 {
+	if x != nil {
+		return x.WorldId
+	}
+	return 0
+}
+
+func (x *PushBatchRequest) GetItems() []*PushItem {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type PushWorldBatchReply struct {
+type PushBatchReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PushWorldBatchReply) Reset() {
-	*x = PushWorldBatchReply{}
-	mi := &file_gate_push_proto_msgTypes[2]
+func (x *PushBatchReply) Reset() {
+	*x = PushBatchReply{}
+	mi := &file_gate_push_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushWorldBatchReply) String() string {
+func (x *PushBatchReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushWorldBatchReply) ProtoMessage() {}
+func (*PushBatchReply) ProtoMessage() {}
 
-func (x *PushWorldBatchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gate_push_proto_msgTypes[2]
+func (x *PushBatchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,34 +591,186 @@ func (x *PushWorldBatchReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushWorldBatchReply.ProtoReflect.Descriptor instead.
-func (*PushWorldBatchReply) Descriptor() ([]byte, []int) {
-	return file_gate_push_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PushBatchReply.ProtoReflect.Descriptor instead.
+func (*PushBatchReply) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PushWorldBatchReply) GetOk() bool {
+func (x *PushBatchReply) GetOk() bool {
 	if x != nil {
 		return x.Ok
 	}
 	return false
 }
 
+// KickRequest 玩家在另一个 gate 登录，踢掉本 gate 上的旧会话。gate 为目标 gate 的地址，
+// 经路由客户端发送时按它选连接，服务端不看。logout 为运营踢人，结束会话并登出，不留重连窗口
+type KickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Gate          string                 `protobuf:"bytes,2,opt,name=gate,proto3" json:"gate,omitempty"`
+	Logout        bool                   `protobuf:"varint,3,opt,name=logout,proto3" json:"logout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickRequest) Reset() {
+	*x = KickRequest{}
+	mi := &file_gate_push_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickRequest) ProtoMessage() {}
+
+func (x *KickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
+func (*KickRequest) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KickRequest) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *KickRequest) GetGate() string {
+	if x != nil {
+		return x.Gate
+	}
+	return ""
+}
+
+func (x *KickRequest) GetLogout() bool {
+	if x != nil {
+		return x.Logout
+	}
+	return false
+}
+
+// KickReply online 表示玩家在本 gate 上有会话，只有 logout 时填写
+type KickReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickReply) Reset() {
+	*x = KickReply{}
+	mi := &file_gate_push_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickReply) ProtoMessage() {}
+
+func (x *KickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_push_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickReply.ProtoReflect.Descriptor instead.
+func (*KickReply) Descriptor() ([]byte, []int) {
+	return file_gate_push_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KickReply) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *KickReply) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
 var File_gate_push_proto protoreflect.FileDescriptor
 
 const file_gate_push_proto_rawDesc = "" +
 	"\n" +
-	"\x0fgate/push.proto\x12\x13three_kingdoms.gate\x1a\x10player/arm.proto\"]\n" +
-	"\rWorldPushItem\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12/\n" +
-	"\x04army\x18\x02 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\"\x87\x01\n" +
-	"\x15PushWorldBatchRequest\x12\x19\n" +
-	"\bworld_id\x18\x01 \x01(\x05R\aworldId\x12\x19\n" +
-	"\bmsg_type\x18\x02 \x01(\tR\amsgType\x128\n" +
-	"\x05items\x18\x03 \x03(\v2\".three_kingdoms.gate.WorldPushItemR\x05items\"%\n" +
-	"\x13PushWorldBatchReply\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok2y\n" +
-	"\x0fGatePushService\x12f\n" +
-	"\x0ePushWorldBatch\x12*.three_kingdoms.gate.PushWorldBatchRequest\x1a(.three_kingdoms.gate.PushWorldBatchReplyB/Z-ThreeKingdoms/internal/shared/gen/gate;gatepbb\x06proto3"
+	"\x0fgate/push.proto\x12\x13three_kingdoms.gate\x1a\x10player/arm.proto\x1a\x11player/city.proto\x1a\x15player/alliance.proto\x1a\x17player/war_report.proto\x1a\x15player/resource.proto\x1a\x15player/facility.proto\x1a\x14player/general.proto\"{\n" +
+	"\fBattleResult\x12/\n" +
+	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\x12:\n" +
+	"\bgenerals\x18\x02 \x03(\v2\x1e.three_kingdoms.player.GeneralR\bgenerals\"d\n" +
+	"\fFacilityDone\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x03R\x06cityId\x12;\n" +
+	"\bfacility\x18\x02 \x01(\v2\x1f.three_kingdoms.player.FacilityR\bfacility\"~\n" +
+	"\vChatMessage\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\x05R\achannel\x12\x10\n" +
+	"\x03rid\x18\x02 \x01(\x05R\x03rid\x12\x1b\n" +
+	"\tnick_name\x18\x03 \x01(\tR\bnickName\x12\x10\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\x12\x14\n" +
+	"\x05ctime\x18\x05 \x01(\x03R\x05ctime\"d\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x14\n" +
+	"\x05ctime\x18\x04 \x01(\x03R\x05ctime\"\xd9\x05\n" +
+	"\bPushItem\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x121\n" +
+	"\x04army\x18\x02 \x01(\v2\x1b.three_kingdoms.player.ArmyH\x00R\x04army\x121\n" +
+	"\x04city\x18\x03 \x01(\v2\x1b.three_kingdoms.player.CityH\x00R\x04city\x12=\n" +
+	"\balliance\x18\x04 \x01(\v2\x1f.three_kingdoms.player.AllianceH\x00R\balliance\x12?\n" +
+	"\x05marks\x18\x05 \x01(\v2'.three_kingdoms.player.AllianceMarkListH\x00R\x05marks\x12G\n" +
+	"\falliance_log\x18\x06 \x01(\v2\".three_kingdoms.player.AllianceLogH\x00R\vallianceLog\x12A\n" +
+	"\n" +
+	"war_report\x18\a \x01(\v2 .three_kingdoms.player.WarReportH\x00R\twarReport\x12H\n" +
+	"\rbattle_result\x18\b \x01(\v2!.three_kingdoms.gate.BattleResultH\x00R\fbattleResult\x12'\n" +
+	"\bresource\x18\t \x01(\v2\t.ResourceH\x00R\bresource\x12?\n" +
+	"\bfacility\x18\n" +
+	" \x01(\v2!.three_kingdoms.gate.FacilityDoneH\x00R\bfacility\x126\n" +
+	"\x04chat\x18\v \x01(\v2 .three_kingdoms.gate.ChatMessageH\x00R\x04chat\x12G\n" +
+	"\fannouncement\x18\f \x01(\v2!.three_kingdoms.gate.AnnouncementH\x00R\fannouncementB\t\n" +
+	"\apayload\"b\n" +
+	"\x10PushBatchRequest\x12\x19\n" +
+	"\bworld_id\x18\x01 \x01(\x05R\aworldId\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.three_kingdoms.gate.PushItemR\x05items\" \n" +
+	"\x0ePushBatchReply\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"V\n" +
+	"\vKickRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x12\n" +
+	"\x04gate\x18\x02 \x01(\tR\x04gate\x12\x16\n" +
+	"\x06logout\x18\x03 \x01(\bR\x06logout\"3\n" +
+	"\tKickReply\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online2\xb4\x01\n" +
+	"\x0fGatePushService\x12W\n" +
+	"\tPushBatch\x12%.three_kingdoms.gate.PushBatchRequest\x1a#.three_kingdoms.gate.PushBatchReply\x12H\n" +
+	"\x04Kick\x12 .three_kingdoms.gate.KickRequest\x1a\x1e.three_kingdoms.gate.KickReplyB/Z-ThreeKingdoms/internal/shared/gen/gate;gatepbb\x06proto3"
 
 var (
 	file_gate_push_proto_rawDescOnce sync.Once
@@ -207,23 +784,52 @@ func file_gate_push_proto_rawDescGZIP() []byte {
 	return file_gate_push_proto_rawDescData
 }
 
-var file_gate_push_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gate_push_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_gate_push_proto_goTypes = []any{
-	(*WorldPushItem)(nil),         // 0: three_kingdoms.gate.WorldPushItem
-	(*PushWorldBatchRequest)(nil), // 1: three_kingdoms.gate.PushWorldBatchRequest
-	(*PushWorldBatchReply)(nil),   // 2: three_kingdoms.gate.PushWorldBatchReply
-	(*player.Army)(nil),           // 3: three_kingdoms.player.Army
+	(*BattleResult)(nil),            // 0: three_kingdoms.gate.BattleResult
+	(*FacilityDone)(nil),            // 1: three_kingdoms.gate.FacilityDone
+	(*ChatMessage)(nil),             // 2: three_kingdoms.gate.ChatMessage
+	(*Announcement)(nil),            // 3: three_kingdoms.gate.Announcement
+	(*PushItem)(nil),                // 4: three_kingdoms.gate.PushItem
+	(*PushBatchRequest)(nil),        // 5: three_kingdoms.gate.PushBatchRequest
+	(*PushBatchReply)(nil),          // 6: three_kingdoms.gate.PushBatchReply
+	(*KickRequest)(nil),             // 7: three_kingdoms.gate.KickRequest
+	(*KickReply)(nil),               // 8: three_kingdoms.gate.KickReply
+	(*player.Army)(nil),             // 9: three_kingdoms.player.Army
+	(*player.General)(nil),          // 10: three_kingdoms.player.General
+	(*player.Facility)(nil),         // 11: three_kingdoms.player.Facility
+	(*player.City)(nil),             // 12: three_kingdoms.player.City
+	(*player.Alliance)(nil),         // 13: three_kingdoms.player.Alliance
+	(*player.AllianceMarkList)(nil), // 14: three_kingdoms.player.AllianceMarkList
+	(*player.AllianceLog)(nil),      // 15: three_kingdoms.player.AllianceLog
+	(*player.WarReport)(nil),        // 16: three_kingdoms.player.WarReport
+	(*player.Resource)(nil),         // 17: Resource
 }
 var file_gate_push_proto_depIdxs = []int32{
-	3, // 0: three_kingdoms.gate.WorldPushItem.army:type_name -> three_kingdoms.player.Army
-	0, // 1: three_kingdoms.gate.PushWorldBatchRequest.items:type_name -> three_kingdoms.gate.WorldPushItem
-	1, // 2: three_kingdoms.gate.GatePushService.PushWorldBatch:input_type -> three_kingdoms.gate.PushWorldBatchRequest
-	2, // 3: three_kingdoms.gate.GatePushService.PushWorldBatch:output_type -> three_kingdoms.gate.PushWorldBatchReply
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9,  // 0: three_kingdoms.gate.BattleResult.army:type_name -> three_kingdoms.player.Army
+	10, // 1: three_kingdoms.gate.BattleResult.generals:type_name -> three_kingdoms.player.General
+	11, // 2: three_kingdoms.gate.FacilityDone.facility:type_name -> three_kingdoms.player.Facility
+	9,  // 3: three_kingdoms.gate.PushItem.army:type_name -> three_kingdoms.player.Army
+	12, // 4: three_kingdoms.gate.PushItem.city:type_name -> three_kingdoms.player.City
+	13, // 5: three_kingdoms.gate.PushItem.alliance:type_name -> three_kingdoms.player.Alliance
+	14, // 6: three_kingdoms.gate.PushItem.marks:type_name -> three_kingdoms.player.AllianceMarkList
+	15, // 7: three_kingdoms.gate.PushItem.alliance_log:type_name -> three_kingdoms.player.AllianceLog
+	16, // 8: three_kingdoms.gate.PushItem.war_report:type_name -> three_kingdoms.player.WarReport
+	0,  // 9: three_kingdoms.gate.PushItem.battle_result:type_name -> three_kingdoms.gate.BattleResult
+	17, // 10: three_kingdoms.gate.PushItem.resource:type_name -> Resource
+	1,  // 11: three_kingdoms.gate.PushItem.facility:type_name -> three_kingdoms.gate.FacilityDone
+	2,  // 12: three_kingdoms.gate.PushItem.chat:type_name -> three_kingdoms.gate.ChatMessage
+	3,  // 13: three_kingdoms.gate.PushItem.announcement:type_name -> three_kingdoms.gate.Announcement
+	4,  // 14: three_kingdoms.gate.PushBatchRequest.items:type_name -> three_kingdoms.gate.PushItem
+	5,  // 15: three_kingdoms.gate.GatePushService.PushBatch:input_type -> three_kingdoms.gate.PushBatchRequest
+	7,  // 16: three_kingdoms.gate.GatePushService.Kick:input_type -> three_kingdoms.gate.KickRequest
+	6,  // 17: three_kingdoms.gate.GatePushService.PushBatch:output_type -> three_kingdoms.gate.PushBatchReply
+	8,  // 18: three_kingdoms.gate.GatePushService.Kick:output_type -> three_kingdoms.gate.KickReply
+	17, // [17:19] is the sub-list for method output_type
+	15, // [15:17] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_gate_push_proto_init() }
@@ -231,13 +837,26 @@ func file_gate_push_proto_init() {
 	if File_gate_push_proto != nil {
 		return
 	}
+	file_gate_push_proto_msgTypes[4].OneofWrappers = []any{
+		(*PushItem_Army)(nil),
+		(*PushItem_City)(nil),
+		(*PushItem_Alliance)(nil),
+		(*PushItem_Marks)(nil),
+		(*PushItem_AllianceLog)(nil),
+		(*PushItem_WarReport)(nil),
+		(*PushItem_BattleResult)(nil),
+		(*PushItem_Resource)(nil),
+		(*PushItem_Facility)(nil),
+		(*PushItem_Chat)(nil),
+		(*PushItem_Announcement)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gate_push_proto_rawDesc), len(file_gate_push_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
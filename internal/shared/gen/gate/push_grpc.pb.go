@@ -19,14 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GatePushService_PushWorldBatch_FullMethodName = "/three_kingdoms.gate.GatePushService/PushWorldBatch"
+	GatePushService_PushBatch_FullMethodName = "/three_kingdoms.gate.GatePushService/PushBatch"
+	GatePushService_Kick_FullMethodName      = "/three_kingdoms.gate.GatePushService/Kick"
 )
 
 // GatePushServiceClient is the client API for GatePushService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GatePushServiceClient interface {
-	PushWorldBatch(ctx context.Context, in *PushWorldBatchRequest, opts ...grpc.CallOption) (*PushWorldBatchReply, error)
+	PushBatch(ctx context.Context, in *PushBatchRequest, opts ...grpc.CallOption) (*PushBatchReply, error)
+	Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*KickReply, error)
 }
 
 type gatePushServiceClient struct {
@@ -37,10 +39,20 @@ func NewGatePushServiceClient(cc grpc.ClientConnInterface) GatePushServiceClient
 	return &gatePushServiceClient{cc}
 }
 
-func (c *gatePushServiceClient) PushWorldBatch(ctx context.Context, in *PushWorldBatchRequest, opts ...grpc.CallOption) (*PushWorldBatchReply, error) {
+func (c *gatePushServiceClient) PushBatch(ctx context.Context, in *PushBatchRequest, opts ...grpc.CallOption) (*PushBatchReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PushWorldBatchReply)
-	err := c.cc.Invoke(ctx, GatePushService_PushWorldBatch_FullMethodName, in, out, cOpts...)
+	out := new(PushBatchReply)
+	err := c.cc.Invoke(ctx, GatePushService_PushBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatePushServiceClient) Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*KickReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickReply)
+	err := c.cc.Invoke(ctx, GatePushService_Kick_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +63,8 @@ func (c *gatePushServiceClient) PushWorldBatch(ctx context.Context, in *PushWorl
 // All implementations must embed UnimplementedGatePushServiceServer
 // for forward compatibility.
 type GatePushServiceServer interface {
-	PushWorldBatch(context.Context, *PushWorldBatchRequest) (*PushWorldBatchReply, error)
+	PushBatch(context.Context, *PushBatchRequest) (*PushBatchReply, error)
+	Kick(context.Context, *KickRequest) (*KickReply, error)
 	mustEmbedUnimplementedGatePushServiceServer()
 }
 
@@ -62,8 +75,11 @@ type GatePushServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedGatePushServiceServer struct{}
 
-func (UnimplementedGatePushServiceServer) PushWorldBatch(context.Context, *PushWorldBatchRequest) (*PushWorldBatchReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method PushWorldBatch not implemented")
+func (UnimplementedGatePushServiceServer) PushBatch(context.Context, *PushBatchRequest) (*PushBatchReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PushBatch not implemented")
+}
+func (UnimplementedGatePushServiceServer) Kick(context.Context, *KickRequest) (*KickReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method Kick not implemented")
 }
 func (UnimplementedGatePushServiceServer) mustEmbedUnimplementedGatePushServiceServer() {}
 func (UnimplementedGatePushServiceServer) testEmbeddedByValue()                         {}
@@ -86,20 +102,38 @@ func RegisterGatePushServiceServer(s grpc.ServiceRegistrar, srv GatePushServiceS
 	s.RegisterService(&GatePushService_ServiceDesc, srv)
 }
 
-func _GatePushService_PushWorldBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushWorldBatchRequest)
+func _GatePushService_PushBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatePushServiceServer).PushWorldBatch(ctx, in)
+		return srv.(GatePushServiceServer).PushBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatePushService_PushWorldBatch_FullMethodName,
+		FullMethod: GatePushService_PushBatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatePushServiceServer).PushWorldBatch(ctx, req.(*PushWorldBatchRequest))
+		return srv.(GatePushServiceServer).PushBatch(ctx, req.(*PushBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatePushService_Kick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatePushServiceServer).Kick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatePushService_Kick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatePushServiceServer).Kick(ctx, req.(*KickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -112,8 +146,12 @@ var GatePushService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*GatePushServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "PushWorldBatch",
-			Handler:    _GatePushService_PushWorldBatch_Handler,
+			MethodName: "PushBatch",
+			Handler:    _GatePushService_PushBatch_Handler,
+		},
+		{
+			MethodName: "Kick",
+			Handler:    _GatePushService_Kick_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.3
+// source: gate/ws.proto
+
+package gatepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ws 二进制编码的消息信封，握手时协商使用，请求和应答共用
+type WsEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 路由，如 army.assign
+	Code          int32                  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`                      // 应答的业务码，请求不填
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                 // 请求或应答消息，proto 编码
+	Msg           string                 `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`                         // 出错时的提示
+	Json          bool                   `protobuf:"varint,6,opt,name=json,proto3" json:"json,omitempty"`                      // payload 为 json，用于没有 proto 定义的消息（如心跳、握手、登录）
+	PushSeq       int64                  `protobuf:"varint,7,opt,name=push_seq,json=pushSeq,proto3" json:"push_seq,omitempty"` // 推送的序号，断线重连时用于补发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WsEnvelope) Reset() {
+	*x = WsEnvelope{}
+	mi := &file_gate_ws_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WsEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WsEnvelope) ProtoMessage() {}
+
+func (x *WsEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_gate_ws_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WsEnvelope.ProtoReflect.Descriptor instead.
+func (*WsEnvelope) Descriptor() ([]byte, []int) {
+	return file_gate_ws_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WsEnvelope) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *WsEnvelope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WsEnvelope) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *WsEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WsEnvelope) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *WsEnvelope) GetJson() bool {
+	if x != nil {
+		return x.Json
+	}
+	return false
+}
+
+func (x *WsEnvelope) GetPushSeq() int64 {
+	if x != nil {
+		return x.PushSeq
+	}
+	return 0
+}
+
+var File_gate_ws_proto protoreflect.FileDescriptor
+
+const file_gate_ws_proto_rawDesc = "" +
+	"\n" +
+	"\rgate/ws.proto\x12\x13three_kingdoms.gate\"\xa1\x01\n" +
+	"\n" +
+	"WsEnvelope\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12\x10\n" +
+	"\x03msg\x18\x05 \x01(\tR\x03msg\x12\x12\n" +
+	"\x04json\x18\x06 \x01(\bR\x04json\x12\x19\n" +
+	"\bpush_seq\x18\a \x01(\x03R\apushSeqB/Z-ThreeKingdoms/internal/shared/gen/gate;gatepbb\x06proto3"
+
+var (
+	file_gate_ws_proto_rawDescOnce sync.Once
+	file_gate_ws_proto_rawDescData []byte
+)
+
+func file_gate_ws_proto_rawDescGZIP() []byte {
+	file_gate_ws_proto_rawDescOnce.Do(func() {
+		file_gate_ws_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gate_ws_proto_rawDesc), len(file_gate_ws_proto_rawDesc)))
+	})
+	return file_gate_ws_proto_rawDescData
+}
+
+var file_gate_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gate_ws_proto_goTypes = []any{
+	(*WsEnvelope)(nil), // 0: three_kingdoms.gate.WsEnvelope
+}
+var file_gate_ws_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_gate_ws_proto_init() }
+func file_gate_ws_proto_init() {
+	if File_gate_ws_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gate_ws_proto_rawDesc), len(file_gate_ws_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gate_ws_proto_goTypes,
+		DependencyIndexes: file_gate_ws_proto_depIdxs,
+		MessageInfos:      file_gate_ws_proto_msgTypes,
+	}.Build()
+	File_gate_ws_proto = out.File
+	file_gate_ws_proto_goTypes = nil
+	file_gate_ws_proto_depIdxs = nil
+}
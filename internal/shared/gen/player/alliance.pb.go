@@ -121,13 +121,249 @@ func (AllianceApplyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_player_alliance_proto_rawDescGZIP(), []int{1}
 }
 
+// 联盟列表排序，除按 id 外都是从大到小
+type AllianceSort int32
+
+const (
+	AllianceSort_ALLIANCE_SORT_ID        AllianceSort = 0 // 按创建顺序
+	AllianceSort_ALLIANCE_SORT_CNT       AllianceSort = 1 // 按成员数
+	AllianceSort_ALLIANCE_SORT_TERRITORY AllianceSort = 2 // 按占领的系统建筑数
+	AllianceSort_ALLIANCE_SORT_POWER     AllianceSort = 3 // 按战力
+)
+
+// Enum value maps for AllianceSort.
+var (
+	AllianceSort_name = map[int32]string{
+		0: "ALLIANCE_SORT_ID",
+		1: "ALLIANCE_SORT_CNT",
+		2: "ALLIANCE_SORT_TERRITORY",
+		3: "ALLIANCE_SORT_POWER",
+	}
+	AllianceSort_value = map[string]int32{
+		"ALLIANCE_SORT_ID":        0,
+		"ALLIANCE_SORT_CNT":       1,
+		"ALLIANCE_SORT_TERRITORY": 2,
+		"ALLIANCE_SORT_POWER":     3,
+	}
+)
+
+func (x AllianceSort) Enum() *AllianceSort {
+	p := new(AllianceSort)
+	*p = x
+	return p
+}
+
+func (x AllianceSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllianceSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_alliance_proto_enumTypes[2].Descriptor()
+}
+
+func (AllianceSort) Type() protoreflect.EnumType {
+	return &file_player_alliance_proto_enumTypes[2]
+}
+
+func (x AllianceSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllianceSort.Descriptor instead.
+func (AllianceSort) EnumDescriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{2}
+}
+
+// 联盟动态类型
+type AllianceLogKind int32
+
+const (
+	AllianceLogKind_ALLIANCE_LOG_CREATE   AllianceLogKind = 0  // 创建联盟
+	AllianceLogKind_ALLIANCE_LOG_JOIN     AllianceLogKind = 1  // 加入
+	AllianceLogKind_ALLIANCE_LOG_LEAVE    AllianceLogKind = 2  // 退出
+	AllianceLogKind_ALLIANCE_LOG_KICK     AllianceLogKind = 3  // 踢出
+	AllianceLogKind_ALLIANCE_LOG_APPOINT  AllianceLogKind = 4  // 任免
+	AllianceLogKind_ALLIANCE_LOG_TRANSFER AllianceLogKind = 5  // 盟主变更
+	AllianceLogKind_ALLIANCE_LOG_NOTICE   AllianceLogKind = 6  // 修改公告
+	AllianceLogKind_ALLIANCE_LOG_CAPTURE  AllianceLogKind = 7  // 占领领地
+	AllianceLogKind_ALLIANCE_LOG_LOSE     AllianceLogKind = 8  // 失去领地
+	AllianceLogKind_ALLIANCE_LOG_WAR      AllianceLogKind = 9  // 宣战
+	AllianceLogKind_ALLIANCE_LOG_TRUCE    AllianceLogKind = 10 // 停战
+	AllianceLogKind_ALLIANCE_LOG_NAP      AllianceLogKind = 11 // 互不侵犯
+)
+
+// Enum value maps for AllianceLogKind.
+var (
+	AllianceLogKind_name = map[int32]string{
+		0:  "ALLIANCE_LOG_CREATE",
+		1:  "ALLIANCE_LOG_JOIN",
+		2:  "ALLIANCE_LOG_LEAVE",
+		3:  "ALLIANCE_LOG_KICK",
+		4:  "ALLIANCE_LOG_APPOINT",
+		5:  "ALLIANCE_LOG_TRANSFER",
+		6:  "ALLIANCE_LOG_NOTICE",
+		7:  "ALLIANCE_LOG_CAPTURE",
+		8:  "ALLIANCE_LOG_LOSE",
+		9:  "ALLIANCE_LOG_WAR",
+		10: "ALLIANCE_LOG_TRUCE",
+		11: "ALLIANCE_LOG_NAP",
+	}
+	AllianceLogKind_value = map[string]int32{
+		"ALLIANCE_LOG_CREATE":   0,
+		"ALLIANCE_LOG_JOIN":     1,
+		"ALLIANCE_LOG_LEAVE":    2,
+		"ALLIANCE_LOG_KICK":     3,
+		"ALLIANCE_LOG_APPOINT":  4,
+		"ALLIANCE_LOG_TRANSFER": 5,
+		"ALLIANCE_LOG_NOTICE":   6,
+		"ALLIANCE_LOG_CAPTURE":  7,
+		"ALLIANCE_LOG_LOSE":     8,
+		"ALLIANCE_LOG_WAR":      9,
+		"ALLIANCE_LOG_TRUCE":    10,
+		"ALLIANCE_LOG_NAP":      11,
+	}
+)
+
+func (x AllianceLogKind) Enum() *AllianceLogKind {
+	p := new(AllianceLogKind)
+	*p = x
+	return p
+}
+
+func (x AllianceLogKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllianceLogKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_alliance_proto_enumTypes[3].Descriptor()
+}
+
+func (AllianceLogKind) Type() protoreflect.EnumType {
+	return &file_player_alliance_proto_enumTypes[3]
+}
+
+func (x AllianceLogKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllianceLogKind.Descriptor instead.
+func (AllianceLogKind) EnumDescriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{3}
+}
+
+type AllianceMarkKind int32
+
+const (
+	AllianceMarkKind_ALLIANCE_MARK_ATTACK AllianceMarkKind = 0 // 进攻
+	AllianceMarkKind_ALLIANCE_MARK_DEFEND AllianceMarkKind = 1 // 防守
+	AllianceMarkKind_ALLIANCE_MARK_GATHER AllianceMarkKind = 2 // 集合
+)
+
+// Enum value maps for AllianceMarkKind.
+var (
+	AllianceMarkKind_name = map[int32]string{
+		0: "ALLIANCE_MARK_ATTACK",
+		1: "ALLIANCE_MARK_DEFEND",
+		2: "ALLIANCE_MARK_GATHER",
+	}
+	AllianceMarkKind_value = map[string]int32{
+		"ALLIANCE_MARK_ATTACK": 0,
+		"ALLIANCE_MARK_DEFEND": 1,
+		"ALLIANCE_MARK_GATHER": 2,
+	}
+)
+
+func (x AllianceMarkKind) Enum() *AllianceMarkKind {
+	p := new(AllianceMarkKind)
+	*p = x
+	return p
+}
+
+func (x AllianceMarkKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllianceMarkKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_alliance_proto_enumTypes[4].Descriptor()
+}
+
+func (AllianceMarkKind) Type() protoreflect.EnumType {
+	return &file_player_alliance_proto_enumTypes[4]
+}
+
+func (x AllianceMarkKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllianceMarkKind.Descriptor instead.
+func (AllianceMarkKind) EnumDescriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{4}
+}
+
+// 联盟外交状态，除中立外都有期限
+type DiplomacyState int32
+
+const (
+	DiplomacyState_DIPLOMACY_NEUTRAL DiplomacyState = 0 // 中立
+	DiplomacyState_DIPLOMACY_WAR     DiplomacyState = 1 // 宣战
+	DiplomacyState_DIPLOMACY_TRUCE   DiplomacyState = 2 // 停战
+	DiplomacyState_DIPLOMACY_NAP     DiplomacyState = 3 // 互不侵犯
+)
+
+// Enum value maps for DiplomacyState.
+var (
+	DiplomacyState_name = map[int32]string{
+		0: "DIPLOMACY_NEUTRAL",
+		1: "DIPLOMACY_WAR",
+		2: "DIPLOMACY_TRUCE",
+		3: "DIPLOMACY_NAP",
+	}
+	DiplomacyState_value = map[string]int32{
+		"DIPLOMACY_NEUTRAL": 0,
+		"DIPLOMACY_WAR":     1,
+		"DIPLOMACY_TRUCE":   2,
+		"DIPLOMACY_NAP":     3,
+	}
+)
+
+func (x DiplomacyState) Enum() *DiplomacyState {
+	p := new(DiplomacyState)
+	*p = x
+	return p
+}
+
+func (x DiplomacyState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiplomacyState) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_alliance_proto_enumTypes[5].Descriptor()
+}
+
+func (DiplomacyState) Type() protoreflect.EnumType {
+	return &file_player_alliance_proto_enumTypes[5]
+}
+
+func (x DiplomacyState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiplomacyState.Descriptor instead.
+func (DiplomacyState) EnumDescriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{5}
+}
+
 type Alliance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`        // 联盟id
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // 联盟名字
-	Cnt           int32                  `protobuf:"varint,3,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 联盟人数
-	Notice        string                 `protobuf:"bytes,4,opt,name=notice,proto3" json:"notice,omitempty"` // 公告
-	Major         []*Major               `protobuf:"bytes,5,rep,name=major,proto3" json:"major,omitempty"`   // 联盟主要人物（盟主、副盟主）
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`               // 联盟id
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`            // 联盟名字
+	Cnt           int32                  `protobuf:"varint,3,opt,name=cnt,proto3" json:"cnt,omitempty"`             // 联盟人数
+	Notice        string                 `protobuf:"bytes,4,opt,name=notice,proto3" json:"notice,omitempty"`        // 公告
+	Major         []*Major               `protobuf:"bytes,5,rep,name=major,proto3" json:"major,omitempty"`          // 联盟主要人物（盟主、副盟主）
+	Buildings     []*SysBuilding         `protobuf:"bytes,6,rep,name=buildings,proto3" json:"buildings,omitempty"`  // 占领的系统城市和要塞
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`         // 成员上限
+	Power         int32                  `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`         // 成员战力合计
+	Territory     int32                  `protobuf:"varint,9,opt,name=territory,proto3" json:"territory,omitempty"` // 占领的系统建筑数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,6 +433,102 @@ func (x *Alliance) GetMajor() []*Major {
 	return nil
 }
 
+func (x *Alliance) GetBuildings() []*SysBuilding {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+func (x *Alliance) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Alliance) GetPower() int32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *Alliance) GetTerritory() int32 {
+	if x != nil {
+		return x.Territory
+	}
+	return 0
+}
+
+type SysBuilding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Kind          int32                  `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"` // 50 系统要塞，51 系统城市
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SysBuilding) Reset() {
+	*x = SysBuilding{}
+	mi := &file_player_alliance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SysBuilding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysBuilding) ProtoMessage() {}
+
+func (x *SysBuilding) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysBuilding.ProtoReflect.Descriptor instead.
+func (*SysBuilding) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SysBuilding) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SysBuilding) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SysBuilding) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *SysBuilding) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
 type Major struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rid           int32                  `protobuf:"varint,1,opt,name=rid,proto3" json:"rid,omitempty"`
@@ -208,7 +540,7 @@ type Major struct {
 
 func (x *Major) Reset() {
 	*x = Major{}
-	mi := &file_player_alliance_proto_msgTypes[1]
+	mi := &file_player_alliance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -220,7 +552,7 @@ func (x *Major) String() string {
 func (*Major) ProtoMessage() {}
 
 func (x *Major) ProtoReflect() protoreflect.Message {
-	mi := &file_player_alliance_proto_msgTypes[1]
+	mi := &file_player_alliance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +565,7 @@ func (x *Major) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Major.ProtoReflect.Descriptor instead.
 func (*Major) Descriptor() ([]byte, []int) {
-	return file_player_alliance_proto_rawDescGZIP(), []int{1}
+	return file_player_alliance_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Major) GetRid() int32 {
@@ -270,7 +602,7 @@ type Member struct {
 
 func (x *Member) Reset() {
 	*x = Member{}
-	mi := &file_player_alliance_proto_msgTypes[2]
+	mi := &file_player_alliance_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +614,7 @@ func (x *Member) String() string {
 func (*Member) ProtoMessage() {}
 
 func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_player_alliance_proto_msgTypes[2]
+	mi := &file_player_alliance_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +627,7 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Member.ProtoReflect.Descriptor instead.
 func (*Member) Descriptor() ([]byte, []int) {
-	return file_player_alliance_proto_rawDescGZIP(), []int{2}
+	return file_player_alliance_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Member) GetRid() int32 {
@@ -343,7 +675,7 @@ type ApplyItem struct {
 
 func (x *ApplyItem) Reset() {
 	*x = ApplyItem{}
-	mi := &file_player_alliance_proto_msgTypes[3]
+	mi := &file_player_alliance_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +687,7 @@ func (x *ApplyItem) String() string {
 func (*ApplyItem) ProtoMessage() {}
 
 func (x *ApplyItem) ProtoReflect() protoreflect.Message {
-	mi := &file_player_alliance_proto_msgTypes[3]
+	mi := &file_player_alliance_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +700,7 @@ func (x *ApplyItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyItem.ProtoReflect.Descriptor instead.
 func (*ApplyItem) Descriptor() ([]byte, []int) {
-	return file_player_alliance_proto_rawDescGZIP(), []int{3}
+	return file_player_alliance_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ApplyItem) GetPlayerId() int32 {
@@ -385,17 +717,509 @@ func (x *ApplyItem) GetNickName() string {
 	return ""
 }
 
+type AllianceLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          AllianceLogKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=three_kingdoms.player.AllianceLogKind" json:"kind,omitempty"`
+	OpId          int32                  `protobuf:"varint,2,opt,name=op_id,json=opId,proto3" json:"op_id,omitempty"`
+	OpName        string                 `protobuf:"bytes,3,opt,name=op_name,json=opName,proto3" json:"op_name,omitempty"`
+	TargetId      int32                  `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetName    string                 `protobuf:"bytes,5,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	Title         AllianceTitle          `protobuf:"varint,6,opt,name=title,proto3,enum=three_kingdoms.player.AllianceTitle" json:"title,omitempty"` // 任免时的新职位
+	X             int32                  `protobuf:"varint,7,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,8,opt,name=y,proto3" json:"y,omitempty"`
+	Ctime         int64                  `protobuf:"varint,9,opt,name=ctime,proto3" json:"ctime,omitempty"` // 毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceLog) Reset() {
+	*x = AllianceLog{}
+	mi := &file_player_alliance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceLog) ProtoMessage() {}
+
+func (x *AllianceLog) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceLog.ProtoReflect.Descriptor instead.
+func (*AllianceLog) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllianceLog) GetKind() AllianceLogKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AllianceLogKind_ALLIANCE_LOG_CREATE
+}
+
+func (x *AllianceLog) GetOpId() int32 {
+	if x != nil {
+		return x.OpId
+	}
+	return 0
+}
+
+func (x *AllianceLog) GetOpName() string {
+	if x != nil {
+		return x.OpName
+	}
+	return ""
+}
+
+func (x *AllianceLog) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *AllianceLog) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *AllianceLog) GetTitle() AllianceTitle {
+	if x != nil {
+		return x.Title
+	}
+	return AllianceTitle_ALLIANCE_CHAIRMAN
+}
+
+func (x *AllianceLog) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AllianceLog) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *AllianceLog) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 联盟集结，到截止时间后集结点上的军队一起出发
+type Rally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaderId      int32                  `protobuf:"varint,2,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	TargetX       int32                  `protobuf:"varint,3,opt,name=target_x,json=targetX,proto3" json:"target_x,omitempty"`
+	TargetY       int32                  `protobuf:"varint,4,opt,name=target_y,json=targetY,proto3" json:"target_y,omitempty"`
+	RallyX        int32                  `protobuf:"varint,5,opt,name=rally_x,json=rallyX,proto3" json:"rally_x,omitempty"` // 集结点
+	RallyY        int32                  `protobuf:"varint,6,opt,name=rally_y,json=rallyY,proto3" json:"rally_y,omitempty"`
+	Deadline      int64                  `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"` // 出发时间，毫秒
+	Armies        []*RallyArmy           `protobuf:"bytes,8,rep,name=armies,proto3" json:"armies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rally) Reset() {
+	*x = Rally{}
+	mi := &file_player_alliance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rally) ProtoMessage() {}
+
+func (x *Rally) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rally.ProtoReflect.Descriptor instead.
+func (*Rally) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Rally) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rally) GetLeaderId() int32 {
+	if x != nil {
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *Rally) GetTargetX() int32 {
+	if x != nil {
+		return x.TargetX
+	}
+	return 0
+}
+
+func (x *Rally) GetTargetY() int32 {
+	if x != nil {
+		return x.TargetY
+	}
+	return 0
+}
+
+func (x *Rally) GetRallyX() int32 {
+	if x != nil {
+		return x.RallyX
+	}
+	return 0
+}
+
+func (x *Rally) GetRallyY() int32 {
+	if x != nil {
+		return x.RallyY
+	}
+	return 0
+}
+
+func (x *Rally) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *Rally) GetArmies() []*RallyArmy {
+	if x != nil {
+		return x.Armies
+	}
+	return nil
+}
+
+type RallyArmy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ArmyId        int32                  `protobuf:"varint,2,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RallyArmy) Reset() {
+	*x = RallyArmy{}
+	mi := &file_player_alliance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RallyArmy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RallyArmy) ProtoMessage() {}
+
+func (x *RallyArmy) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RallyArmy.ProtoReflect.Descriptor instead.
+func (*RallyArmy) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RallyArmy) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RallyArmy) GetArmyId() int32 {
+	if x != nil {
+		return x.ArmyId
+	}
+	return 0
+}
+
+// 联盟地图标记
+type AllianceMark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             int32                  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Kind          AllianceMarkKind       `protobuf:"varint,4,opt,name=kind,proto3,enum=three_kingdoms.player.AllianceMarkKind" json:"kind,omitempty"`
+	Label         string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	CreatorId     int32                  `protobuf:"varint,6,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Expire        int64                  `protobuf:"varint,7,opt,name=expire,proto3" json:"expire,omitempty"` // 到期时间，毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceMark) Reset() {
+	*x = AllianceMark{}
+	mi := &file_player_alliance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceMark) ProtoMessage() {}
+
+func (x *AllianceMark) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceMark.ProtoReflect.Descriptor instead.
+func (*AllianceMark) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AllianceMark) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AllianceMark) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AllianceMark) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *AllianceMark) GetKind() AllianceMarkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AllianceMarkKind_ALLIANCE_MARK_ATTACK
+}
+
+func (x *AllianceMark) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AllianceMark) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *AllianceMark) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type AllianceMarkList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Marks         []*AllianceMark        `protobuf:"bytes,1,rep,name=marks,proto3" json:"marks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceMarkList) Reset() {
+	*x = AllianceMarkList{}
+	mi := &file_player_alliance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceMarkList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceMarkList) ProtoMessage() {}
+
+func (x *AllianceMarkList) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceMarkList.ProtoReflect.Descriptor instead.
+func (*AllianceMarkList) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AllianceMarkList) GetMarks() []*AllianceMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+// 和另一个联盟的外交关系
+type Diplomacy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId    int32                  `protobuf:"varint,1,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State         DiplomacyState         `protobuf:"varint,3,opt,name=state,proto3,enum=three_kingdoms.player.DiplomacyState" json:"state,omitempty"`
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                                                 // 到期时间，毫秒，中立为 0
+	Proposal      DiplomacyState         `protobuf:"varint,5,opt,name=proposal,proto3,enum=three_kingdoms.player.DiplomacyState" json:"proposal,omitempty"` // 待确认的提议，中立表示没有
+	Incoming      bool                   `protobuf:"varint,6,opt,name=incoming,proto3" json:"incoming,omitempty"`                                           // 提议是否由对方发起
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diplomacy) Reset() {
+	*x = Diplomacy{}
+	mi := &file_player_alliance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diplomacy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diplomacy) ProtoMessage() {}
+
+func (x *Diplomacy) ProtoReflect() protoreflect.Message {
+	mi := &file_player_alliance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diplomacy.ProtoReflect.Descriptor instead.
+func (*Diplomacy) Descriptor() ([]byte, []int) {
+	return file_player_alliance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Diplomacy) GetAllianceId() int32 {
+	if x != nil {
+		return x.AllianceId
+	}
+	return 0
+}
+
+func (x *Diplomacy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Diplomacy) GetState() DiplomacyState {
+	if x != nil {
+		return x.State
+	}
+	return DiplomacyState_DIPLOMACY_NEUTRAL
+}
+
+func (x *Diplomacy) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *Diplomacy) GetProposal() DiplomacyState {
+	if x != nil {
+		return x.Proposal
+	}
+	return DiplomacyState_DIPLOMACY_NEUTRAL
+}
+
+func (x *Diplomacy) GetIncoming() bool {
+	if x != nil {
+		return x.Incoming
+	}
+	return false
+}
+
 var File_player_alliance_proto protoreflect.FileDescriptor
 
 const file_player_alliance_proto_rawDesc = "" +
 	"\n" +
-	"\x15player/alliance.proto\x12\x15three_kingdoms.player\"\x8c\x01\n" +
+	"\x15player/alliance.proto\x12\x15three_kingdoms.player\"\x98\x02\n" +
 	"\bAlliance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
 	"\x03cnt\x18\x03 \x01(\x05R\x03cnt\x12\x16\n" +
 	"\x06notice\x18\x04 \x01(\tR\x06notice\x122\n" +
-	"\x05major\x18\x05 \x03(\v2\x1c.three_kingdoms.player.MajorR\x05major\"i\n" +
+	"\x05major\x18\x05 \x03(\v2\x1c.three_kingdoms.player.MajorR\x05major\x12@\n" +
+	"\tbuildings\x18\x06 \x03(\v2\".three_kingdoms.player.SysBuildingR\tbuildings\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05power\x18\b \x01(\x05R\x05power\x12\x1c\n" +
+	"\tterritory\x18\t \x01(\x05R\tterritory\"S\n" +
+	"\vSysBuilding\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\x05R\x04kind\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\"i\n" +
 	"\x05Major\x12\x10\n" +
 	"\x03rid\x18\x01 \x01(\x05R\x03rid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
@@ -408,7 +1232,49 @@ const file_player_alliance_proto_rawDesc = "" +
 	"\x01y\x18\x05 \x01(\x05R\x01y\"D\n" +
 	"\tApplyItem\x12\x1a\n" +
 	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\x12\x1b\n" +
-	"\tnick_name\x18\x02 \x01(\tR\bnickName*W\n" +
+	"\tnick_name\x18\x02 \x01(\tR\bnickName\"\xa3\x02\n" +
+	"\vAllianceLog\x12:\n" +
+	"\x04kind\x18\x01 \x01(\x0e2&.three_kingdoms.player.AllianceLogKindR\x04kind\x12\x13\n" +
+	"\x05op_id\x18\x02 \x01(\x05R\x04opId\x12\x17\n" +
+	"\aop_name\x18\x03 \x01(\tR\x06opName\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\x05R\btargetId\x12\x1f\n" +
+	"\vtarget_name\x18\x05 \x01(\tR\n" +
+	"targetName\x12:\n" +
+	"\x05title\x18\x06 \x01(\x0e2$.three_kingdoms.player.AllianceTitleR\x05title\x12\f\n" +
+	"\x01x\x18\a \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\b \x01(\x05R\x01y\x12\x14\n" +
+	"\x05ctime\x18\t \x01(\x03R\x05ctime\"\xf2\x01\n" +
+	"\x05Rally\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tleader_id\x18\x02 \x01(\x05R\bleaderId\x12\x19\n" +
+	"\btarget_x\x18\x03 \x01(\x05R\atargetX\x12\x19\n" +
+	"\btarget_y\x18\x04 \x01(\x05R\atargetY\x12\x17\n" +
+	"\arally_x\x18\x05 \x01(\x05R\x06rallyX\x12\x17\n" +
+	"\arally_y\x18\x06 \x01(\x05R\x06rallyY\x12\x1a\n" +
+	"\bdeadline\x18\a \x01(\x03R\bdeadline\x128\n" +
+	"\x06armies\x18\b \x03(\v2 .three_kingdoms.player.RallyArmyR\x06armies\"A\n" +
+	"\tRallyArmy\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\aarmy_id\x18\x02 \x01(\x05R\x06armyId\"\xc4\x01\n" +
+	"\fAllianceMark\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x05R\x01y\x12;\n" +
+	"\x04kind\x18\x04 \x01(\x0e2'.three_kingdoms.player.AllianceMarkKindR\x04kind\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x06 \x01(\x05R\tcreatorId\x12\x16\n" +
+	"\x06expire\x18\a \x01(\x03R\x06expire\"M\n" +
+	"\x10AllianceMarkList\x129\n" +
+	"\x05marks\x18\x01 \x03(\v2#.three_kingdoms.player.AllianceMarkR\x05marks\"\xf2\x01\n" +
+	"\tDiplomacy\x12\x1f\n" +
+	"\valliance_id\x18\x01 \x01(\x05R\n" +
+	"allianceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12;\n" +
+	"\x05state\x18\x03 \x01(\x0e2%.three_kingdoms.player.DiplomacyStateR\x05state\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12A\n" +
+	"\bproposal\x18\x05 \x01(\x0e2%.three_kingdoms.player.DiplomacyStateR\bproposal\x12\x1a\n" +
+	"\bincoming\x18\x06 \x01(\bR\bincoming*W\n" +
 	"\rAllianceTitle\x12\x15\n" +
 	"\x11ALLIANCE_CHAIRMAN\x10\x00\x12\x1a\n" +
 	"\x16ALLIANCE_VICE_CHAIRMAN\x10\x01\x12\x13\n" +
@@ -416,7 +1282,35 @@ const file_player_alliance_proto_rawDesc = "" +
 	"\x13AllianceApplyStatus\x12\x16\n" +
 	"\x12ALLIANCE_UNTREATED\x10\x00\x12\x13\n" +
 	"\x0fALLIANCE_REFUSE\x10\x01\x12\x12\n" +
-	"\x0eALLIANCE_ADOPT\x10\x02B3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
+	"\x0eALLIANCE_ADOPT\x10\x02*q\n" +
+	"\fAllianceSort\x12\x14\n" +
+	"\x10ALLIANCE_SORT_ID\x10\x00\x12\x15\n" +
+	"\x11ALLIANCE_SORT_CNT\x10\x01\x12\x1b\n" +
+	"\x17ALLIANCE_SORT_TERRITORY\x10\x02\x12\x17\n" +
+	"\x13ALLIANCE_SORT_POWER\x10\x03*\xb3\x02\n" +
+	"\x0fAllianceLogKind\x12\x17\n" +
+	"\x13ALLIANCE_LOG_CREATE\x10\x00\x12\x15\n" +
+	"\x11ALLIANCE_LOG_JOIN\x10\x01\x12\x16\n" +
+	"\x12ALLIANCE_LOG_LEAVE\x10\x02\x12\x15\n" +
+	"\x11ALLIANCE_LOG_KICK\x10\x03\x12\x18\n" +
+	"\x14ALLIANCE_LOG_APPOINT\x10\x04\x12\x19\n" +
+	"\x15ALLIANCE_LOG_TRANSFER\x10\x05\x12\x17\n" +
+	"\x13ALLIANCE_LOG_NOTICE\x10\x06\x12\x18\n" +
+	"\x14ALLIANCE_LOG_CAPTURE\x10\a\x12\x15\n" +
+	"\x11ALLIANCE_LOG_LOSE\x10\b\x12\x14\n" +
+	"\x10ALLIANCE_LOG_WAR\x10\t\x12\x16\n" +
+	"\x12ALLIANCE_LOG_TRUCE\x10\n" +
+	"\x12\x14\n" +
+	"\x10ALLIANCE_LOG_NAP\x10\v*`\n" +
+	"\x10AllianceMarkKind\x12\x18\n" +
+	"\x14ALLIANCE_MARK_ATTACK\x10\x00\x12\x18\n" +
+	"\x14ALLIANCE_MARK_DEFEND\x10\x01\x12\x18\n" +
+	"\x14ALLIANCE_MARK_GATHER\x10\x02*b\n" +
+	"\x0eDiplomacyState\x12\x15\n" +
+	"\x11DIPLOMACY_NEUTRAL\x10\x00\x12\x11\n" +
+	"\rDIPLOMACY_WAR\x10\x01\x12\x13\n" +
+	"\x0fDIPLOMACY_TRUCE\x10\x02\x12\x11\n" +
+	"\rDIPLOMACY_NAP\x10\x03B3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
 
 var (
 	file_player_alliance_proto_rawDescOnce sync.Once
@@ -430,25 +1324,44 @@ func file_player_alliance_proto_rawDescGZIP() []byte {
 	return file_player_alliance_proto_rawDescData
 }
 
-var file_player_alliance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_player_alliance_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_player_alliance_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_player_alliance_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_player_alliance_proto_goTypes = []any{
 	(AllianceTitle)(0),       // 0: three_kingdoms.player.AllianceTitle
 	(AllianceApplyStatus)(0), // 1: three_kingdoms.player.AllianceApplyStatus
-	(*Alliance)(nil),         // 2: three_kingdoms.player.Alliance
-	(*Major)(nil),            // 3: three_kingdoms.player.Major
-	(*Member)(nil),           // 4: three_kingdoms.player.Member
-	(*ApplyItem)(nil),        // 5: three_kingdoms.player.ApplyItem
+	(AllianceSort)(0),        // 2: three_kingdoms.player.AllianceSort
+	(AllianceLogKind)(0),     // 3: three_kingdoms.player.AllianceLogKind
+	(AllianceMarkKind)(0),    // 4: three_kingdoms.player.AllianceMarkKind
+	(DiplomacyState)(0),      // 5: three_kingdoms.player.DiplomacyState
+	(*Alliance)(nil),         // 6: three_kingdoms.player.Alliance
+	(*SysBuilding)(nil),      // 7: three_kingdoms.player.SysBuilding
+	(*Major)(nil),            // 8: three_kingdoms.player.Major
+	(*Member)(nil),           // 9: three_kingdoms.player.Member
+	(*ApplyItem)(nil),        // 10: three_kingdoms.player.ApplyItem
+	(*AllianceLog)(nil),      // 11: three_kingdoms.player.AllianceLog
+	(*Rally)(nil),            // 12: three_kingdoms.player.Rally
+	(*RallyArmy)(nil),        // 13: three_kingdoms.player.RallyArmy
+	(*AllianceMark)(nil),     // 14: three_kingdoms.player.AllianceMark
+	(*AllianceMarkList)(nil), // 15: three_kingdoms.player.AllianceMarkList
+	(*Diplomacy)(nil),        // 16: three_kingdoms.player.Diplomacy
 }
 var file_player_alliance_proto_depIdxs = []int32{
-	3, // 0: three_kingdoms.player.Alliance.major:type_name -> three_kingdoms.player.Major
-	0, // 1: three_kingdoms.player.Major.title:type_name -> three_kingdoms.player.AllianceTitle
-	0, // 2: three_kingdoms.player.Member.title:type_name -> three_kingdoms.player.AllianceTitle
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: three_kingdoms.player.Alliance.major:type_name -> three_kingdoms.player.Major
+	7,  // 1: three_kingdoms.player.Alliance.buildings:type_name -> three_kingdoms.player.SysBuilding
+	0,  // 2: three_kingdoms.player.Major.title:type_name -> three_kingdoms.player.AllianceTitle
+	0,  // 3: three_kingdoms.player.Member.title:type_name -> three_kingdoms.player.AllianceTitle
+	3,  // 4: three_kingdoms.player.AllianceLog.kind:type_name -> three_kingdoms.player.AllianceLogKind
+	0,  // 5: three_kingdoms.player.AllianceLog.title:type_name -> three_kingdoms.player.AllianceTitle
+	13, // 6: three_kingdoms.player.Rally.armies:type_name -> three_kingdoms.player.RallyArmy
+	4,  // 7: three_kingdoms.player.AllianceMark.kind:type_name -> three_kingdoms.player.AllianceMarkKind
+	14, // 8: three_kingdoms.player.AllianceMarkList.marks:type_name -> three_kingdoms.player.AllianceMark
+	5,  // 9: three_kingdoms.player.Diplomacy.state:type_name -> three_kingdoms.player.DiplomacyState
+	5,  // 10: three_kingdoms.player.Diplomacy.proposal:type_name -> three_kingdoms.player.DiplomacyState
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_player_alliance_proto_init() }
@@ -461,8 +1374,8 @@ func file_player_alliance_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_player_alliance_proto_rawDesc), len(file_player_alliance_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
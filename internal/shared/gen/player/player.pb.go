@@ -53,6 +53,34 @@ type PlayerRequest struct {
 	//	*PlayerRequest_ConscriptRequest
 	//	*PlayerRequest_ArmyInfoRequest
 	//	*PlayerRequest_AssignArmyRequest
+	//	*PlayerRequest_GiveUpRequest
+	//	*PlayerRequest_PosTagAddRequest
+	//	*PlayerRequest_PosTagRenameRequest
+	//	*PlayerRequest_PosTagDelRequest
+	//	*PlayerRequest_CreateSubCityRequest
+	//	*PlayerRequest_MoveCityRequest
+	//	*PlayerRequest_AllianceCreateRequest
+	//	*PlayerRequest_AllianceJoinRequest
+	//	*PlayerRequest_AllianceVerifyRequest
+	//	*PlayerRequest_AllianceExitRequest
+	//	*PlayerRequest_AllianceKickRequest
+	//	*PlayerRequest_AllianceDismissRequest
+	//	*PlayerRequest_AllianceAppointRequest
+	//	*PlayerRequest_AllianceTransferRequest
+	//	*PlayerRequest_AllianceNoticeRequest
+	//	*PlayerRequest_AllianceLogRequest
+	//	*PlayerRequest_RallyCreateRequest
+	//	*PlayerRequest_RallyJoinRequest
+	//	*PlayerRequest_RallyListRequest
+	//	*PlayerRequest_ReinforceBackRequest
+	//	*PlayerRequest_AllianceMarkAddRequest
+	//	*PlayerRequest_AllianceMarkDelRequest
+	//	*PlayerRequest_AllianceMarkListRequest
+	//	*PlayerRequest_DiplomacyProposeRequest
+	//	*PlayerRequest_DiplomacyAcceptRequest
+	//	*PlayerRequest_DiplomacyListRequest
+	//	*PlayerRequest_LogoutRequest
+	//	*PlayerRequest_AdminRequest
 	Body          isPlayerRequest_Body `protobuf_oneof:"body"`
 	TraceId       string               `protobuf:"bytes,100,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -331,6 +359,258 @@ func (x *PlayerRequest) GetAssignArmyRequest() *AssignArmyRequest {
 	return nil
 }
 
+func (x *PlayerRequest) GetGiveUpRequest() *GiveUpRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_GiveUpRequest); ok {
+			return x.GiveUpRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetPosTagAddRequest() *PosTagAddRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_PosTagAddRequest); ok {
+			return x.PosTagAddRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetPosTagRenameRequest() *PosTagRenameRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_PosTagRenameRequest); ok {
+			return x.PosTagRenameRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetPosTagDelRequest() *PosTagDelRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_PosTagDelRequest); ok {
+			return x.PosTagDelRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetCreateSubCityRequest() *CreateSubCityRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_CreateSubCityRequest); ok {
+			return x.CreateSubCityRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetMoveCityRequest() *MoveCityRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_MoveCityRequest); ok {
+			return x.MoveCityRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceCreateRequest() *AllianceCreateRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceCreateRequest); ok {
+			return x.AllianceCreateRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceJoinRequest() *AllianceJoinRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceJoinRequest); ok {
+			return x.AllianceJoinRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceVerifyRequest() *AllianceVerifyRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceVerifyRequest); ok {
+			return x.AllianceVerifyRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceExitRequest() *AllianceExitRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceExitRequest); ok {
+			return x.AllianceExitRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceKickRequest() *AllianceKickRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceKickRequest); ok {
+			return x.AllianceKickRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceDismissRequest() *AllianceDismissRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceDismissRequest); ok {
+			return x.AllianceDismissRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceAppointRequest() *AllianceAppointRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceAppointRequest); ok {
+			return x.AllianceAppointRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceTransferRequest() *AllianceTransferRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceTransferRequest); ok {
+			return x.AllianceTransferRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceNoticeRequest() *AllianceNoticeRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceNoticeRequest); ok {
+			return x.AllianceNoticeRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceLogRequest() *AllianceLogRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceLogRequest); ok {
+			return x.AllianceLogRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetRallyCreateRequest() *RallyCreateRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_RallyCreateRequest); ok {
+			return x.RallyCreateRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetRallyJoinRequest() *RallyJoinRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_RallyJoinRequest); ok {
+			return x.RallyJoinRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetRallyListRequest() *RallyListRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_RallyListRequest); ok {
+			return x.RallyListRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetReinforceBackRequest() *ReinforceBackRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_ReinforceBackRequest); ok {
+			return x.ReinforceBackRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceMarkAddRequest() *AllianceMarkAddRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceMarkAddRequest); ok {
+			return x.AllianceMarkAddRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceMarkDelRequest() *AllianceMarkDelRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceMarkDelRequest); ok {
+			return x.AllianceMarkDelRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAllianceMarkListRequest() *AllianceMarkListRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AllianceMarkListRequest); ok {
+			return x.AllianceMarkListRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetDiplomacyProposeRequest() *DiplomacyProposeRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_DiplomacyProposeRequest); ok {
+			return x.DiplomacyProposeRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetDiplomacyAcceptRequest() *DiplomacyAcceptRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_DiplomacyAcceptRequest); ok {
+			return x.DiplomacyAcceptRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetDiplomacyListRequest() *DiplomacyListRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_DiplomacyListRequest); ok {
+			return x.DiplomacyListRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetLogoutRequest() *LogoutRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_LogoutRequest); ok {
+			return x.LogoutRequest
+		}
+	}
+	return nil
+}
+
+func (x *PlayerRequest) GetAdminRequest() *AdminRequest {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerRequest_AdminRequest); ok {
+			return x.AdminRequest
+		}
+	}
+	return nil
+}
+
 func (x *PlayerRequest) GetTraceId() string {
 	if x != nil {
 		return x.TraceId
@@ -434,6 +714,118 @@ type PlayerRequest_AssignArmyRequest struct {
 	AssignArmyRequest *AssignArmyRequest `protobuf:"bytes,32,opt,name=assignArmyRequest,proto3,oneof"`
 }
 
+type PlayerRequest_GiveUpRequest struct {
+	GiveUpRequest *GiveUpRequest `protobuf:"bytes,33,opt,name=giveUpRequest,proto3,oneof"`
+}
+
+type PlayerRequest_PosTagAddRequest struct {
+	PosTagAddRequest *PosTagAddRequest `protobuf:"bytes,34,opt,name=posTagAddRequest,proto3,oneof"`
+}
+
+type PlayerRequest_PosTagRenameRequest struct {
+	PosTagRenameRequest *PosTagRenameRequest `protobuf:"bytes,35,opt,name=posTagRenameRequest,proto3,oneof"`
+}
+
+type PlayerRequest_PosTagDelRequest struct {
+	PosTagDelRequest *PosTagDelRequest `protobuf:"bytes,36,opt,name=posTagDelRequest,proto3,oneof"`
+}
+
+type PlayerRequest_CreateSubCityRequest struct {
+	CreateSubCityRequest *CreateSubCityRequest `protobuf:"bytes,37,opt,name=createSubCityRequest,proto3,oneof"`
+}
+
+type PlayerRequest_MoveCityRequest struct {
+	MoveCityRequest *MoveCityRequest `protobuf:"bytes,38,opt,name=moveCityRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceCreateRequest struct {
+	AllianceCreateRequest *AllianceCreateRequest `protobuf:"bytes,39,opt,name=allianceCreateRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceJoinRequest struct {
+	AllianceJoinRequest *AllianceJoinRequest `protobuf:"bytes,40,opt,name=allianceJoinRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceVerifyRequest struct {
+	AllianceVerifyRequest *AllianceVerifyRequest `protobuf:"bytes,41,opt,name=allianceVerifyRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceExitRequest struct {
+	AllianceExitRequest *AllianceExitRequest `protobuf:"bytes,42,opt,name=allianceExitRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceKickRequest struct {
+	AllianceKickRequest *AllianceKickRequest `protobuf:"bytes,43,opt,name=allianceKickRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceDismissRequest struct {
+	AllianceDismissRequest *AllianceDismissRequest `protobuf:"bytes,44,opt,name=allianceDismissRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceAppointRequest struct {
+	AllianceAppointRequest *AllianceAppointRequest `protobuf:"bytes,45,opt,name=allianceAppointRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceTransferRequest struct {
+	AllianceTransferRequest *AllianceTransferRequest `protobuf:"bytes,46,opt,name=allianceTransferRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceNoticeRequest struct {
+	AllianceNoticeRequest *AllianceNoticeRequest `protobuf:"bytes,47,opt,name=allianceNoticeRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceLogRequest struct {
+	AllianceLogRequest *AllianceLogRequest `protobuf:"bytes,48,opt,name=allianceLogRequest,proto3,oneof"`
+}
+
+type PlayerRequest_RallyCreateRequest struct {
+	RallyCreateRequest *RallyCreateRequest `protobuf:"bytes,49,opt,name=rallyCreateRequest,proto3,oneof"`
+}
+
+type PlayerRequest_RallyJoinRequest struct {
+	RallyJoinRequest *RallyJoinRequest `protobuf:"bytes,50,opt,name=rallyJoinRequest,proto3,oneof"`
+}
+
+type PlayerRequest_RallyListRequest struct {
+	RallyListRequest *RallyListRequest `protobuf:"bytes,51,opt,name=rallyListRequest,proto3,oneof"`
+}
+
+type PlayerRequest_ReinforceBackRequest struct {
+	ReinforceBackRequest *ReinforceBackRequest `protobuf:"bytes,52,opt,name=reinforceBackRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceMarkAddRequest struct {
+	AllianceMarkAddRequest *AllianceMarkAddRequest `protobuf:"bytes,53,opt,name=allianceMarkAddRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceMarkDelRequest struct {
+	AllianceMarkDelRequest *AllianceMarkDelRequest `protobuf:"bytes,54,opt,name=allianceMarkDelRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AllianceMarkListRequest struct {
+	AllianceMarkListRequest *AllianceMarkListRequest `protobuf:"bytes,55,opt,name=allianceMarkListRequest,proto3,oneof"`
+}
+
+type PlayerRequest_DiplomacyProposeRequest struct {
+	DiplomacyProposeRequest *DiplomacyProposeRequest `protobuf:"bytes,56,opt,name=diplomacyProposeRequest,proto3,oneof"`
+}
+
+type PlayerRequest_DiplomacyAcceptRequest struct {
+	DiplomacyAcceptRequest *DiplomacyAcceptRequest `protobuf:"bytes,57,opt,name=diplomacyAcceptRequest,proto3,oneof"`
+}
+
+type PlayerRequest_DiplomacyListRequest struct {
+	DiplomacyListRequest *DiplomacyListRequest `protobuf:"bytes,58,opt,name=diplomacyListRequest,proto3,oneof"`
+}
+
+type PlayerRequest_LogoutRequest struct {
+	LogoutRequest *LogoutRequest `protobuf:"bytes,59,opt,name=logoutRequest,proto3,oneof"`
+}
+
+type PlayerRequest_AdminRequest struct {
+	AdminRequest *AdminRequest `protobuf:"bytes,60,opt,name=adminRequest,proto3,oneof"`
+}
+
 func (*PlayerRequest_EnterServerRequest) isPlayerRequest_Body() {}
 
 func (*PlayerRequest_CreateRoleRequest) isPlayerRequest_Body() {}
@@ -480,6 +872,62 @@ func (*PlayerRequest_ArmyInfoRequest) isPlayerRequest_Body() {}
 
 func (*PlayerRequest_AssignArmyRequest) isPlayerRequest_Body() {}
 
+func (*PlayerRequest_GiveUpRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_PosTagAddRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_PosTagRenameRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_PosTagDelRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_CreateSubCityRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_MoveCityRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceCreateRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceJoinRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceVerifyRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceExitRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceKickRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceDismissRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceAppointRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceTransferRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceNoticeRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceLogRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_RallyCreateRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_RallyJoinRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_RallyListRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_ReinforceBackRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceMarkAddRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceMarkDelRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AllianceMarkListRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_DiplomacyProposeRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_DiplomacyAcceptRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_DiplomacyListRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_LogoutRequest) isPlayerRequest_Body() {}
+
+func (*PlayerRequest_AdminRequest) isPlayerRequest_Body() {}
+
 type PlayerResponse struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Result *common.BizResult      `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -508,6 +956,34 @@ type PlayerResponse struct {
 	//	*PlayerResponse_ConscriptResponse
 	//	*PlayerResponse_ArmyInfoResponse
 	//	*PlayerResponse_AssignArmyResponse
+	//	*PlayerResponse_GiveUpResponse
+	//	*PlayerResponse_PosTagAddResponse
+	//	*PlayerResponse_PosTagRenameResponse
+	//	*PlayerResponse_PosTagDelResponse
+	//	*PlayerResponse_CreateSubCityResponse
+	//	*PlayerResponse_MoveCityResponse
+	//	*PlayerResponse_AllianceCreateResponse
+	//	*PlayerResponse_AllianceJoinResponse
+	//	*PlayerResponse_AllianceVerifyResponse
+	//	*PlayerResponse_AllianceExitResponse
+	//	*PlayerResponse_AllianceKickResponse
+	//	*PlayerResponse_AllianceDismissResponse
+	//	*PlayerResponse_AllianceAppointResponse
+	//	*PlayerResponse_AllianceTransferResponse
+	//	*PlayerResponse_AllianceNoticeResponse
+	//	*PlayerResponse_AllianceLogResponse
+	//	*PlayerResponse_RallyCreateResponse
+	//	*PlayerResponse_RallyJoinResponse
+	//	*PlayerResponse_RallyListResponse
+	//	*PlayerResponse_ReinforceBackResponse
+	//	*PlayerResponse_AllianceMarkAddResponse
+	//	*PlayerResponse_AllianceMarkDelResponse
+	//	*PlayerResponse_AllianceMarkListResponse
+	//	*PlayerResponse_DiplomacyProposeResponse
+	//	*PlayerResponse_DiplomacyAcceptResponse
+	//	*PlayerResponse_DiplomacyListResponse
+	//	*PlayerResponse_LogoutResponse
+	//	*PlayerResponse_AdminResponse
 	Body          isPlayerResponse_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -764,10 +1240,262 @@ func (x *PlayerResponse) GetAssignArmyResponse() *AssignArmyResponse {
 	return nil
 }
 
-type isPlayerResponse_Body interface {
-	isPlayerResponse_Body()
-}
-
+func (x *PlayerResponse) GetGiveUpResponse() *GiveUpResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_GiveUpResponse); ok {
+			return x.GiveUpResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetPosTagAddResponse() *PosTagAddResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_PosTagAddResponse); ok {
+			return x.PosTagAddResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetPosTagRenameResponse() *PosTagRenameResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_PosTagRenameResponse); ok {
+			return x.PosTagRenameResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetPosTagDelResponse() *PosTagDelResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_PosTagDelResponse); ok {
+			return x.PosTagDelResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetCreateSubCityResponse() *CreateSubCityResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_CreateSubCityResponse); ok {
+			return x.CreateSubCityResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetMoveCityResponse() *MoveCityResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_MoveCityResponse); ok {
+			return x.MoveCityResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceCreateResponse() *AllianceCreateResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceCreateResponse); ok {
+			return x.AllianceCreateResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceJoinResponse() *AllianceJoinResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceJoinResponse); ok {
+			return x.AllianceJoinResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceVerifyResponse() *AllianceVerifyResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceVerifyResponse); ok {
+			return x.AllianceVerifyResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceExitResponse() *AllianceExitResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceExitResponse); ok {
+			return x.AllianceExitResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceKickResponse() *AllianceKickResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceKickResponse); ok {
+			return x.AllianceKickResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceDismissResponse() *AllianceDismissResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceDismissResponse); ok {
+			return x.AllianceDismissResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceAppointResponse() *AllianceAppointResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceAppointResponse); ok {
+			return x.AllianceAppointResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceTransferResponse() *AllianceTransferResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceTransferResponse); ok {
+			return x.AllianceTransferResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceNoticeResponse() *AllianceNoticeResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceNoticeResponse); ok {
+			return x.AllianceNoticeResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceLogResponse() *AllianceLogResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceLogResponse); ok {
+			return x.AllianceLogResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetRallyCreateResponse() *RallyCreateResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_RallyCreateResponse); ok {
+			return x.RallyCreateResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetRallyJoinResponse() *RallyJoinResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_RallyJoinResponse); ok {
+			return x.RallyJoinResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetRallyListResponse() *RallyListResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_RallyListResponse); ok {
+			return x.RallyListResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetReinforceBackResponse() *ReinforceBackResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_ReinforceBackResponse); ok {
+			return x.ReinforceBackResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceMarkAddResponse() *AllianceMarkAddResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceMarkAddResponse); ok {
+			return x.AllianceMarkAddResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceMarkDelResponse() *AllianceMarkDelResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceMarkDelResponse); ok {
+			return x.AllianceMarkDelResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAllianceMarkListResponse() *AllianceMarkListResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AllianceMarkListResponse); ok {
+			return x.AllianceMarkListResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetDiplomacyProposeResponse() *DiplomacyProposeResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_DiplomacyProposeResponse); ok {
+			return x.DiplomacyProposeResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetDiplomacyAcceptResponse() *DiplomacyAcceptResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_DiplomacyAcceptResponse); ok {
+			return x.DiplomacyAcceptResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetDiplomacyListResponse() *DiplomacyListResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_DiplomacyListResponse); ok {
+			return x.DiplomacyListResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetLogoutResponse() *LogoutResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_LogoutResponse); ok {
+			return x.LogoutResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetAdminResponse() *AdminResponse {
+	if x != nil {
+		if x, ok := x.Body.(*PlayerResponse_AdminResponse); ok {
+			return x.AdminResponse
+		}
+	}
+	return nil
+}
+
+type isPlayerResponse_Body interface {
+	isPlayerResponse_Body()
+}
+
 type PlayerResponse_EnterServerResponse struct {
 	EnterServerResponse *EnterServerResponse `protobuf:"bytes,10,opt,name=enterServerResponse,proto3,oneof"`
 }
@@ -860,6 +1588,118 @@ type PlayerResponse_AssignArmyResponse struct {
 	AssignArmyResponse *AssignArmyResponse `protobuf:"bytes,32,opt,name=assignArmyResponse,proto3,oneof"`
 }
 
+type PlayerResponse_GiveUpResponse struct {
+	GiveUpResponse *GiveUpResponse `protobuf:"bytes,33,opt,name=giveUpResponse,proto3,oneof"`
+}
+
+type PlayerResponse_PosTagAddResponse struct {
+	PosTagAddResponse *PosTagAddResponse `protobuf:"bytes,34,opt,name=posTagAddResponse,proto3,oneof"`
+}
+
+type PlayerResponse_PosTagRenameResponse struct {
+	PosTagRenameResponse *PosTagRenameResponse `protobuf:"bytes,35,opt,name=posTagRenameResponse,proto3,oneof"`
+}
+
+type PlayerResponse_PosTagDelResponse struct {
+	PosTagDelResponse *PosTagDelResponse `protobuf:"bytes,36,opt,name=posTagDelResponse,proto3,oneof"`
+}
+
+type PlayerResponse_CreateSubCityResponse struct {
+	CreateSubCityResponse *CreateSubCityResponse `protobuf:"bytes,37,opt,name=createSubCityResponse,proto3,oneof"`
+}
+
+type PlayerResponse_MoveCityResponse struct {
+	MoveCityResponse *MoveCityResponse `protobuf:"bytes,38,opt,name=moveCityResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceCreateResponse struct {
+	AllianceCreateResponse *AllianceCreateResponse `protobuf:"bytes,39,opt,name=allianceCreateResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceJoinResponse struct {
+	AllianceJoinResponse *AllianceJoinResponse `protobuf:"bytes,40,opt,name=allianceJoinResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceVerifyResponse struct {
+	AllianceVerifyResponse *AllianceVerifyResponse `protobuf:"bytes,41,opt,name=allianceVerifyResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceExitResponse struct {
+	AllianceExitResponse *AllianceExitResponse `protobuf:"bytes,42,opt,name=allianceExitResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceKickResponse struct {
+	AllianceKickResponse *AllianceKickResponse `protobuf:"bytes,43,opt,name=allianceKickResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceDismissResponse struct {
+	AllianceDismissResponse *AllianceDismissResponse `protobuf:"bytes,44,opt,name=allianceDismissResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceAppointResponse struct {
+	AllianceAppointResponse *AllianceAppointResponse `protobuf:"bytes,45,opt,name=allianceAppointResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceTransferResponse struct {
+	AllianceTransferResponse *AllianceTransferResponse `protobuf:"bytes,46,opt,name=allianceTransferResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceNoticeResponse struct {
+	AllianceNoticeResponse *AllianceNoticeResponse `protobuf:"bytes,47,opt,name=allianceNoticeResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceLogResponse struct {
+	AllianceLogResponse *AllianceLogResponse `protobuf:"bytes,48,opt,name=allianceLogResponse,proto3,oneof"`
+}
+
+type PlayerResponse_RallyCreateResponse struct {
+	RallyCreateResponse *RallyCreateResponse `protobuf:"bytes,49,opt,name=rallyCreateResponse,proto3,oneof"`
+}
+
+type PlayerResponse_RallyJoinResponse struct {
+	RallyJoinResponse *RallyJoinResponse `protobuf:"bytes,50,opt,name=rallyJoinResponse,proto3,oneof"`
+}
+
+type PlayerResponse_RallyListResponse struct {
+	RallyListResponse *RallyListResponse `protobuf:"bytes,51,opt,name=rallyListResponse,proto3,oneof"`
+}
+
+type PlayerResponse_ReinforceBackResponse struct {
+	ReinforceBackResponse *ReinforceBackResponse `protobuf:"bytes,52,opt,name=reinforceBackResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceMarkAddResponse struct {
+	AllianceMarkAddResponse *AllianceMarkAddResponse `protobuf:"bytes,53,opt,name=allianceMarkAddResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceMarkDelResponse struct {
+	AllianceMarkDelResponse *AllianceMarkDelResponse `protobuf:"bytes,54,opt,name=allianceMarkDelResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AllianceMarkListResponse struct {
+	AllianceMarkListResponse *AllianceMarkListResponse `protobuf:"bytes,55,opt,name=allianceMarkListResponse,proto3,oneof"`
+}
+
+type PlayerResponse_DiplomacyProposeResponse struct {
+	DiplomacyProposeResponse *DiplomacyProposeResponse `protobuf:"bytes,56,opt,name=diplomacyProposeResponse,proto3,oneof"`
+}
+
+type PlayerResponse_DiplomacyAcceptResponse struct {
+	DiplomacyAcceptResponse *DiplomacyAcceptResponse `protobuf:"bytes,57,opt,name=diplomacyAcceptResponse,proto3,oneof"`
+}
+
+type PlayerResponse_DiplomacyListResponse struct {
+	DiplomacyListResponse *DiplomacyListResponse `protobuf:"bytes,58,opt,name=diplomacyListResponse,proto3,oneof"`
+}
+
+type PlayerResponse_LogoutResponse struct {
+	LogoutResponse *LogoutResponse `protobuf:"bytes,59,opt,name=logoutResponse,proto3,oneof"`
+}
+
+type PlayerResponse_AdminResponse struct {
+	AdminResponse *AdminResponse `protobuf:"bytes,60,opt,name=adminResponse,proto3,oneof"`
+}
+
 func (*PlayerResponse_EnterServerResponse) isPlayerResponse_Body() {}
 
 func (*PlayerResponse_CreateRoleResponse) isPlayerResponse_Body() {}
@@ -906,6 +1746,62 @@ func (*PlayerResponse_ArmyInfoResponse) isPlayerResponse_Body() {}
 
 func (*PlayerResponse_AssignArmyResponse) isPlayerResponse_Body() {}
 
+func (*PlayerResponse_GiveUpResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_PosTagAddResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_PosTagRenameResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_PosTagDelResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_CreateSubCityResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_MoveCityResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceCreateResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceJoinResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceVerifyResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceExitResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceKickResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceDismissResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceAppointResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceTransferResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceNoticeResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceLogResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_RallyCreateResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_RallyJoinResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_RallyListResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_ReinforceBackResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceMarkAddResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceMarkDelResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AllianceMarkListResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_DiplomacyProposeResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_DiplomacyAcceptResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_DiplomacyListResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_LogoutResponse) isPlayerResponse_Body() {}
+
+func (*PlayerResponse_AdminResponse) isPlayerResponse_Body() {}
+
 type EnterServerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -1285,6 +2181,7 @@ type MyPropertyResponse struct {
 	Generals      []*General             `protobuf:"bytes,3,rep,name=generals,proto3" json:"generals,omitempty"`
 	Cities        []*City                `protobuf:"bytes,4,rep,name=cities,proto3" json:"cities,omitempty"`
 	Armies        []*Army                `protobuf:"bytes,5,rep,name=armies,proto3" json:"armies,omitempty"`
+	PosTags       []*PosTag              `protobuf:"bytes,6,rep,name=posTags,proto3" json:"posTags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1354,6 +2251,13 @@ func (x *MyPropertyResponse) GetArmies() []*Army {
 	return nil
 }
 
+func (x *MyPropertyResponse) GetPosTags() []*PosTag {
+	if x != nil {
+		return x.PosTags
+	}
+	return nil
+}
+
 type PosTagListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -1442,26 +2346,30 @@ func (x *PosTagListResponse) GetPosTags() []*PosTag {
 	return nil
 }
 
-type MyGeneralsRequest struct {
+// 路由 role.addPosTag
+type PosTagAddRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MyGeneralsRequest) Reset() {
-	*x = MyGeneralsRequest{}
+func (x *PosTagAddRequest) Reset() {
+	*x = PosTagAddRequest{}
 	mi := &file_player_player_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyGeneralsRequest) String() string {
+func (x *PosTagAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyGeneralsRequest) ProtoMessage() {}
+func (*PosTagAddRequest) ProtoMessage() {}
 
-func (x *MyGeneralsRequest) ProtoReflect() protoreflect.Message {
+func (x *PosTagAddRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,32 +2381,53 @@ func (x *MyGeneralsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyGeneralsRequest.ProtoReflect.Descriptor instead.
-func (*MyGeneralsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagAddRequest.ProtoReflect.Descriptor instead.
+func (*PosTagAddRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{12}
 }
 
-type MyGeneralsResponse struct {
+func (x *PosTagAddRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PosTagAddRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PosTagAddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PosTagAddResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Generals      []*General             `protobuf:"bytes,1,rep,name=generals,proto3" json:"generals,omitempty"`
+	PosTags       []*PosTag              `protobuf:"bytes,1,rep,name=posTags,proto3" json:"posTags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MyGeneralsResponse) Reset() {
-	*x = MyGeneralsResponse{}
+func (x *PosTagAddResponse) Reset() {
+	*x = PosTagAddResponse{}
 	mi := &file_player_player_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyGeneralsResponse) String() string {
+func (x *PosTagAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyGeneralsResponse) ProtoMessage() {}
+func (*PosTagAddResponse) ProtoMessage() {}
 
-func (x *MyGeneralsResponse) ProtoReflect() protoreflect.Message {
+func (x *PosTagAddResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,39 +2439,42 @@ func (x *MyGeneralsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyGeneralsResponse.ProtoReflect.Descriptor instead.
-func (*MyGeneralsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagAddResponse.ProtoReflect.Descriptor instead.
+func (*PosTagAddResponse) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MyGeneralsResponse) GetGenerals() []*General {
+func (x *PosTagAddResponse) GetPosTags() []*PosTag {
 	if x != nil {
-		return x.Generals
+		return x.PosTags
 	}
 	return nil
 }
 
-type ArmyListRequest struct {
+// 路由 role.renamePosTag
+type PosTagRenameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=CityId,proto3" json:"CityId,omitempty"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArmyListRequest) Reset() {
-	*x = ArmyListRequest{}
+func (x *PosTagRenameRequest) Reset() {
+	*x = PosTagRenameRequest{}
 	mi := &file_player_player_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArmyListRequest) String() string {
+func (x *PosTagRenameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArmyListRequest) ProtoMessage() {}
+func (*PosTagRenameRequest) ProtoMessage() {}
 
-func (x *ArmyListRequest) ProtoReflect() protoreflect.Message {
+func (x *PosTagRenameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1554,40 +2486,53 @@ func (x *ArmyListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArmyListRequest.ProtoReflect.Descriptor instead.
-func (*ArmyListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagRenameRequest.ProtoReflect.Descriptor instead.
+func (*PosTagRenameRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ArmyListRequest) GetCityId() int32 {
+func (x *PosTagRenameRequest) GetX() int32 {
 	if x != nil {
-		return x.CityId
+		return x.X
 	}
 	return 0
 }
 
-type ArmyListResponse struct {
+func (x *PosTagRenameRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PosTagRenameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PosTagRenameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=CityId,proto3" json:"CityId,omitempty"`
-	Armies        []*Army                `protobuf:"bytes,2,rep,name=armies,proto3" json:"armies,omitempty"`
+	PosTags       []*PosTag              `protobuf:"bytes,1,rep,name=posTags,proto3" json:"posTags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArmyListResponse) Reset() {
-	*x = ArmyListResponse{}
+func (x *PosTagRenameResponse) Reset() {
+	*x = PosTagRenameResponse{}
 	mi := &file_player_player_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArmyListResponse) String() string {
+func (x *PosTagRenameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArmyListResponse) ProtoMessage() {}
+func (*PosTagRenameResponse) ProtoMessage() {}
 
-func (x *ArmyListResponse) ProtoReflect() protoreflect.Message {
+func (x *PosTagRenameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1599,45 +2544,41 @@ func (x *ArmyListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArmyListResponse.ProtoReflect.Descriptor instead.
-func (*ArmyListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagRenameResponse.ProtoReflect.Descriptor instead.
+func (*PosTagRenameResponse) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ArmyListResponse) GetCityId() int32 {
-	if x != nil {
-		return x.CityId
-	}
-	return 0
-}
-
-func (x *ArmyListResponse) GetArmies() []*Army {
+func (x *PosTagRenameResponse) GetPosTags() []*PosTag {
 	if x != nil {
-		return x.Armies
+		return x.PosTags
 	}
 	return nil
 }
 
-type WarReportRequest struct {
+// 路由 role.delPosTag
+type PosTagDelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WarReportRequest) Reset() {
-	*x = WarReportRequest{}
+func (x *PosTagDelRequest) Reset() {
+	*x = PosTagDelRequest{}
 	mi := &file_player_player_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WarReportRequest) String() string {
+func (x *PosTagDelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarReportRequest) ProtoMessage() {}
+func (*PosTagDelRequest) ProtoMessage() {}
 
-func (x *WarReportRequest) ProtoReflect() protoreflect.Message {
+func (x *PosTagDelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1649,32 +2590,46 @@ func (x *WarReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarReportRequest.ProtoReflect.Descriptor instead.
-func (*WarReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagDelRequest.ProtoReflect.Descriptor instead.
+func (*PosTagDelRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{16}
 }
 
-type WarReportResponse struct {
+func (x *PosTagDelRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PosTagDelRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PosTagDelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WarReports    []*WarReport           `protobuf:"bytes,1,rep,name=warReports,proto3" json:"warReports,omitempty"`
+	PosTags       []*PosTag              `protobuf:"bytes,1,rep,name=posTags,proto3" json:"posTags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WarReportResponse) Reset() {
-	*x = WarReportResponse{}
+func (x *PosTagDelResponse) Reset() {
+	*x = PosTagDelResponse{}
 	mi := &file_player_player_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WarReportResponse) String() string {
+func (x *PosTagDelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarReportResponse) ProtoMessage() {}
+func (*PosTagDelResponse) ProtoMessage() {}
 
-func (x *WarReportResponse) ProtoReflect() protoreflect.Message {
+func (x *PosTagDelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1686,38 +2641,38 @@ func (x *WarReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarReportResponse.ProtoReflect.Descriptor instead.
-func (*WarReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PosTagDelResponse.ProtoReflect.Descriptor instead.
+func (*PosTagDelResponse) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WarReportResponse) GetWarReports() []*WarReport {
+func (x *PosTagDelResponse) GetPosTags() []*PosTag {
 	if x != nil {
-		return x.WarReports
+		return x.PosTags
 	}
 	return nil
 }
 
-type SkillListRequest struct {
+type MyGeneralsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SkillListRequest) Reset() {
-	*x = SkillListRequest{}
+func (x *MyGeneralsRequest) Reset() {
+	*x = MyGeneralsRequest{}
 	mi := &file_player_player_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SkillListRequest) String() string {
+func (x *MyGeneralsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillListRequest) ProtoMessage() {}
+func (*MyGeneralsRequest) ProtoMessage() {}
 
-func (x *SkillListRequest) ProtoReflect() protoreflect.Message {
+func (x *MyGeneralsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1729,32 +2684,32 @@ func (x *SkillListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillListRequest.ProtoReflect.Descriptor instead.
-func (*SkillListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MyGeneralsRequest.ProtoReflect.Descriptor instead.
+func (*MyGeneralsRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{18}
 }
 
-type SkillListResponse struct {
+type MyGeneralsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skills        []*Skill               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Generals      []*General             `protobuf:"bytes,1,rep,name=generals,proto3" json:"generals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SkillListResponse) Reset() {
-	*x = SkillListResponse{}
+func (x *MyGeneralsResponse) Reset() {
+	*x = MyGeneralsResponse{}
 	mi := &file_player_player_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SkillListResponse) String() string {
+func (x *MyGeneralsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillListResponse) ProtoMessage() {}
+func (*MyGeneralsResponse) ProtoMessage() {}
 
-func (x *SkillListResponse) ProtoReflect() protoreflect.Message {
+func (x *MyGeneralsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1766,41 +2721,39 @@ func (x *SkillListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillListResponse.ProtoReflect.Descriptor instead.
-func (*SkillListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MyGeneralsResponse.ProtoReflect.Descriptor instead.
+func (*MyGeneralsResponse) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SkillListResponse) GetSkills() []*Skill {
+func (x *MyGeneralsResponse) GetGenerals() []*General {
 	if x != nil {
-		return x.Skills
+		return x.Generals
 	}
 	return nil
 }
 
-type ScanBlockRequest struct {
+type ArmyListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
-	Length        int32                  `protobuf:"varint,3,opt,name=Length,proto3" json:"Length,omitempty"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=CityId,proto3" json:"CityId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanBlockRequest) Reset() {
-	*x = ScanBlockRequest{}
+func (x *ArmyListRequest) Reset() {
+	*x = ArmyListRequest{}
 	mi := &file_player_player_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanBlockRequest) String() string {
+func (x *ArmyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanBlockRequest) ProtoMessage() {}
+func (*ArmyListRequest) ProtoMessage() {}
 
-func (x *ScanBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ArmyListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1812,55 +2765,40 @@ func (x *ScanBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanBlockRequest.ProtoReflect.Descriptor instead.
-func (*ScanBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArmyListRequest.ProtoReflect.Descriptor instead.
+func (*ArmyListRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ScanBlockRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *ScanBlockRequest) GetY() int32 {
-	if x != nil {
-		return x.Y
-	}
-	return 0
-}
-
-func (x *ScanBlockRequest) GetLength() int32 {
+func (x *ArmyListRequest) GetCityId() int32 {
 	if x != nil {
-		return x.Length
+		return x.CityId
 	}
 	return 0
 }
 
-type ScanBlockResponse struct {
+type ArmyListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
-	Cities        []*City                `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
-	Armies        []*Army                `protobuf:"bytes,3,rep,name=Armies,proto3" json:"Armies,omitempty"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=CityId,proto3" json:"CityId,omitempty"`
+	Armies        []*Army                `protobuf:"bytes,2,rep,name=armies,proto3" json:"armies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanBlockResponse) Reset() {
-	*x = ScanBlockResponse{}
+func (x *ArmyListResponse) Reset() {
+	*x = ArmyListResponse{}
 	mi := &file_player_player_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanBlockResponse) String() string {
+func (x *ArmyListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanBlockResponse) ProtoMessage() {}
+func (*ArmyListResponse) ProtoMessage() {}
 
-func (x *ScanBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *ArmyListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1872,52 +2810,45 @@ func (x *ScanBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanBlockResponse.ProtoReflect.Descriptor instead.
-func (*ScanBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArmyListResponse.ProtoReflect.Descriptor instead.
+func (*ArmyListResponse) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ScanBlockResponse) GetBuildings() []*Building {
-	if x != nil {
-		return x.Buildings
-	}
-	return nil
-}
-
-func (x *ScanBlockResponse) GetCities() []*City {
+func (x *ArmyListResponse) GetCityId() int32 {
 	if x != nil {
-		return x.Cities
+		return x.CityId
 	}
-	return nil
+	return 0
 }
 
-func (x *ScanBlockResponse) GetArmies() []*Army {
+func (x *ArmyListResponse) GetArmies() []*Army {
 	if x != nil {
 		return x.Armies
 	}
 	return nil
 }
 
-type OpenCollectionRequest struct {
+type WarReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenCollectionRequest) Reset() {
-	*x = OpenCollectionRequest{}
+func (x *WarReportRequest) Reset() {
+	*x = WarReportRequest{}
 	mi := &file_player_player_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenCollectionRequest) String() string {
+func (x *WarReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenCollectionRequest) ProtoMessage() {}
+func (*WarReportRequest) ProtoMessage() {}
 
-func (x *OpenCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *WarReportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_player_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1929,35 +2860,2748 @@ func (x *OpenCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenCollectionRequest.ProtoReflect.Descriptor instead.
-func (*OpenCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WarReportRequest.ProtoReflect.Descriptor instead.
+func (*WarReportRequest) Descriptor() ([]byte, []int) {
 	return file_player_player_proto_rawDescGZIP(), []int{22}
 }
 
-type OpenCollectionResponse struct {
+type WarReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarReports    []*WarReport           `protobuf:"bytes,1,rep,name=warReports,proto3" json:"warReports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarReportResponse) Reset() {
+	*x = WarReportResponse{}
+	mi := &file_player_player_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarReportResponse) ProtoMessage() {}
+
+func (x *WarReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarReportResponse.ProtoReflect.Descriptor instead.
+func (*WarReportResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WarReportResponse) GetWarReports() []*WarReport {
+	if x != nil {
+		return x.WarReports
+	}
+	return nil
+}
+
+type SkillListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillListRequest) Reset() {
+	*x = SkillListRequest{}
+	mi := &file_player_player_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillListRequest) ProtoMessage() {}
+
+func (x *SkillListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillListRequest.ProtoReflect.Descriptor instead.
+func (*SkillListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{24}
+}
+
+type SkillListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*Skill               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillListResponse) Reset() {
+	*x = SkillListResponse{}
+	mi := &file_player_player_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillListResponse) ProtoMessage() {}
+
+func (x *SkillListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillListResponse.ProtoReflect.Descriptor instead.
+func (*SkillListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SkillListResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type ScanBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Length        int32                  `protobuf:"varint,3,opt,name=Length,proto3" json:"Length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanBlockRequest) Reset() {
+	*x = ScanBlockRequest{}
+	mi := &file_player_player_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanBlockRequest) ProtoMessage() {}
+
+func (x *ScanBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanBlockRequest.ProtoReflect.Descriptor instead.
+func (*ScanBlockRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScanBlockRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ScanBlockRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ScanBlockRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type ScanBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildings     []*Building            `protobuf:"bytes,1,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	Cities        []*City                `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
+	Armies        []*Army                `protobuf:"bytes,3,rep,name=Armies,proto3" json:"Armies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanBlockResponse) Reset() {
+	*x = ScanBlockResponse{}
+	mi := &file_player_player_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanBlockResponse) ProtoMessage() {}
+
+func (x *ScanBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanBlockResponse.ProtoReflect.Descriptor instead.
+func (*ScanBlockResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ScanBlockResponse) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+func (x *ScanBlockResponse) GetCities() []*City {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *ScanBlockResponse) GetArmies() []*Army {
+	if x != nil {
+		return x.Armies
+	}
+	return nil
+}
+
+type OpenCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenCollectionRequest) Reset() {
+	*x = OpenCollectionRequest{}
+	mi := &file_player_player_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCollectionRequest) ProtoMessage() {}
+
+func (x *OpenCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCollectionRequest.ProtoReflect.Descriptor instead.
+func (*OpenCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{28}
+}
+
+type OpenCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	CurTimes      int32                  `protobuf:"varint,2,opt,name=curTimes,proto3" json:"curTimes,omitempty"`
+	NextTime      int64                  `protobuf:"varint,3,opt,name=nextTime,proto3" json:"nextTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenCollectionResponse) Reset() {
+	*x = OpenCollectionResponse{}
+	mi := &file_player_player_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCollectionResponse) ProtoMessage() {}
+
+func (x *OpenCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCollectionResponse.ProtoReflect.Descriptor instead.
+func (*OpenCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OpenCollectionResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *OpenCollectionResponse) GetCurTimes() int32 {
+	if x != nil {
+		return x.CurTimes
+	}
+	return 0
+}
+
+func (x *OpenCollectionResponse) GetNextTime() int64 {
+	if x != nil {
+		return x.NextTime
+	}
+	return 0
+}
+
+type CollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionRequest) Reset() {
+	*x = CollectionRequest{}
+	mi := &file_player_player_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionRequest) ProtoMessage() {}
+
+func (x *CollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionRequest.ProtoReflect.Descriptor instead.
+func (*CollectionRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{30}
+}
+
+type CollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gold          int32                  `protobuf:"varint,1,opt,name=gold,proto3" json:"gold,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CurTimes      int32                  `protobuf:"varint,3,opt,name=curTimes,proto3" json:"curTimes,omitempty"`
+	NextTime      int64                  `protobuf:"varint,4,opt,name=nextTime,proto3" json:"nextTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionResponse) Reset() {
+	*x = CollectionResponse{}
+	mi := &file_player_player_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionResponse) ProtoMessage() {}
+
+func (x *CollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionResponse.ProtoReflect.Descriptor instead.
+func (*CollectionResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CollectionResponse) GetGold() int32 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *CollectionResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CollectionResponse) GetCurTimes() int32 {
+	if x != nil {
+		return x.CurTimes
+	}
+	return 0
+}
+
+func (x *CollectionResponse) GetNextTime() int64 {
+	if x != nil {
+		return x.NextTime
+	}
+	return 0
+}
+
+type AllianceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 名称关键字
+	Prefix        bool                   `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`  // true 前缀匹配，否则子串匹配
+	Sort          AllianceSort           `protobuf:"varint,3,opt,name=sort,proto3,enum=three_kingdoms.player.AllianceSort" json:"sort,omitempty"`
+	HasFree       bool                   `protobuf:"varint,4,opt,name=has_free,json=hasFree,proto3" json:"has_free,omitempty"` // 只看有空位的联盟
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                   // 上一页返回的 next_cursor，首页为空
+	Size          int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                      // 每页条数，默认 20，最多 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceListRequest) Reset() {
+	*x = AllianceListRequest{}
+	mi := &file_player_player_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceListRequest) ProtoMessage() {}
+
+func (x *AllianceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceListRequest.ProtoReflect.Descriptor instead.
+func (*AllianceListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AllianceListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *AllianceListRequest) GetPrefix() bool {
+	if x != nil {
+		return x.Prefix
+	}
+	return false
+}
+
+func (x *AllianceListRequest) GetSort() AllianceSort {
+	if x != nil {
+		return x.Sort
+	}
+	return AllianceSort_ALLIANCE_SORT_ID
+}
+
+func (x *AllianceListRequest) GetHasFree() bool {
+	if x != nil {
+		return x.HasFree
+	}
+	return false
+}
+
+func (x *AllianceListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AllianceListRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AllianceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*Alliance            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示没有下一页
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                            // 满足条件的联盟数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceListResponse) Reset() {
+	*x = AllianceListResponse{}
+	mi := &file_player_player_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceListResponse) ProtoMessage() {}
+
+func (x *AllianceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceListResponse.ProtoReflect.Descriptor instead.
+func (*AllianceListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AllianceListResponse) GetList() []*Alliance {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AllianceListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AllianceListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AllianceInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId    int32                  `protobuf:"varint,1,opt,name=allianceId,proto3" json:"allianceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceInfoRequest) Reset() {
+	*x = AllianceInfoRequest{}
+	mi := &file_player_player_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceInfoRequest) ProtoMessage() {}
+
+func (x *AllianceInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceInfoRequest.ProtoReflect.Descriptor instead.
+func (*AllianceInfoRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AllianceInfoRequest) GetAllianceId() int32 {
+	if x != nil {
+		return x.AllianceId
+	}
+	return 0
+}
+
+type AllianceInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alliance      *Alliance              `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceInfoResponse) Reset() {
+	*x = AllianceInfoResponse{}
+	mi := &file_player_player_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceInfoResponse) ProtoMessage() {}
+
+func (x *AllianceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceInfoResponse.ProtoReflect.Descriptor instead.
+func (*AllianceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AllianceInfoResponse) GetAlliance() *Alliance {
+	if x != nil {
+		return x.Alliance
+	}
+	return nil
+}
+
+type AllianceApplyListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceApplyListRequest) Reset() {
+	*x = AllianceApplyListRequest{}
+	mi := &file_player_player_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceApplyListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceApplyListRequest) ProtoMessage() {}
+
+func (x *AllianceApplyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceApplyListRequest.ProtoReflect.Descriptor instead.
+func (*AllianceApplyListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{36}
+}
+
+type AllianceApplyListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          []*ApplyItem           `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceApplyListResponse) Reset() {
+	*x = AllianceApplyListResponse{}
+	mi := &file_player_player_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceApplyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceApplyListResponse) ProtoMessage() {}
+
+func (x *AllianceApplyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceApplyListResponse.ProtoReflect.Descriptor instead.
+func (*AllianceApplyListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AllianceApplyListResponse) GetItem() []*ApplyItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DrawGeneralRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrawTimes     int32                  `protobuf:"varint,1,opt,name=DrawTimes,proto3" json:"DrawTimes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawGeneralRequest) Reset() {
+	*x = DrawGeneralRequest{}
+	mi := &file_player_player_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawGeneralRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGeneralRequest) ProtoMessage() {}
+
+func (x *DrawGeneralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGeneralRequest.ProtoReflect.Descriptor instead.
+func (*DrawGeneralRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DrawGeneralRequest) GetDrawTimes() int32 {
+	if x != nil {
+		return x.DrawTimes
+	}
+	return 0
+}
+
+type DrawGeneralResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Generals      []*General             `protobuf:"bytes,1,rep,name=generals,proto3" json:"generals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawGeneralResponse) Reset() {
+	*x = DrawGeneralResponse{}
+	mi := &file_player_player_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawGeneralResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawGeneralResponse) ProtoMessage() {}
+
+func (x *DrawGeneralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawGeneralResponse.ProtoReflect.Descriptor instead.
+func (*DrawGeneralResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DrawGeneralResponse) GetGenerals() []*General {
+	if x != nil {
+		return x.Generals
+	}
+	return nil
+}
+
+type FacilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"` //0 表示主城
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacilitiesRequest) Reset() {
+	*x = FacilitiesRequest{}
+	mi := &file_player_player_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacilitiesRequest) ProtoMessage() {}
+
+func (x *FacilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacilitiesRequest.ProtoReflect.Descriptor instead.
+func (*FacilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FacilitiesRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+type FacilitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Facilities    []*Facility            `protobuf:"bytes,2,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacilitiesResponse) Reset() {
+	*x = FacilitiesResponse{}
+	mi := &file_player_player_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacilitiesResponse) ProtoMessage() {}
+
+func (x *FacilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacilitiesResponse.ProtoReflect.Descriptor instead.
+func (*FacilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FacilitiesResponse) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *FacilitiesResponse) GetFacilities() []*Facility {
+	if x != nil {
+		return x.Facilities
+	}
+	return nil
+}
+
+type UpFacilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	FType         int32                  `protobuf:"varint,2,opt,name=f_type,json=fType,proto3" json:"f_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpFacilityRequest) Reset() {
+	*x = UpFacilityRequest{}
+	mi := &file_player_player_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpFacilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpFacilityRequest) ProtoMessage() {}
+
+func (x *UpFacilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpFacilityRequest.ProtoReflect.Descriptor instead.
+func (*UpFacilityRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpFacilityRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *UpFacilityRequest) GetFType() int32 {
+	if x != nil {
+		return x.FType
+	}
+	return 0
+}
+
+type UpFacilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Facility      *Facility              `protobuf:"bytes,2,opt,name=facility,proto3" json:"facility,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpFacilityResponse) Reset() {
+	*x = UpFacilityResponse{}
+	mi := &file_player_player_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpFacilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpFacilityResponse) ProtoMessage() {}
+
+func (x *UpFacilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpFacilityResponse.ProtoReflect.Descriptor instead.
+func (*UpFacilityResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpFacilityResponse) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *UpFacilityResponse) GetFacility() *Facility {
+	if x != nil {
+		return x.Facility
+	}
+	return nil
+}
+
+func (x *UpFacilityResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type TransformRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []int32                `protobuf:"varint,1,rep,packed,name=from,proto3" json:"from,omitempty"` //0 Wood 1 Iron 2 Stone 3 Grain
+	To            []int32                `protobuf:"varint,2,rep,packed,name=to,proto3" json:"to,omitempty"`     //0 Wood 1 Iron 2 Stone 3 Grain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformRequest) Reset() {
+	*x = TransformRequest{}
+	mi := &file_player_player_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformRequest) ProtoMessage() {}
+
+func (x *TransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformRequest.ProtoReflect.Descriptor instead.
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransformRequest) GetFrom() []int32 {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransformRequest) GetTo() []int32 {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TransformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Rate          int32                  `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`                               //兑换率，百分比
+	Prices        []int32                `protobuf:"varint,3,rep,packed,name=prices,proto3" json:"prices,omitempty"`                    //成交后的行情，千分比，顺序同 from/to
+	DailyUsed     int32                  `protobuf:"varint,4,opt,name=daily_used,json=dailyUsed,proto3" json:"daily_used,omitempty"`    //当日已兑换量
+	DailyLimit    int32                  `protobuf:"varint,5,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"` //每日兑换上限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformResponse) Reset() {
+	*x = TransformResponse{}
+	mi := &file_player_player_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformResponse) ProtoMessage() {}
+
+func (x *TransformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformResponse.ProtoReflect.Descriptor instead.
+func (*TransformResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TransformResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *TransformResponse) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TransformResponse) GetPrices() []int32 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *TransformResponse) GetDailyUsed() int32 {
+	if x != nil {
+		return x.DailyUsed
+	}
+	return 0
+}
+
+func (x *TransformResponse) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+// 配置武将
+type DisposeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	GeneralId     int32                  `protobuf:"varint,2,opt,name=general_id,json=generalId,proto3" json:"general_id,omitempty"`
+	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`       //第几队，1-5队
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` //位置，-1到2,-1是解除该武将上阵状态, 0 1 2 分别是三个武将槽位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisposeRequest) Reset() {
+	*x = DisposeRequest{}
+	mi := &file_player_player_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisposeRequest) ProtoMessage() {}
+
+func (x *DisposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisposeRequest.ProtoReflect.Descriptor instead.
+func (*DisposeRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DisposeRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *DisposeRequest) GetGeneralId() int32 {
+	if x != nil {
+		return x.GeneralId
+	}
+	return 0
+}
+
+func (x *DisposeRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *DisposeRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type DisposeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *Army                  `protobuf:"bytes,1,opt,name=Army,proto3" json:"Army,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisposeResponse) Reset() {
+	*x = DisposeResponse{}
+	mi := &file_player_player_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisposeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisposeResponse) ProtoMessage() {}
+
+func (x *DisposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisposeResponse.ProtoReflect.Descriptor instead.
+func (*DisposeResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DisposeResponse) GetArmy() *Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+type ConscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArmyId        int32                  `protobuf:"varint,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"` //队伍 id
+	Counts        []int32                `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`        //征兵人数 主将、副将、副将：[20,20,0]
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConscriptRequest) Reset() {
+	*x = ConscriptRequest{}
+	mi := &file_player_player_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConscriptRequest) ProtoMessage() {}
+
+func (x *ConscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConscriptRequest.ProtoReflect.Descriptor instead.
+func (*ConscriptRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ConscriptRequest) GetArmyId() int32 {
+	if x != nil {
+		return x.ArmyId
+	}
+	return 0
+}
+
+func (x *ConscriptRequest) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type ConscriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConscriptResponse) Reset() {
+	*x = ConscriptResponse{}
+	mi := &file_player_player_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConscriptResponse) ProtoMessage() {}
+
+func (x *ConscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConscriptResponse.ProtoReflect.Descriptor instead.
+func (*ConscriptResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ConscriptResponse) GetArmy() *Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+func (x *ConscriptResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// 路由 army.myOne
+type ArmyInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         int32                  `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	CityId        int32                  `protobuf:"varint,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"` //0 表示主城
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmyInfoRequest) Reset() {
+	*x = ArmyInfoRequest{}
+	mi := &file_player_player_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmyInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmyInfoRequest) ProtoMessage() {}
+
+func (x *ArmyInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmyInfoRequest.ProtoReflect.Descriptor instead.
+func (*ArmyInfoRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ArmyInfoRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *ArmyInfoRequest) GetCityId() int32 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+type ArmyInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmyInfoResponse) Reset() {
+	*x = ArmyInfoResponse{}
+	mi := &file_player_player_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmyInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmyInfoResponse) ProtoMessage() {}
+
+func (x *ArmyInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmyInfoResponse.ProtoReflect.Descriptor instead.
+func (*ArmyInfoResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ArmyInfoResponse) GetArmy() *Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+// 路由 army.assign
+type AssignArmyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArmyId        int32                  `protobuf:"varint,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"` //队伍 id
+	Cmd           int32                  `protobuf:"varint,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignArmyRequest) Reset() {
+	*x = AssignArmyRequest{}
+	mi := &file_player_player_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignArmyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignArmyRequest) ProtoMessage() {}
+
+func (x *AssignArmyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignArmyRequest.ProtoReflect.Descriptor instead.
+func (*AssignArmyRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AssignArmyRequest) GetArmyId() int32 {
+	if x != nil {
+		return x.ArmyId
+	}
+	return 0
+}
+
+func (x *AssignArmyRequest) GetCmd() int32 {
+	if x != nil {
+		return x.Cmd
+	}
+	return 0
+}
+
+func (x *AssignArmyRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *AssignArmyRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type AssignArmyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignArmyResponse) Reset() {
+	*x = AssignArmyResponse{}
+	mi := &file_player_player_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignArmyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignArmyResponse) ProtoMessage() {}
+
+func (x *AssignArmyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignArmyResponse.ProtoReflect.Descriptor instead.
+func (*AssignArmyResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AssignArmyResponse) GetArmy() *Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+// 路由 army.reinforceBack，遣返增援军队，领主和增援方都可以发起
+type ReinforceBackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 增援军队所属玩家
+	ArmyId        int32                  `protobuf:"varint,4,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinforceBackRequest) Reset() {
+	*x = ReinforceBackRequest{}
+	mi := &file_player_player_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinforceBackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinforceBackRequest) ProtoMessage() {}
+
+func (x *ReinforceBackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinforceBackRequest.ProtoReflect.Descriptor instead.
+func (*ReinforceBackRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReinforceBackRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ReinforceBackRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ReinforceBackRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ReinforceBackRequest) GetArmyId() int32 {
+	if x != nil {
+		return x.ArmyId
+	}
+	return 0
+}
+
+type ReinforceBackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ArmyId        int32                  `protobuf:"varint,4,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinforceBackResponse) Reset() {
+	*x = ReinforceBackResponse{}
+	mi := &file_player_player_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinforceBackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinforceBackResponse) ProtoMessage() {}
+
+func (x *ReinforceBackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinforceBackResponse.ProtoReflect.Descriptor instead.
+func (*ReinforceBackResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReinforceBackResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ReinforceBackResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ReinforceBackResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ReinforceBackResponse) GetArmyId() int32 {
+	if x != nil {
+		return x.ArmyId
+	}
+	return 0
+}
+
+// 路由 nationMap.giveUp
+type GiveUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiveUpRequest) Reset() {
+	*x = GiveUpRequest{}
+	mi := &file_player_player_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiveUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiveUpRequest) ProtoMessage() {}
+
+func (x *GiveUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveUpRequest.ProtoReflect.Descriptor instead.
+func (*GiveUpRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GiveUpRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GiveUpRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type GiveUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	GiveUpTime    int64                  `protobuf:"varint,3,opt,name=give_up_time,json=giveUpTime,proto3" json:"give_up_time,omitempty"` //放弃生效时间，毫秒
+	Resource      *Resource              `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiveUpResponse) Reset() {
+	*x = GiveUpResponse{}
+	mi := &file_player_player_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiveUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiveUpResponse) ProtoMessage() {}
+
+func (x *GiveUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveUpResponse.ProtoReflect.Descriptor instead.
+func (*GiveUpResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GiveUpResponse) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GiveUpResponse) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *GiveUpResponse) GetGiveUpTime() int64 {
+	if x != nil {
+		return x.GiveUpTime
+	}
+	return 0
+}
+
+func (x *GiveUpResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// 路由 city.createSubCity
+type CreateSubCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubCityRequest) Reset() {
+	*x = CreateSubCityRequest{}
+	mi := &file_player_player_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubCityRequest) ProtoMessage() {}
+
+func (x *CreateSubCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubCityRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubCityRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateSubCityRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CreateSubCityRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CreateSubCityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateSubCityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubCityResponse) Reset() {
+	*x = CreateSubCityResponse{}
+	mi := &file_player_player_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubCityResponse) ProtoMessage() {}
+
+func (x *CreateSubCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubCityResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubCityResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateSubCityResponse) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *CreateSubCityResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// 路由 city.move
+type MoveCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCityRequest) Reset() {
+	*x = MoveCityRequest{}
+	mi := &file_player_player_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCityRequest) ProtoMessage() {}
+
+func (x *MoveCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCityRequest.ProtoReflect.Descriptor instead.
+func (*MoveCityRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MoveCityRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MoveCityRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type MoveCityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          *City                  `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	NextMoveTime  int64                  `protobuf:"varint,3,opt,name=next_move_time,json=nextMoveTime,proto3" json:"next_move_time,omitempty"` //下次可迁城时间，毫秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCityResponse) Reset() {
+	*x = MoveCityResponse{}
+	mi := &file_player_player_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCityResponse) ProtoMessage() {}
+
+func (x *MoveCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCityResponse.ProtoReflect.Descriptor instead.
+func (*MoveCityResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MoveCityResponse) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *MoveCityResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *MoveCityResponse) GetNextMoveTime() int64 {
+	if x != nil {
+		return x.NextMoveTime
+	}
+	return 0
+}
+
+// 路由 union.create
+type AllianceCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceCreateRequest) Reset() {
+	*x = AllianceCreateRequest{}
+	mi := &file_player_player_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceCreateRequest) ProtoMessage() {}
+
+func (x *AllianceCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceCreateRequest.ProtoReflect.Descriptor instead.
+func (*AllianceCreateRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AllianceCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AllianceCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alliance      *Alliance              `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceCreateResponse) Reset() {
+	*x = AllianceCreateResponse{}
+	mi := &file_player_player_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceCreateResponse) ProtoMessage() {}
+
+func (x *AllianceCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceCreateResponse.ProtoReflect.Descriptor instead.
+func (*AllianceCreateResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AllianceCreateResponse) GetAlliance() *Alliance {
+	if x != nil {
+		return x.Alliance
+	}
+	return nil
+}
+
+func (x *AllianceCreateResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// 路由 union.join
+type AllianceJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId    int32                  `protobuf:"varint,1,opt,name=allianceId,proto3" json:"allianceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceJoinRequest) Reset() {
+	*x = AllianceJoinRequest{}
+	mi := &file_player_player_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceJoinRequest) ProtoMessage() {}
+
+func (x *AllianceJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceJoinRequest.ProtoReflect.Descriptor instead.
+func (*AllianceJoinRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AllianceJoinRequest) GetAllianceId() int32 {
+	if x != nil {
+		return x.AllianceId
+	}
+	return 0
+}
+
+type AllianceJoinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceJoinResponse) Reset() {
+	*x = AllianceJoinResponse{}
+	mi := &file_player_player_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceJoinResponse) ProtoMessage() {}
+
+func (x *AllianceJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceJoinResponse.ProtoReflect.Descriptor instead.
+func (*AllianceJoinResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{65}
+}
+
+// 路由 union.verify
+type AllianceVerifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Decide        AllianceApplyStatus    `protobuf:"varint,2,opt,name=decide,proto3,enum=three_kingdoms.player.AllianceApplyStatus" json:"decide,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceVerifyRequest) Reset() {
+	*x = AllianceVerifyRequest{}
+	mi := &file_player_player_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceVerifyRequest) ProtoMessage() {}
+
+func (x *AllianceVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceVerifyRequest.ProtoReflect.Descriptor instead.
+func (*AllianceVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AllianceVerifyRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AllianceVerifyRequest) GetDecide() AllianceApplyStatus {
+	if x != nil {
+		return x.Decide
+	}
+	return AllianceApplyStatus_ALLIANCE_UNTREATED
+}
+
+type AllianceVerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Decide        AllianceApplyStatus    `protobuf:"varint,2,opt,name=decide,proto3,enum=three_kingdoms.player.AllianceApplyStatus" json:"decide,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceVerifyResponse) Reset() {
+	*x = AllianceVerifyResponse{}
+	mi := &file_player_player_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceVerifyResponse) ProtoMessage() {}
+
+func (x *AllianceVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceVerifyResponse.ProtoReflect.Descriptor instead.
+func (*AllianceVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AllianceVerifyResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AllianceVerifyResponse) GetDecide() AllianceApplyStatus {
+	if x != nil {
+		return x.Decide
+	}
+	return AllianceApplyStatus_ALLIANCE_UNTREATED
+}
+
+// 路由 union.exit
+type AllianceExitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceExitRequest) Reset() {
+	*x = AllianceExitRequest{}
+	mi := &file_player_player_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceExitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceExitRequest) ProtoMessage() {}
+
+func (x *AllianceExitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceExitRequest.ProtoReflect.Descriptor instead.
+func (*AllianceExitRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{68}
+}
+
+type AllianceExitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceExitResponse) Reset() {
+	*x = AllianceExitResponse{}
+	mi := &file_player_player_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceExitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceExitResponse) ProtoMessage() {}
+
+func (x *AllianceExitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceExitResponse.ProtoReflect.Descriptor instead.
+func (*AllianceExitResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{69}
+}
+
+// 路由 union.kick
+type AllianceKickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceKickRequest) Reset() {
+	*x = AllianceKickRequest{}
+	mi := &file_player_player_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceKickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceKickRequest) ProtoMessage() {}
+
+func (x *AllianceKickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceKickRequest.ProtoReflect.Descriptor instead.
+func (*AllianceKickRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AllianceKickRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type AllianceKickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceKickResponse) Reset() {
+	*x = AllianceKickResponse{}
+	mi := &file_player_player_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceKickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceKickResponse) ProtoMessage() {}
+
+func (x *AllianceKickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceKickResponse.ProtoReflect.Descriptor instead.
+func (*AllianceKickResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AllianceKickResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// 路由 union.dismiss
+type AllianceDismissRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceDismissRequest) Reset() {
+	*x = AllianceDismissRequest{}
+	mi := &file_player_player_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceDismissRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceDismissRequest) ProtoMessage() {}
+
+func (x *AllianceDismissRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceDismissRequest.ProtoReflect.Descriptor instead.
+func (*AllianceDismissRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{72}
+}
+
+type AllianceDismissResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceDismissResponse) Reset() {
+	*x = AllianceDismissResponse{}
+	mi := &file_player_player_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceDismissResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceDismissResponse) ProtoMessage() {}
+
+func (x *AllianceDismissResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceDismissResponse.ProtoReflect.Descriptor instead.
+func (*AllianceDismissResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{73}
+}
+
+// 路由 union.appoint，任免副盟主
+type AllianceAppointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Title         AllianceTitle          `protobuf:"varint,2,opt,name=title,proto3,enum=three_kingdoms.player.AllianceTitle" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceAppointRequest) Reset() {
+	*x = AllianceAppointRequest{}
+	mi := &file_player_player_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceAppointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceAppointRequest) ProtoMessage() {}
+
+func (x *AllianceAppointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceAppointRequest.ProtoReflect.Descriptor instead.
+func (*AllianceAppointRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AllianceAppointRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AllianceAppointRequest) GetTitle() AllianceTitle {
+	if x != nil {
+		return x.Title
+	}
+	return AllianceTitle_ALLIANCE_CHAIRMAN
+}
+
+type AllianceAppointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Title         AllianceTitle          `protobuf:"varint,2,opt,name=title,proto3,enum=three_kingdoms.player.AllianceTitle" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceAppointResponse) Reset() {
+	*x = AllianceAppointResponse{}
+	mi := &file_player_player_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceAppointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceAppointResponse) ProtoMessage() {}
+
+func (x *AllianceAppointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceAppointResponse.ProtoReflect.Descriptor instead.
+func (*AllianceAppointResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AllianceAppointResponse) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AllianceAppointResponse) GetTitle() AllianceTitle {
+	if x != nil {
+		return x.Title
+	}
+	return AllianceTitle_ALLIANCE_CHAIRMAN
+}
+
+// 路由 union.abdicate，盟主让位
+type AllianceTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	CurTimes      int32                  `protobuf:"varint,2,opt,name=curTimes,proto3" json:"curTimes,omitempty"`
-	NextTime      int64                  `protobuf:"varint,3,opt,name=nextTime,proto3" json:"nextTime,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenCollectionResponse) Reset() {
-	*x = OpenCollectionResponse{}
-	mi := &file_player_player_proto_msgTypes[23]
+func (x *AllianceTransferRequest) Reset() {
+	*x = AllianceTransferRequest{}
+	mi := &file_player_player_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenCollectionResponse) String() string {
+func (x *AllianceTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenCollectionResponse) ProtoMessage() {}
+func (*AllianceTransferRequest) ProtoMessage() {}
 
-func (x *OpenCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[23]
+func (x *AllianceTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,53 +5612,85 @@ func (x *OpenCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenCollectionResponse.ProtoReflect.Descriptor instead.
-func (*OpenCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AllianceTransferRequest.ProtoReflect.Descriptor instead.
+func (*AllianceTransferRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *OpenCollectionResponse) GetLimit() int32 {
+func (x *AllianceTransferRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Limit
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *OpenCollectionResponse) GetCurTimes() int32 {
+type AllianceTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceTransferResponse) Reset() {
+	*x = AllianceTransferResponse{}
+	mi := &file_player_player_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceTransferResponse) ProtoMessage() {}
+
+func (x *AllianceTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[77]
 	if x != nil {
-		return x.CurTimes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OpenCollectionResponse) GetNextTime() int64 {
+// Deprecated: Use AllianceTransferResponse.ProtoReflect.Descriptor instead.
+func (*AllianceTransferResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AllianceTransferResponse) GetPlayerId() int32 {
 	if x != nil {
-		return x.NextTime
+		return x.PlayerId
 	}
 	return 0
 }
 
-type CollectionRequest struct {
+// 路由 union.modNotice
+type AllianceNoticeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectionRequest) Reset() {
-	*x = CollectionRequest{}
-	mi := &file_player_player_proto_msgTypes[24]
+func (x *AllianceNoticeRequest) Reset() {
+	*x = AllianceNoticeRequest{}
+	mi := &file_player_player_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectionRequest) String() string {
+func (x *AllianceNoticeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionRequest) ProtoMessage() {}
+func (*AllianceNoticeRequest) ProtoMessage() {}
 
-func (x *CollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[24]
+func (x *AllianceNoticeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,36 +5701,40 @@ func (x *CollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionRequest.ProtoReflect.Descriptor instead.
-func (*CollectionRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AllianceNoticeRequest.ProtoReflect.Descriptor instead.
+func (*AllianceNoticeRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{78}
 }
 
-type CollectionResponse struct {
+func (x *AllianceNoticeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AllianceNoticeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gold          int32                  `protobuf:"varint,1,opt,name=gold,proto3" json:"gold,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	CurTimes      int32                  `protobuf:"varint,3,opt,name=curTimes,proto3" json:"curTimes,omitempty"`
-	NextTime      int64                  `protobuf:"varint,4,opt,name=nextTime,proto3" json:"nextTime,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollectionResponse) Reset() {
-	*x = CollectionResponse{}
-	mi := &file_player_player_proto_msgTypes[25]
+func (x *AllianceNoticeResponse) Reset() {
+	*x = AllianceNoticeResponse{}
+	mi := &file_player_player_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollectionResponse) String() string {
+func (x *AllianceNoticeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionResponse) ProtoMessage() {}
+func (*AllianceNoticeResponse) ProtoMessage() {}
 
-func (x *CollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[25]
+func (x *AllianceNoticeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,60 +5745,221 @@ func (x *CollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionResponse.ProtoReflect.Descriptor instead.
-func (*CollectionResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AllianceNoticeResponse.ProtoReflect.Descriptor instead.
+func (*AllianceNoticeResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CollectionResponse) GetGold() int32 {
+func (x *AllianceNoticeResponse) GetText() string {
 	if x != nil {
-		return x.Gold
+		return x.Text
+	}
+	return ""
+}
+
+// 路由 union.log，page 从 1 开始，按时间倒序
+type AllianceLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceLogRequest) Reset() {
+	*x = AllianceLogRequest{}
+	mi := &file_player_player_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceLogRequest) ProtoMessage() {}
+
+func (x *AllianceLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceLogRequest.ProtoReflect.Descriptor instead.
+func (*AllianceLogRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AllianceLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CollectionResponse) GetLimit() int32 {
+func (x *AllianceLogRequest) GetSize() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Size
 	}
 	return 0
 }
 
-func (x *CollectionResponse) GetCurTimes() int32 {
+type AllianceLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*AllianceLog         `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceLogResponse) Reset() {
+	*x = AllianceLogResponse{}
+	mi := &file_player_player_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceLogResponse) ProtoMessage() {}
+
+func (x *AllianceLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[81]
 	if x != nil {
-		return x.CurTimes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceLogResponse.ProtoReflect.Descriptor instead.
+func (*AllianceLogResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AllianceLogResponse) GetLogs() []*AllianceLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *AllianceLogResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *CollectionResponse) GetNextTime() int64 {
+// 路由 union.addMark，expire 为有效期（秒），0 取最长有效期
+type AllianceMarkAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Kind          AllianceMarkKind       `protobuf:"varint,3,opt,name=kind,proto3,enum=three_kingdoms.player.AllianceMarkKind" json:"kind,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Expire        int32                  `protobuf:"varint,5,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceMarkAddRequest) Reset() {
+	*x = AllianceMarkAddRequest{}
+	mi := &file_player_player_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceMarkAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceMarkAddRequest) ProtoMessage() {}
+
+func (x *AllianceMarkAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[82]
 	if x != nil {
-		return x.NextTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceMarkAddRequest.ProtoReflect.Descriptor instead.
+func (*AllianceMarkAddRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AllianceMarkAddRequest) GetX() int32 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-type AllianceListRequest struct {
+func (x *AllianceMarkAddRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *AllianceMarkAddRequest) GetKind() AllianceMarkKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AllianceMarkKind_ALLIANCE_MARK_ATTACK
+}
+
+func (x *AllianceMarkAddRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AllianceMarkAddRequest) GetExpire() int32 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type AllianceMarkAddResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceListRequest) Reset() {
-	*x = AllianceListRequest{}
-	mi := &file_player_player_proto_msgTypes[26]
+func (x *AllianceMarkAddResponse) Reset() {
+	*x = AllianceMarkAddResponse{}
+	mi := &file_player_player_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceListRequest) String() string {
+func (x *AllianceMarkAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceListRequest) ProtoMessage() {}
+func (*AllianceMarkAddResponse) ProtoMessage() {}
 
-func (x *AllianceListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[26]
+func (x *AllianceMarkAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,33 +5970,34 @@ func (x *AllianceListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceListRequest.ProtoReflect.Descriptor instead.
-func (*AllianceListRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AllianceMarkAddResponse.ProtoReflect.Descriptor instead.
+func (*AllianceMarkAddResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{83}
 }
 
-type AllianceListResponse struct {
+// 路由 union.delMark
+type AllianceMarkDelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*Alliance            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceListResponse) Reset() {
-	*x = AllianceListResponse{}
-	mi := &file_player_player_proto_msgTypes[27]
+func (x *AllianceMarkDelRequest) Reset() {
+	*x = AllianceMarkDelRequest{}
+	mi := &file_player_player_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceListResponse) String() string {
+func (x *AllianceMarkDelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceListResponse) ProtoMessage() {}
+func (*AllianceMarkDelRequest) ProtoMessage() {}
 
-func (x *AllianceListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[27]
+func (x *AllianceMarkDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,40 +6008,40 @@ func (x *AllianceListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceListResponse.ProtoReflect.Descriptor instead.
-func (*AllianceListResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AllianceMarkDelRequest.ProtoReflect.Descriptor instead.
+func (*AllianceMarkDelRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AllianceListResponse) GetList() []*Alliance {
+func (x *AllianceMarkDelRequest) GetId() int32 {
 	if x != nil {
-		return x.List
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type AllianceInfoRequest struct {
+type AllianceMarkDelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AllianceId    int32                  `protobuf:"varint,1,opt,name=allianceId,proto3" json:"allianceId,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceInfoRequest) Reset() {
-	*x = AllianceInfoRequest{}
-	mi := &file_player_player_proto_msgTypes[28]
+func (x *AllianceMarkDelResponse) Reset() {
+	*x = AllianceMarkDelResponse{}
+	mi := &file_player_player_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceInfoRequest) String() string {
+func (x *AllianceMarkDelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceInfoRequest) ProtoMessage() {}
+func (*AllianceMarkDelResponse) ProtoMessage() {}
 
-func (x *AllianceInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[28]
+func (x *AllianceMarkDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,40 +6052,77 @@ func (x *AllianceInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceInfoRequest.ProtoReflect.Descriptor instead.
-func (*AllianceInfoRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AllianceMarkDelResponse.ProtoReflect.Descriptor instead.
+func (*AllianceMarkDelResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AllianceMarkDelResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 路由 union.markList
+type AllianceMarkListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceMarkListRequest) Reset() {
+	*x = AllianceMarkListRequest{}
+	mi := &file_player_player_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceMarkListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceMarkListRequest) ProtoMessage() {}
+
+func (x *AllianceMarkListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *AllianceInfoRequest) GetAllianceId() int32 {
-	if x != nil {
-		return x.AllianceId
-	}
-	return 0
+// Deprecated: Use AllianceMarkListRequest.ProtoReflect.Descriptor instead.
+func (*AllianceMarkListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{86}
 }
 
-type AllianceInfoResponse struct {
+type AllianceMarkListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Alliance      *Alliance              `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	Marks         []*AllianceMark        `protobuf:"bytes,1,rep,name=marks,proto3" json:"marks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceInfoResponse) Reset() {
-	*x = AllianceInfoResponse{}
-	mi := &file_player_player_proto_msgTypes[29]
+func (x *AllianceMarkListResponse) Reset() {
+	*x = AllianceMarkListResponse{}
+	mi := &file_player_player_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceInfoResponse) String() string {
+func (x *AllianceMarkListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceInfoResponse) ProtoMessage() {}
+func (*AllianceMarkListResponse) ProtoMessage() {}
 
-func (x *AllianceInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[29]
+func (x *AllianceMarkListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,39 +6133,42 @@ func (x *AllianceInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceInfoResponse.ProtoReflect.Descriptor instead.
-func (*AllianceInfoResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AllianceMarkListResponse.ProtoReflect.Descriptor instead.
+func (*AllianceMarkListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *AllianceInfoResponse) GetAlliance() *Alliance {
+func (x *AllianceMarkListResponse) GetMarks() []*AllianceMark {
 	if x != nil {
-		return x.Alliance
+		return x.Marks
 	}
 	return nil
 }
 
-type AllianceApplyListRequest struct {
+// 路由 union.diplomacy，盟主提出外交变更，宣战立即生效，停战和互不侵犯需要对方盟主同意
+type DiplomacyProposeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      int32                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	State         DiplomacyState         `protobuf:"varint,2,opt,name=state,proto3,enum=three_kingdoms.player.DiplomacyState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceApplyListRequest) Reset() {
-	*x = AllianceApplyListRequest{}
-	mi := &file_player_player_proto_msgTypes[30]
+func (x *DiplomacyProposeRequest) Reset() {
+	*x = DiplomacyProposeRequest{}
+	mi := &file_player_player_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceApplyListRequest) String() string {
+func (x *DiplomacyProposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceApplyListRequest) ProtoMessage() {}
+func (*DiplomacyProposeRequest) ProtoMessage() {}
 
-func (x *AllianceApplyListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[30]
+func (x *DiplomacyProposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,33 +6179,48 @@ func (x *AllianceApplyListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceApplyListRequest.ProtoReflect.Descriptor instead.
-func (*AllianceApplyListRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DiplomacyProposeRequest.ProtoReflect.Descriptor instead.
+func (*DiplomacyProposeRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{88}
 }
 
-type AllianceApplyListResponse struct {
+func (x *DiplomacyProposeRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *DiplomacyProposeRequest) GetState() DiplomacyState {
+	if x != nil {
+		return x.State
+	}
+	return DiplomacyState_DIPLOMACY_NEUTRAL
+}
+
+type DiplomacyProposeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          []*ApplyItem           `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty"`
+	TargetId      int32                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	State         DiplomacyState         `protobuf:"varint,2,opt,name=state,proto3,enum=three_kingdoms.player.DiplomacyState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllianceApplyListResponse) Reset() {
-	*x = AllianceApplyListResponse{}
-	mi := &file_player_player_proto_msgTypes[31]
+func (x *DiplomacyProposeResponse) Reset() {
+	*x = DiplomacyProposeResponse{}
+	mi := &file_player_player_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllianceApplyListResponse) String() string {
+func (x *DiplomacyProposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllianceApplyListResponse) ProtoMessage() {}
+func (*DiplomacyProposeResponse) ProtoMessage() {}
 
-func (x *AllianceApplyListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[31]
+func (x *DiplomacyProposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2334,40 +6231,48 @@ func (x *AllianceApplyListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllianceApplyListResponse.ProtoReflect.Descriptor instead.
-func (*AllianceApplyListResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use DiplomacyProposeResponse.ProtoReflect.Descriptor instead.
+func (*DiplomacyProposeResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *AllianceApplyListResponse) GetItem() []*ApplyItem {
+func (x *DiplomacyProposeResponse) GetTargetId() int32 {
 	if x != nil {
-		return x.Item
+		return x.TargetId
 	}
-	return nil
+	return 0
 }
 
-type DrawGeneralRequest struct {
+func (x *DiplomacyProposeResponse) GetState() DiplomacyState {
+	if x != nil {
+		return x.State
+	}
+	return DiplomacyState_DIPLOMACY_NEUTRAL
+}
+
+// 路由 union.diplomacyAccept，同意对方的提议
+type DiplomacyAcceptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DrawTimes     int32                  `protobuf:"varint,1,opt,name=DrawTimes,proto3" json:"DrawTimes,omitempty"`
+	TargetId      int32                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DrawGeneralRequest) Reset() {
-	*x = DrawGeneralRequest{}
-	mi := &file_player_player_proto_msgTypes[32]
+func (x *DiplomacyAcceptRequest) Reset() {
+	*x = DiplomacyAcceptRequest{}
+	mi := &file_player_player_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DrawGeneralRequest) String() string {
+func (x *DiplomacyAcceptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DrawGeneralRequest) ProtoMessage() {}
+func (*DiplomacyAcceptRequest) ProtoMessage() {}
 
-func (x *DrawGeneralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[32]
+func (x *DiplomacyAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,40 +6283,40 @@ func (x *DrawGeneralRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DrawGeneralRequest.ProtoReflect.Descriptor instead.
-func (*DrawGeneralRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DiplomacyAcceptRequest.ProtoReflect.Descriptor instead.
+func (*DiplomacyAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *DrawGeneralRequest) GetDrawTimes() int32 {
+func (x *DiplomacyAcceptRequest) GetTargetId() int32 {
 	if x != nil {
-		return x.DrawTimes
+		return x.TargetId
 	}
 	return 0
 }
 
-type DrawGeneralResponse struct {
+type DiplomacyAcceptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Generals      []*General             `protobuf:"bytes,1,rep,name=generals,proto3" json:"generals,omitempty"`
+	TargetId      int32                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DrawGeneralResponse) Reset() {
-	*x = DrawGeneralResponse{}
-	mi := &file_player_player_proto_msgTypes[33]
+func (x *DiplomacyAcceptResponse) Reset() {
+	*x = DiplomacyAcceptResponse{}
+	mi := &file_player_player_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DrawGeneralResponse) String() string {
+func (x *DiplomacyAcceptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DrawGeneralResponse) ProtoMessage() {}
+func (*DiplomacyAcceptResponse) ProtoMessage() {}
 
-func (x *DrawGeneralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[33]
+func (x *DiplomacyAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2422,39 +6327,40 @@ func (x *DrawGeneralResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DrawGeneralResponse.ProtoReflect.Descriptor instead.
-func (*DrawGeneralResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DiplomacyAcceptResponse.ProtoReflect.Descriptor instead.
+func (*DiplomacyAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DrawGeneralResponse) GetGenerals() []*General {
+func (x *DiplomacyAcceptResponse) GetTargetId() int32 {
 	if x != nil {
-		return x.Generals
+		return x.TargetId
 	}
-	return nil
+	return 0
 }
 
-type FacilitiesRequest struct {
+// 路由 union.diplomacyList
+type DiplomacyListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FacilitiesRequest) Reset() {
-	*x = FacilitiesRequest{}
-	mi := &file_player_player_proto_msgTypes[34]
+func (x *DiplomacyListRequest) Reset() {
+	*x = DiplomacyListRequest{}
+	mi := &file_player_player_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FacilitiesRequest) String() string {
+func (x *DiplomacyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FacilitiesRequest) ProtoMessage() {}
+func (*DiplomacyListRequest) ProtoMessage() {}
 
-func (x *FacilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[34]
+func (x *DiplomacyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2465,34 +6371,33 @@ func (x *FacilitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FacilitiesRequest.ProtoReflect.Descriptor instead.
-func (*FacilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DiplomacyListRequest.ProtoReflect.Descriptor instead.
+func (*DiplomacyListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{92}
 }
 
-type FacilitiesResponse struct {
+type DiplomacyListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	Facilities    []*Facility            `protobuf:"bytes,2,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	Relations     []*Diplomacy           `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FacilitiesResponse) Reset() {
-	*x = FacilitiesResponse{}
-	mi := &file_player_player_proto_msgTypes[35]
+func (x *DiplomacyListResponse) Reset() {
+	*x = DiplomacyListResponse{}
+	mi := &file_player_player_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FacilitiesResponse) String() string {
+func (x *DiplomacyListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FacilitiesResponse) ProtoMessage() {}
+func (*DiplomacyListResponse) ProtoMessage() {}
 
-func (x *FacilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[35]
+func (x *DiplomacyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2503,48 +6408,42 @@ func (x *FacilitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FacilitiesResponse.ProtoReflect.Descriptor instead.
-func (*FacilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *FacilitiesResponse) GetCityId() int32 {
-	if x != nil {
-		return x.CityId
-	}
-	return 0
+// Deprecated: Use DiplomacyListResponse.ProtoReflect.Descriptor instead.
+func (*DiplomacyListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *FacilitiesResponse) GetFacilities() []*Facility {
+func (x *DiplomacyListResponse) GetRelations() []*Diplomacy {
 	if x != nil {
-		return x.Facilities
+		return x.Relations
 	}
 	return nil
 }
 
-type UpFacilityRequest struct {
+// 路由 union.rally，发起集结，需要标记目标的权限
+type RallyCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	FType         int32                  `protobuf:"varint,2,opt,name=f_type,json=fType,proto3" json:"f_type,omitempty"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpFacilityRequest) Reset() {
-	*x = UpFacilityRequest{}
-	mi := &file_player_player_proto_msgTypes[36]
+func (x *RallyCreateRequest) Reset() {
+	*x = RallyCreateRequest{}
+	mi := &file_player_player_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpFacilityRequest) String() string {
+func (x *RallyCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpFacilityRequest) ProtoMessage() {}
+func (*RallyCreateRequest) ProtoMessage() {}
 
-func (x *UpFacilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[36]
+func (x *RallyCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2555,49 +6454,47 @@ func (x *UpFacilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpFacilityRequest.ProtoReflect.Descriptor instead.
-func (*UpFacilityRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use RallyCreateRequest.ProtoReflect.Descriptor instead.
+func (*RallyCreateRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpFacilityRequest) GetCityId() int32 {
+func (x *RallyCreateRequest) GetX() int32 {
 	if x != nil {
-		return x.CityId
+		return x.X
 	}
 	return 0
 }
 
-func (x *UpFacilityRequest) GetFType() int32 {
+func (x *RallyCreateRequest) GetY() int32 {
 	if x != nil {
-		return x.FType
+		return x.Y
 	}
 	return 0
 }
 
-type UpFacilityResponse struct {
+type RallyCreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	Facility      *Facility              `protobuf:"bytes,2,opt,name=facility,proto3" json:"facility,omitempty"`
-	Resource      *Resource              `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Rally         *Rally                 `protobuf:"bytes,1,opt,name=rally,proto3" json:"rally,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpFacilityResponse) Reset() {
-	*x = UpFacilityResponse{}
-	mi := &file_player_player_proto_msgTypes[37]
+func (x *RallyCreateResponse) Reset() {
+	*x = RallyCreateResponse{}
+	mi := &file_player_player_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpFacilityResponse) String() string {
+func (x *RallyCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpFacilityResponse) ProtoMessage() {}
+func (*RallyCreateResponse) ProtoMessage() {}
 
-func (x *UpFacilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[37]
+func (x *RallyCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2608,55 +6505,42 @@ func (x *UpFacilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpFacilityResponse.ProtoReflect.Descriptor instead.
-func (*UpFacilityResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *UpFacilityResponse) GetCityId() int32 {
-	if x != nil {
-		return x.CityId
-	}
-	return 0
-}
-
-func (x *UpFacilityResponse) GetFacility() *Facility {
-	if x != nil {
-		return x.Facility
-	}
-	return nil
+// Deprecated: Use RallyCreateResponse.ProtoReflect.Descriptor instead.
+func (*RallyCreateResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UpFacilityResponse) GetResource() *Resource {
+func (x *RallyCreateResponse) GetRally() *Rally {
 	if x != nil {
-		return x.Resource
+		return x.Rally
 	}
 	return nil
 }
 
-type TransformRequest struct {
+// 路由 union.joinRally，派军队前往集结点
+type RallyJoinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          []int32                `protobuf:"varint,1,rep,packed,name=from,proto3" json:"from,omitempty"` //0 Wood 1 Iron 2 Stone 3 Grain
-	To            []int32                `protobuf:"varint,2,rep,packed,name=to,proto3" json:"to,omitempty"`     //0 Wood 1 Iron 2 Stone 3 Grain
+	RallyId       int32                  `protobuf:"varint,1,opt,name=rally_id,json=rallyId,proto3" json:"rally_id,omitempty"`
+	ArmyId        int32                  `protobuf:"varint,2,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransformRequest) Reset() {
-	*x = TransformRequest{}
-	mi := &file_player_player_proto_msgTypes[38]
+func (x *RallyJoinRequest) Reset() {
+	*x = RallyJoinRequest{}
+	mi := &file_player_player_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransformRequest) String() string {
+func (x *RallyJoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransformRequest) ProtoMessage() {}
+func (*RallyJoinRequest) ProtoMessage() {}
 
-func (x *TransformRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[38]
+func (x *RallyJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,46 +6551,47 @@ func (x *TransformRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransformRequest.ProtoReflect.Descriptor instead.
-func (*TransformRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RallyJoinRequest.ProtoReflect.Descriptor instead.
+func (*RallyJoinRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *TransformRequest) GetFrom() []int32 {
+func (x *RallyJoinRequest) GetRallyId() int32 {
 	if x != nil {
-		return x.From
+		return x.RallyId
 	}
-	return nil
+	return 0
 }
 
-func (x *TransformRequest) GetTo() []int32 {
+func (x *RallyJoinRequest) GetArmyId() int32 {
 	if x != nil {
-		return x.To
+		return x.ArmyId
 	}
-	return nil
+	return 0
 }
 
-type TransformResponse struct {
+type RallyJoinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransformResponse) Reset() {
-	*x = TransformResponse{}
-	mi := &file_player_player_proto_msgTypes[39]
+func (x *RallyJoinResponse) Reset() {
+	*x = RallyJoinResponse{}
+	mi := &file_player_player_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransformResponse) String() string {
+func (x *RallyJoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransformResponse) ProtoMessage() {}
+func (*RallyJoinResponse) ProtoMessage() {}
 
-func (x *TransformResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[39]
+func (x *RallyJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2717,37 +6602,40 @@ func (x *TransformResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransformResponse.ProtoReflect.Descriptor instead.
-func (*TransformResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RallyJoinResponse.ProtoReflect.Descriptor instead.
+func (*RallyJoinResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{97}
 }
 
-// 配置武将
-type DisposeRequest struct {
+func (x *RallyJoinResponse) GetArmy() *Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+// 路由 union.rallyList
+type RallyListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        int32                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	GeneralId     int32                  `protobuf:"varint,2,opt,name=general_id,json=generalId,proto3" json:"general_id,omitempty"`
-	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`       //第几队，1-5队
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` //位置，-1到2,-1是解除该武将上阵状态, 0 1 2 分别是三个武将槽位
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisposeRequest) Reset() {
-	*x = DisposeRequest{}
-	mi := &file_player_player_proto_msgTypes[40]
+func (x *RallyListRequest) Reset() {
+	*x = RallyListRequest{}
+	mi := &file_player_player_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisposeRequest) String() string {
+func (x *RallyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisposeRequest) ProtoMessage() {}
+func (*RallyListRequest) ProtoMessage() {}
 
-func (x *DisposeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[40]
+func (x *RallyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,64 +6643,36 @@ func (x *DisposeRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DisposeRequest.ProtoReflect.Descriptor instead.
-func (*DisposeRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *DisposeRequest) GetCityId() int32 {
-	if x != nil {
-		return x.CityId
-	}
-	return 0
-}
-
-func (x *DisposeRequest) GetGeneralId() int32 {
-	if x != nil {
-		return x.GeneralId
-	}
-	return 0
-}
-
-func (x *DisposeRequest) GetOrder() int32 {
-	if x != nil {
-		return x.Order
-	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DisposeRequest) GetPosition() int32 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
+// Deprecated: Use RallyListRequest.ProtoReflect.Descriptor instead.
+func (*RallyListRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{98}
 }
 
-type DisposeResponse struct {
+type RallyListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Army          *Army                  `protobuf:"bytes,1,opt,name=Army,proto3" json:"Army,omitempty"`
+	Rallies       []*Rally               `protobuf:"bytes,1,rep,name=rallies,proto3" json:"rallies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisposeResponse) Reset() {
-	*x = DisposeResponse{}
-	mi := &file_player_player_proto_msgTypes[41]
+func (x *RallyListResponse) Reset() {
+	*x = RallyListResponse{}
+	mi := &file_player_player_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisposeResponse) String() string {
+func (x *RallyListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisposeResponse) ProtoMessage() {}
+func (*RallyListResponse) ProtoMessage() {}
 
-func (x *DisposeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[41]
+func (x *RallyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2823,41 +6683,41 @@ func (x *DisposeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisposeResponse.ProtoReflect.Descriptor instead.
-func (*DisposeResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RallyListResponse.ProtoReflect.Descriptor instead.
+func (*RallyListResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DisposeResponse) GetArmy() *Army {
+func (x *RallyListResponse) GetRallies() []*Rally {
 	if x != nil {
-		return x.Army
+		return x.Rallies
 	}
 	return nil
 }
 
-type ConscriptRequest struct {
+// 网关在会话结束（断线超过重连窗口）时发送，记录登出时间
+type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArmyId        int32                  `protobuf:"varint,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"` //队伍 id
-	Counts        []int32                `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`        //征兵人数 主将、副将、副将：[20,20,0]
+	LogoutTime    int64                  `protobuf:"varint,1,opt,name=logout_time,json=logoutTime,proto3" json:"logout_time,omitempty"` // 毫秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConscriptRequest) Reset() {
-	*x = ConscriptRequest{}
-	mi := &file_player_player_proto_msgTypes[42]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_player_player_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConscriptRequest) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConscriptRequest) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *ConscriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[42]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,48 +6728,83 @@ func (x *ConscriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConscriptRequest.ProtoReflect.Descriptor instead.
-func (*ConscriptRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ConscriptRequest) GetArmyId() int32 {
+func (x *LogoutRequest) GetLogoutTime() int64 {
 	if x != nil {
-		return x.ArmyId
+		return x.LogoutTime
 	}
 	return 0
 }
 
-func (x *ConscriptRequest) GetCounts() []int32 {
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_player_player_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[101]
 	if x != nil {
-		return x.Counts
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type ConscriptResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
-	Resource      *Resource              `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{101}
+}
+
+// 网关运营接口转发的 GM 命令，不对客户端开放。operator 为操作人，只用于日志
+type AdminRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Operator string                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Types that are valid to be assigned to Cmd:
+	//
+	//	*AdminRequest_GrantResource
+	//	*AdminRequest_SetFacilityLevel
+	//	*AdminRequest_TeleportArmy
+	Cmd           isAdminRequest_Cmd `protobuf_oneof:"cmd"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConscriptResponse) Reset() {
-	*x = ConscriptResponse{}
-	mi := &file_player_player_proto_msgTypes[43]
+func (x *AdminRequest) Reset() {
+	*x = AdminRequest{}
+	mi := &file_player_player_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConscriptResponse) String() string {
+func (x *AdminRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConscriptResponse) ProtoMessage() {}
+func (*AdminRequest) ProtoMessage() {}
 
-func (x *ConscriptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[43]
+func (x *AdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,48 +6815,102 @@ func (x *ConscriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConscriptResponse.ProtoReflect.Descriptor instead.
-func (*ConscriptResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use AdminRequest.ProtoReflect.Descriptor instead.
+func (*AdminRequest) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ConscriptResponse) GetArmy() *Army {
+func (x *AdminRequest) GetOperator() string {
 	if x != nil {
-		return x.Army
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AdminRequest) GetCmd() isAdminRequest_Cmd {
+	if x != nil {
+		return x.Cmd
 	}
 	return nil
 }
 
-func (x *ConscriptResponse) GetResource() *Resource {
+func (x *AdminRequest) GetGrantResource() *AdminGrantResource {
 	if x != nil {
-		return x.Resource
+		if x, ok := x.Cmd.(*AdminRequest_GrantResource); ok {
+			return x.GrantResource
+		}
 	}
 	return nil
 }
 
-// 路由 army.myOne
-type ArmyInfoRequest struct {
+func (x *AdminRequest) GetSetFacilityLevel() *AdminSetFacilityLevel {
+	if x != nil {
+		if x, ok := x.Cmd.(*AdminRequest_SetFacilityLevel); ok {
+			return x.SetFacilityLevel
+		}
+	}
+	return nil
+}
+
+func (x *AdminRequest) GetTeleportArmy() *AdminTeleportArmy {
+	if x != nil {
+		if x, ok := x.Cmd.(*AdminRequest_TeleportArmy); ok {
+			return x.TeleportArmy
+		}
+	}
+	return nil
+}
+
+type isAdminRequest_Cmd interface {
+	isAdminRequest_Cmd()
+}
+
+type AdminRequest_GrantResource struct {
+	GrantResource *AdminGrantResource `protobuf:"bytes,2,opt,name=grant_resource,json=grantResource,proto3,oneof"`
+}
+
+type AdminRequest_SetFacilityLevel struct {
+	SetFacilityLevel *AdminSetFacilityLevel `protobuf:"bytes,3,opt,name=set_facility_level,json=setFacilityLevel,proto3,oneof"`
+}
+
+type AdminRequest_TeleportArmy struct {
+	TeleportArmy *AdminTeleportArmy `protobuf:"bytes,4,opt,name=teleport_army,json=teleportArmy,proto3,oneof"`
+}
+
+func (*AdminRequest_GrantResource) isAdminRequest_Cmd() {}
+
+func (*AdminRequest_SetFacilityLevel) isAdminRequest_Cmd() {}
+
+func (*AdminRequest_TeleportArmy) isAdminRequest_Cmd() {}
+
+// 发放资源，数量不能为负
+type AdminGrantResource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         int32                  `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	Wood          int32                  `protobuf:"varint,1,opt,name=wood,proto3" json:"wood,omitempty"`
+	Iron          int32                  `protobuf:"varint,2,opt,name=iron,proto3" json:"iron,omitempty"`
+	Stone         int32                  `protobuf:"varint,3,opt,name=stone,proto3" json:"stone,omitempty"`
+	Grain         int32                  `protobuf:"varint,4,opt,name=grain,proto3" json:"grain,omitempty"`
+	Gold          int32                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
+	Decree        int32                  `protobuf:"varint,6,opt,name=decree,proto3" json:"decree,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArmyInfoRequest) Reset() {
-	*x = ArmyInfoRequest{}
-	mi := &file_player_player_proto_msgTypes[44]
+func (x *AdminGrantResource) Reset() {
+	*x = AdminGrantResource{}
+	mi := &file_player_player_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArmyInfoRequest) String() string {
+func (x *AdminGrantResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArmyInfoRequest) ProtoMessage() {}
+func (*AdminGrantResource) ProtoMessage() {}
 
-func (x *ArmyInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[44]
+func (x *AdminGrantResource) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2972,40 +6921,78 @@ func (x *ArmyInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArmyInfoRequest.ProtoReflect.Descriptor instead.
-func (*ArmyInfoRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AdminGrantResource.ProtoReflect.Descriptor instead.
+func (*AdminGrantResource) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ArmyInfoRequest) GetOrder() int32 {
+func (x *AdminGrantResource) GetWood() int32 {
 	if x != nil {
-		return x.Order
+		return x.Wood
 	}
 	return 0
 }
 
-type ArmyInfoResponse struct {
+func (x *AdminGrantResource) GetIron() int32 {
+	if x != nil {
+		return x.Iron
+	}
+	return 0
+}
+
+func (x *AdminGrantResource) GetStone() int32 {
+	if x != nil {
+		return x.Stone
+	}
+	return 0
+}
+
+func (x *AdminGrantResource) GetGrain() int32 {
+	if x != nil {
+		return x.Grain
+	}
+	return 0
+}
+
+func (x *AdminGrantResource) GetGold() int32 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *AdminGrantResource) GetDecree() int32 {
+	if x != nil {
+		return x.Decree
+	}
+	return 0
+}
+
+// 直接设置设施等级，city_id 为 0 表示主城，正在升级的会被取消
+type AdminSetFacilityLevel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	CityId        int64                  `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArmyInfoResponse) Reset() {
-	*x = ArmyInfoResponse{}
-	mi := &file_player_player_proto_msgTypes[45]
+func (x *AdminSetFacilityLevel) Reset() {
+	*x = AdminSetFacilityLevel{}
+	mi := &file_player_player_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArmyInfoResponse) String() string {
+func (x *AdminSetFacilityLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArmyInfoResponse) ProtoMessage() {}
+func (*AdminSetFacilityLevel) ProtoMessage() {}
 
-func (x *ArmyInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[45]
+func (x *AdminSetFacilityLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3016,44 +7003,56 @@ func (x *ArmyInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArmyInfoResponse.ProtoReflect.Descriptor instead.
-func (*ArmyInfoResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AdminSetFacilityLevel.ProtoReflect.Descriptor instead.
+func (*AdminSetFacilityLevel) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ArmyInfoResponse) GetArmy() *Army {
+func (x *AdminSetFacilityLevel) GetCityId() int64 {
 	if x != nil {
-		return x.Army
+		return x.CityId
 	}
-	return nil
+	return 0
 }
 
-// 路由 army.assign
-type AssignArmyRequest struct {
+func (x *AdminSetFacilityLevel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AdminSetFacilityLevel) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// 把空闲的军队瞬移回它所属的城池，city_id 为 0 表示主城，不能送去其他城池
+type AdminTeleportArmy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArmyId        int32                  `protobuf:"varint,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"` //队伍 id
-	Cmd           int32                  `protobuf:"varint,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
-	X             int32                  `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
-	Y             int32                  `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+	ArmyId        int32                  `protobuf:"varint,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	CityId        int64                  `protobuf:"varint,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignArmyRequest) Reset() {
-	*x = AssignArmyRequest{}
-	mi := &file_player_player_proto_msgTypes[46]
+func (x *AdminTeleportArmy) Reset() {
+	*x = AdminTeleportArmy{}
+	mi := &file_player_player_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignArmyRequest) String() string {
+func (x *AdminTeleportArmy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignArmyRequest) ProtoMessage() {}
+func (*AdminTeleportArmy) ProtoMessage() {}
 
-func (x *AssignArmyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[46]
+func (x *AdminTeleportArmy) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3064,61 +7063,51 @@ func (x *AssignArmyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignArmyRequest.ProtoReflect.Descriptor instead.
-func (*AssignArmyRequest) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AdminTeleportArmy.ProtoReflect.Descriptor instead.
+func (*AdminTeleportArmy) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *AssignArmyRequest) GetArmyId() int32 {
+func (x *AdminTeleportArmy) GetArmyId() int32 {
 	if x != nil {
 		return x.ArmyId
 	}
 	return 0
 }
 
-func (x *AssignArmyRequest) GetCmd() int32 {
-	if x != nil {
-		return x.Cmd
-	}
-	return 0
-}
-
-func (x *AssignArmyRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *AssignArmyRequest) GetY() int32 {
+func (x *AdminTeleportArmy) GetCityId() int64 {
 	if x != nil {
-		return x.Y
+		return x.CityId
 	}
 	return 0
 }
 
-type AssignArmyResponse struct {
+// 按命令返回变化后的数据，其余字段为空
+type AdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Army          *Army                  `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	CityId        int64                  `protobuf:"varint,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Facility      *Facility              `protobuf:"bytes,3,opt,name=facility,proto3" json:"facility,omitempty"`
+	Army          *Army                  `protobuf:"bytes,4,opt,name=army,proto3" json:"army,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignArmyResponse) Reset() {
-	*x = AssignArmyResponse{}
-	mi := &file_player_player_proto_msgTypes[47]
+func (x *AdminResponse) Reset() {
+	*x = AdminResponse{}
+	mi := &file_player_player_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignArmyResponse) String() string {
+func (x *AdminResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignArmyResponse) ProtoMessage() {}
+func (*AdminResponse) ProtoMessage() {}
 
-func (x *AssignArmyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_player_player_proto_msgTypes[47]
+func (x *AdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_player_player_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3129,12 +7118,33 @@ func (x *AssignArmyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignArmyResponse.ProtoReflect.Descriptor instead.
-func (*AssignArmyResponse) Descriptor() ([]byte, []int) {
-	return file_player_player_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AdminResponse.ProtoReflect.Descriptor instead.
+func (*AdminResponse) Descriptor() ([]byte, []int) {
+	return file_player_player_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *AssignArmyResponse) GetArmy() *Army {
+func (x *AdminResponse) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *AdminResponse) GetCityId() int64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *AdminResponse) GetFacility() *Facility {
+	if x != nil {
+		return x.Facility
+	}
+	return nil
+}
+
+func (x *AdminResponse) GetArmy() *Army {
 	if x != nil {
 		return x.Army
 	}
@@ -3145,7 +7155,7 @@ var File_player_player_proto protoreflect.FileDescriptor
 
 const file_player_player_proto_rawDesc = "" +
 	"\n" +
-	"\x13player/player.proto\x12\x15three_kingdoms.player\x1a\x13common/common.proto\x1a\x11player/role.proto\x1a\x15player/resource.proto\x1a\x15player/building.proto\x1a\x10player/arm.proto\x1a\x14player/general.proto\x1a\x11player/city.proto\x1a\x14player/pos_tag.proto\x1a\x17player/war_report.proto\x1a\x12player/skill.proto\x1a\x15player/alliance.proto\x1a\x15player/facility.proto\"\xc8\x11\n" +
+	"\x13player/player.proto\x12\x15three_kingdoms.player\x1a\x13common/common.proto\x1a\x11player/role.proto\x1a\x15player/resource.proto\x1a\x15player/building.proto\x1a\x10player/arm.proto\x1a\x14player/general.proto\x1a\x11player/city.proto\x1a\x14player/pos_tag.proto\x1a\x17player/war_report.proto\x1a\x12player/skill.proto\x1a\x15player/alliance.proto\x1a\x15player/facility.proto\"\xc5&\n" +
 	"\rPlayerRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x19\n" +
 	"\bworld_id\x18\x02 \x01(\x03R\aworldId\x12\x1f\n" +
@@ -3175,9 +7185,37 @@ const file_player_player_proto_rawDesc = "" +
 	"\x0edisposeRequest\x18\x1d \x01(\v2%.three_kingdoms.player.DisposeRequestH\x00R\x0edisposeRequest\x12U\n" +
 	"\x10ConscriptRequest\x18\x1e \x01(\v2'.three_kingdoms.player.ConscriptRequestH\x00R\x10ConscriptRequest\x12R\n" +
 	"\x0farmyInfoRequest\x18\x1f \x01(\v2&.three_kingdoms.player.ArmyInfoRequestH\x00R\x0farmyInfoRequest\x12X\n" +
-	"\x11assignArmyRequest\x18  \x01(\v2(.three_kingdoms.player.AssignArmyRequestH\x00R\x11assignArmyRequest\x12\x19\n" +
+	"\x11assignArmyRequest\x18  \x01(\v2(.three_kingdoms.player.AssignArmyRequestH\x00R\x11assignArmyRequest\x12L\n" +
+	"\rgiveUpRequest\x18! \x01(\v2$.three_kingdoms.player.GiveUpRequestH\x00R\rgiveUpRequest\x12U\n" +
+	"\x10posTagAddRequest\x18\" \x01(\v2'.three_kingdoms.player.PosTagAddRequestH\x00R\x10posTagAddRequest\x12^\n" +
+	"\x13posTagRenameRequest\x18# \x01(\v2*.three_kingdoms.player.PosTagRenameRequestH\x00R\x13posTagRenameRequest\x12U\n" +
+	"\x10posTagDelRequest\x18$ \x01(\v2'.three_kingdoms.player.PosTagDelRequestH\x00R\x10posTagDelRequest\x12a\n" +
+	"\x14createSubCityRequest\x18% \x01(\v2+.three_kingdoms.player.CreateSubCityRequestH\x00R\x14createSubCityRequest\x12R\n" +
+	"\x0fmoveCityRequest\x18& \x01(\v2&.three_kingdoms.player.MoveCityRequestH\x00R\x0fmoveCityRequest\x12d\n" +
+	"\x15allianceCreateRequest\x18' \x01(\v2,.three_kingdoms.player.AllianceCreateRequestH\x00R\x15allianceCreateRequest\x12^\n" +
+	"\x13allianceJoinRequest\x18( \x01(\v2*.three_kingdoms.player.AllianceJoinRequestH\x00R\x13allianceJoinRequest\x12d\n" +
+	"\x15allianceVerifyRequest\x18) \x01(\v2,.three_kingdoms.player.AllianceVerifyRequestH\x00R\x15allianceVerifyRequest\x12^\n" +
+	"\x13allianceExitRequest\x18* \x01(\v2*.three_kingdoms.player.AllianceExitRequestH\x00R\x13allianceExitRequest\x12^\n" +
+	"\x13allianceKickRequest\x18+ \x01(\v2*.three_kingdoms.player.AllianceKickRequestH\x00R\x13allianceKickRequest\x12g\n" +
+	"\x16allianceDismissRequest\x18, \x01(\v2-.three_kingdoms.player.AllianceDismissRequestH\x00R\x16allianceDismissRequest\x12g\n" +
+	"\x16allianceAppointRequest\x18- \x01(\v2-.three_kingdoms.player.AllianceAppointRequestH\x00R\x16allianceAppointRequest\x12j\n" +
+	"\x17allianceTransferRequest\x18. \x01(\v2..three_kingdoms.player.AllianceTransferRequestH\x00R\x17allianceTransferRequest\x12d\n" +
+	"\x15allianceNoticeRequest\x18/ \x01(\v2,.three_kingdoms.player.AllianceNoticeRequestH\x00R\x15allianceNoticeRequest\x12[\n" +
+	"\x12allianceLogRequest\x180 \x01(\v2).three_kingdoms.player.AllianceLogRequestH\x00R\x12allianceLogRequest\x12[\n" +
+	"\x12rallyCreateRequest\x181 \x01(\v2).three_kingdoms.player.RallyCreateRequestH\x00R\x12rallyCreateRequest\x12U\n" +
+	"\x10rallyJoinRequest\x182 \x01(\v2'.three_kingdoms.player.RallyJoinRequestH\x00R\x10rallyJoinRequest\x12U\n" +
+	"\x10rallyListRequest\x183 \x01(\v2'.three_kingdoms.player.RallyListRequestH\x00R\x10rallyListRequest\x12a\n" +
+	"\x14reinforceBackRequest\x184 \x01(\v2+.three_kingdoms.player.ReinforceBackRequestH\x00R\x14reinforceBackRequest\x12g\n" +
+	"\x16allianceMarkAddRequest\x185 \x01(\v2-.three_kingdoms.player.AllianceMarkAddRequestH\x00R\x16allianceMarkAddRequest\x12g\n" +
+	"\x16allianceMarkDelRequest\x186 \x01(\v2-.three_kingdoms.player.AllianceMarkDelRequestH\x00R\x16allianceMarkDelRequest\x12j\n" +
+	"\x17allianceMarkListRequest\x187 \x01(\v2..three_kingdoms.player.AllianceMarkListRequestH\x00R\x17allianceMarkListRequest\x12j\n" +
+	"\x17diplomacyProposeRequest\x188 \x01(\v2..three_kingdoms.player.DiplomacyProposeRequestH\x00R\x17diplomacyProposeRequest\x12g\n" +
+	"\x16diplomacyAcceptRequest\x189 \x01(\v2-.three_kingdoms.player.DiplomacyAcceptRequestH\x00R\x16diplomacyAcceptRequest\x12a\n" +
+	"\x14diplomacyListRequest\x18: \x01(\v2+.three_kingdoms.player.DiplomacyListRequestH\x00R\x14diplomacyListRequest\x12L\n" +
+	"\rlogoutRequest\x18; \x01(\v2$.three_kingdoms.player.LogoutRequestH\x00R\rlogoutRequest\x12I\n" +
+	"\fadminRequest\x18< \x01(\v2#.three_kingdoms.player.AdminRequestH\x00R\fadminRequest\x12\x19\n" +
 	"\btrace_id\x18d \x01(\tR\atraceIdB\x06\n" +
-	"\x04body\"\xc2\x11\n" +
+	"\x04body\"\x93'\n" +
 	"\x0ePlayerResponse\x128\n" +
 	"\x06result\x18\x01 \x01(\v2 .three_kingdoms.common.BizResultR\x06result\x12^\n" +
 	"\x13enterServerResponse\x18\n" +
@@ -3203,7 +7241,35 @@ const file_player_player_proto_rawDesc = "" +
 	"\x0fdisposeResponse\x18\x1d \x01(\v2&.three_kingdoms.player.DisposeResponseH\x00R\x0fdisposeResponse\x12X\n" +
 	"\x11ConscriptResponse\x18\x1e \x01(\v2(.three_kingdoms.player.ConscriptResponseH\x00R\x11ConscriptResponse\x12U\n" +
 	"\x10armyInfoResponse\x18\x1f \x01(\v2'.three_kingdoms.player.ArmyInfoResponseH\x00R\x10armyInfoResponse\x12[\n" +
-	"\x12assignArmyResponse\x18  \x01(\v2).three_kingdoms.player.AssignArmyResponseH\x00R\x12assignArmyResponseB\x06\n" +
+	"\x12assignArmyResponse\x18  \x01(\v2).three_kingdoms.player.AssignArmyResponseH\x00R\x12assignArmyResponse\x12O\n" +
+	"\x0egiveUpResponse\x18! \x01(\v2%.three_kingdoms.player.GiveUpResponseH\x00R\x0egiveUpResponse\x12X\n" +
+	"\x11posTagAddResponse\x18\" \x01(\v2(.three_kingdoms.player.PosTagAddResponseH\x00R\x11posTagAddResponse\x12a\n" +
+	"\x14posTagRenameResponse\x18# \x01(\v2+.three_kingdoms.player.PosTagRenameResponseH\x00R\x14posTagRenameResponse\x12X\n" +
+	"\x11posTagDelResponse\x18$ \x01(\v2(.three_kingdoms.player.PosTagDelResponseH\x00R\x11posTagDelResponse\x12d\n" +
+	"\x15createSubCityResponse\x18% \x01(\v2,.three_kingdoms.player.CreateSubCityResponseH\x00R\x15createSubCityResponse\x12U\n" +
+	"\x10moveCityResponse\x18& \x01(\v2'.three_kingdoms.player.MoveCityResponseH\x00R\x10moveCityResponse\x12g\n" +
+	"\x16allianceCreateResponse\x18' \x01(\v2-.three_kingdoms.player.AllianceCreateResponseH\x00R\x16allianceCreateResponse\x12a\n" +
+	"\x14allianceJoinResponse\x18( \x01(\v2+.three_kingdoms.player.AllianceJoinResponseH\x00R\x14allianceJoinResponse\x12g\n" +
+	"\x16allianceVerifyResponse\x18) \x01(\v2-.three_kingdoms.player.AllianceVerifyResponseH\x00R\x16allianceVerifyResponse\x12a\n" +
+	"\x14allianceExitResponse\x18* \x01(\v2+.three_kingdoms.player.AllianceExitResponseH\x00R\x14allianceExitResponse\x12a\n" +
+	"\x14allianceKickResponse\x18+ \x01(\v2+.three_kingdoms.player.AllianceKickResponseH\x00R\x14allianceKickResponse\x12j\n" +
+	"\x17allianceDismissResponse\x18, \x01(\v2..three_kingdoms.player.AllianceDismissResponseH\x00R\x17allianceDismissResponse\x12j\n" +
+	"\x17allianceAppointResponse\x18- \x01(\v2..three_kingdoms.player.AllianceAppointResponseH\x00R\x17allianceAppointResponse\x12m\n" +
+	"\x18allianceTransferResponse\x18. \x01(\v2/.three_kingdoms.player.AllianceTransferResponseH\x00R\x18allianceTransferResponse\x12g\n" +
+	"\x16allianceNoticeResponse\x18/ \x01(\v2-.three_kingdoms.player.AllianceNoticeResponseH\x00R\x16allianceNoticeResponse\x12^\n" +
+	"\x13allianceLogResponse\x180 \x01(\v2*.three_kingdoms.player.AllianceLogResponseH\x00R\x13allianceLogResponse\x12^\n" +
+	"\x13rallyCreateResponse\x181 \x01(\v2*.three_kingdoms.player.RallyCreateResponseH\x00R\x13rallyCreateResponse\x12X\n" +
+	"\x11rallyJoinResponse\x182 \x01(\v2(.three_kingdoms.player.RallyJoinResponseH\x00R\x11rallyJoinResponse\x12X\n" +
+	"\x11rallyListResponse\x183 \x01(\v2(.three_kingdoms.player.RallyListResponseH\x00R\x11rallyListResponse\x12d\n" +
+	"\x15reinforceBackResponse\x184 \x01(\v2,.three_kingdoms.player.ReinforceBackResponseH\x00R\x15reinforceBackResponse\x12j\n" +
+	"\x17allianceMarkAddResponse\x185 \x01(\v2..three_kingdoms.player.AllianceMarkAddResponseH\x00R\x17allianceMarkAddResponse\x12j\n" +
+	"\x17allianceMarkDelResponse\x186 \x01(\v2..three_kingdoms.player.AllianceMarkDelResponseH\x00R\x17allianceMarkDelResponse\x12m\n" +
+	"\x18allianceMarkListResponse\x187 \x01(\v2/.three_kingdoms.player.AllianceMarkListResponseH\x00R\x18allianceMarkListResponse\x12m\n" +
+	"\x18diplomacyProposeResponse\x188 \x01(\v2/.three_kingdoms.player.DiplomacyProposeResponseH\x00R\x18diplomacyProposeResponse\x12j\n" +
+	"\x17diplomacyAcceptResponse\x189 \x01(\v2..three_kingdoms.player.DiplomacyAcceptResponseH\x00R\x17diplomacyAcceptResponse\x12d\n" +
+	"\x15diplomacyListResponse\x18: \x01(\v2,.three_kingdoms.player.DiplomacyListResponseH\x00R\x15diplomacyListResponse\x12O\n" +
+	"\x0elogoutResponse\x18; \x01(\v2%.three_kingdoms.player.LogoutResponseH\x00R\x0elogoutResponse\x12L\n" +
+	"\radminResponse\x18< \x01(\v2$.three_kingdoms.player.AdminResponseH\x00R\radminResponseB\x06\n" +
 	"\x04body\"1\n" +
 	"\x12EnterServerRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\"\xa1\x01\n" +
@@ -3228,16 +7294,34 @@ const file_player_player_proto_rawDesc = "" +
 	"\x14BuildingConfResponse\x126\n" +
 	"\x04cfgs\x18\x01 \x03(\v2\".three_kingdoms.player.BuildingCfgR\x04cfgs\"0\n" +
 	"\x11MyPropertyRequest\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\"\xa0\x02\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\"\xd9\x02\n" +
 	"\x12MyPropertyResponse\x12%\n" +
 	"\bresource\x18\x01 \x01(\v2\t.ResourceR\bresource\x12=\n" +
 	"\tbuildings\x18\x02 \x03(\v2\x1f.three_kingdoms.player.BuildingR\tbuildings\x12:\n" +
 	"\bgenerals\x18\x03 \x03(\v2\x1e.three_kingdoms.player.GeneralR\bgenerals\x123\n" +
 	"\x06cities\x18\x04 \x03(\v2\x1b.three_kingdoms.player.CityR\x06cities\x123\n" +
-	"\x06armies\x18\x05 \x03(\v2\x1b.three_kingdoms.player.ArmyR\x06armies\"0\n" +
+	"\x06armies\x18\x05 \x03(\v2\x1b.three_kingdoms.player.ArmyR\x06armies\x127\n" +
+	"\aposTags\x18\x06 \x03(\v2\x1d.three_kingdoms.player.PosTagR\aposTags\"0\n" +
 	"\x11PosTagListRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\"M\n" +
 	"\x12PosTagListResponse\x127\n" +
+	"\aposTags\x18\x01 \x03(\v2\x1d.three_kingdoms.player.PosTagR\aposTags\"B\n" +
+	"\x10PosTagAddRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"L\n" +
+	"\x11PosTagAddResponse\x127\n" +
+	"\aposTags\x18\x01 \x03(\v2\x1d.three_kingdoms.player.PosTagR\aposTags\"E\n" +
+	"\x13PosTagRenameRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"O\n" +
+	"\x14PosTagRenameResponse\x127\n" +
+	"\aposTags\x18\x01 \x03(\v2\x1d.three_kingdoms.player.PosTagR\aposTags\".\n" +
+	"\x10PosTagDelRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"L\n" +
+	"\x11PosTagDelResponse\x127\n" +
 	"\aposTags\x18\x01 \x03(\v2\x1d.three_kingdoms.player.PosTagR\aposTags\"\x13\n" +
 	"\x11MyGeneralsRequest\"P\n" +
 	"\x12MyGeneralsResponse\x12:\n" +
@@ -3273,10 +7357,19 @@ const file_player_player_proto_rawDesc = "" +
 	"\x04gold\x18\x01 \x01(\x05R\x04gold\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
 	"\bcurTimes\x18\x03 \x01(\x05R\bcurTimes\x12\x1a\n" +
-	"\bnextTime\x18\x04 \x01(\x03R\bnextTime\"\x15\n" +
-	"\x13AllianceListRequest\"K\n" +
+	"\bnextTime\x18\x04 \x01(\x03R\bnextTime\"\xc7\x01\n" +
+	"\x13AllianceListRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\bR\x06prefix\x127\n" +
+	"\x04sort\x18\x03 \x01(\x0e2#.three_kingdoms.player.AllianceSortR\x04sort\x12\x19\n" +
+	"\bhas_free\x18\x04 \x01(\bR\ahasFree\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\"\x82\x01\n" +
 	"\x14AllianceListResponse\x123\n" +
-	"\x04list\x18\x01 \x03(\v2\x1f.three_kingdoms.player.AllianceR\x04list\"5\n" +
+	"\x04list\x18\x01 \x03(\v2\x1f.three_kingdoms.player.AllianceR\x04list\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"5\n" +
 	"\x13AllianceInfoRequest\x12\x1e\n" +
 	"\n" +
 	"allianceId\x18\x01 \x01(\x05R\n" +
@@ -3289,8 +7382,9 @@ const file_player_player_proto_rawDesc = "" +
 	"\x12DrawGeneralRequest\x12\x1c\n" +
 	"\tDrawTimes\x18\x01 \x01(\x05R\tDrawTimes\"Q\n" +
 	"\x13DrawGeneralResponse\x12:\n" +
-	"\bgenerals\x18\x01 \x03(\v2\x1e.three_kingdoms.player.GeneralR\bgenerals\"\x13\n" +
-	"\x11FacilitiesRequest\"n\n" +
+	"\bgenerals\x18\x01 \x03(\v2\x1e.three_kingdoms.player.GeneralR\bgenerals\",\n" +
+	"\x11FacilitiesRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x05R\x06cityId\"n\n" +
 	"\x12FacilitiesResponse\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12?\n" +
 	"\n" +
@@ -3305,8 +7399,15 @@ const file_player_player_proto_rawDesc = "" +
 	"\bresource\x18\x03 \x01(\v2\t.ResourceR\bresource\"6\n" +
 	"\x10TransformRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x03(\x05R\x04from\x12\x0e\n" +
-	"\x02to\x18\x02 \x03(\x05R\x02to\"\x13\n" +
-	"\x11TransformResponse\"z\n" +
+	"\x02to\x18\x02 \x03(\x05R\x02to\"\xa6\x01\n" +
+	"\x11TransformResponse\x12%\n" +
+	"\bresource\x18\x01 \x01(\v2\t.ResourceR\bresource\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\x05R\x04rate\x12\x16\n" +
+	"\x06prices\x18\x03 \x03(\x05R\x06prices\x12\x1d\n" +
+	"\n" +
+	"daily_used\x18\x04 \x01(\x05R\tdailyUsed\x12\x1f\n" +
+	"\vdaily_limit\x18\x05 \x01(\x05R\n" +
+	"dailyLimit\"z\n" +
 	"\x0eDisposeRequest\x12\x17\n" +
 	"\acity_id\x18\x01 \x01(\x05R\x06cityId\x12\x1d\n" +
 	"\n" +
@@ -3320,9 +7421,10 @@ const file_player_player_proto_rawDesc = "" +
 	"\x06counts\x18\x02 \x03(\x05R\x06counts\"k\n" +
 	"\x11ConscriptResponse\x12/\n" +
 	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\x12%\n" +
-	"\bresource\x18\x02 \x01(\v2\t.ResourceR\bresource\"'\n" +
+	"\bresource\x18\x02 \x01(\v2\t.ResourceR\bresource\"@\n" +
 	"\x0fArmyInfoRequest\x12\x14\n" +
-	"\x05order\x18\x01 \x01(\x05R\x05order\"C\n" +
+	"\x05order\x18\x01 \x01(\x05R\x05order\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\x05R\x06cityId\"C\n" +
 	"\x10ArmyInfoResponse\x12/\n" +
 	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\"Z\n" +
 	"\x11AssignArmyRequest\x12\x17\n" +
@@ -3331,7 +7433,153 @@ const file_player_player_proto_rawDesc = "" +
 	"\x01x\x18\x03 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x04 \x01(\x05R\x01y\"E\n" +
 	"\x12AssignArmyResponse\x12/\n" +
-	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army2f\n" +
+	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\"h\n" +
+	"\x14ReinforceBackRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\aarmy_id\x18\x04 \x01(\x05R\x06armyId\"i\n" +
+	"\x15ReinforceBackResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\aarmy_id\x18\x04 \x01(\x05R\x06armyId\"+\n" +
+	"\rGiveUpRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"u\n" +
+	"\x0eGiveUpResponse\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12 \n" +
+	"\fgive_up_time\x18\x03 \x01(\x03R\n" +
+	"giveUpTime\x12%\n" +
+	"\bresource\x18\x04 \x01(\v2\t.ResourceR\bresource\"F\n" +
+	"\x14CreateSubCityRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"o\n" +
+	"\x15CreateSubCityResponse\x12/\n" +
+	"\x04city\x18\x01 \x01(\v2\x1b.three_kingdoms.player.CityR\x04city\x12%\n" +
+	"\bresource\x18\x02 \x01(\v2\t.ResourceR\bresource\"-\n" +
+	"\x0fMoveCityRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"\x90\x01\n" +
+	"\x10MoveCityResponse\x12/\n" +
+	"\x04city\x18\x01 \x01(\v2\x1b.three_kingdoms.player.CityR\x04city\x12%\n" +
+	"\bresource\x18\x02 \x01(\v2\t.ResourceR\bresource\x12$\n" +
+	"\x0enext_move_time\x18\x03 \x01(\x03R\fnextMoveTime\"+\n" +
+	"\x15AllianceCreateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"|\n" +
+	"\x16AllianceCreateResponse\x12;\n" +
+	"\balliance\x18\x01 \x01(\v2\x1f.three_kingdoms.player.AllianceR\balliance\x12%\n" +
+	"\bresource\x18\x02 \x01(\v2\t.ResourceR\bresource\"5\n" +
+	"\x13AllianceJoinRequest\x12\x1e\n" +
+	"\n" +
+	"allianceId\x18\x01 \x01(\x05R\n" +
+	"allianceId\"\x16\n" +
+	"\x14AllianceJoinResponse\"w\n" +
+	"\x15AllianceVerifyRequest\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\x12B\n" +
+	"\x06decide\x18\x02 \x01(\x0e2*.three_kingdoms.player.AllianceApplyStatusR\x06decide\"x\n" +
+	"\x16AllianceVerifyResponse\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\x12B\n" +
+	"\x06decide\x18\x02 \x01(\x0e2*.three_kingdoms.player.AllianceApplyStatusR\x06decide\"\x15\n" +
+	"\x13AllianceExitRequest\"\x16\n" +
+	"\x14AllianceExitResponse\"1\n" +
+	"\x13AllianceKickRequest\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\"2\n" +
+	"\x14AllianceKickResponse\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\"\x18\n" +
+	"\x16AllianceDismissRequest\"\x19\n" +
+	"\x17AllianceDismissResponse\"p\n" +
+	"\x16AllianceAppointRequest\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\x12:\n" +
+	"\x05title\x18\x02 \x01(\x0e2$.three_kingdoms.player.AllianceTitleR\x05title\"q\n" +
+	"\x17AllianceAppointResponse\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\x12:\n" +
+	"\x05title\x18\x02 \x01(\x0e2$.three_kingdoms.player.AllianceTitleR\x05title\"5\n" +
+	"\x17AllianceTransferRequest\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\"6\n" +
+	"\x18AllianceTransferResponse\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\x05R\bplayerId\"+\n" +
+	"\x15AllianceNoticeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\",\n" +
+	"\x16AllianceNoticeResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"<\n" +
+	"\x12AllianceLogRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"c\n" +
+	"\x13AllianceLogResponse\x126\n" +
+	"\x04logs\x18\x01 \x03(\v2\".three_kingdoms.player.AllianceLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9f\x01\n" +
+	"\x16AllianceMarkAddRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12;\n" +
+	"\x04kind\x18\x03 \x01(\x0e2'.three_kingdoms.player.AllianceMarkKindR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x16\n" +
+	"\x06expire\x18\x05 \x01(\x05R\x06expire\"\x19\n" +
+	"\x17AllianceMarkAddResponse\"(\n" +
+	"\x16AllianceMarkDelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\")\n" +
+	"\x17AllianceMarkDelResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17AllianceMarkListRequest\"U\n" +
+	"\x18AllianceMarkListResponse\x129\n" +
+	"\x05marks\x18\x01 \x03(\v2#.three_kingdoms.player.AllianceMarkR\x05marks\"s\n" +
+	"\x17DiplomacyProposeRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x05R\btargetId\x12;\n" +
+	"\x05state\x18\x02 \x01(\x0e2%.three_kingdoms.player.DiplomacyStateR\x05state\"t\n" +
+	"\x18DiplomacyProposeResponse\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x05R\btargetId\x12;\n" +
+	"\x05state\x18\x02 \x01(\x0e2%.three_kingdoms.player.DiplomacyStateR\x05state\"5\n" +
+	"\x16DiplomacyAcceptRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x05R\btargetId\"6\n" +
+	"\x17DiplomacyAcceptResponse\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x05R\btargetId\"\x16\n" +
+	"\x14DiplomacyListRequest\"W\n" +
+	"\x15DiplomacyListResponse\x12>\n" +
+	"\trelations\x18\x01 \x03(\v2 .three_kingdoms.player.DiplomacyR\trelations\"0\n" +
+	"\x12RallyCreateRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"I\n" +
+	"\x13RallyCreateResponse\x122\n" +
+	"\x05rally\x18\x01 \x01(\v2\x1c.three_kingdoms.player.RallyR\x05rally\"F\n" +
+	"\x10RallyJoinRequest\x12\x19\n" +
+	"\brally_id\x18\x01 \x01(\x05R\arallyId\x12\x17\n" +
+	"\aarmy_id\x18\x02 \x01(\x05R\x06armyId\"D\n" +
+	"\x11RallyJoinResponse\x12/\n" +
+	"\x04army\x18\x01 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army\"\x12\n" +
+	"\x10RallyListRequest\"K\n" +
+	"\x11RallyListResponse\x126\n" +
+	"\arallies\x18\x01 \x03(\v2\x1c.three_kingdoms.player.RallyR\arallies\"0\n" +
+	"\rLogoutRequest\x12\x1f\n" +
+	"\vlogout_time\x18\x01 \x01(\x03R\n" +
+	"logoutTime\"\x10\n" +
+	"\x0eLogoutResponse\"\xb4\x02\n" +
+	"\fAdminRequest\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12R\n" +
+	"\x0egrant_resource\x18\x02 \x01(\v2).three_kingdoms.player.AdminGrantResourceH\x00R\rgrantResource\x12\\\n" +
+	"\x12set_facility_level\x18\x03 \x01(\v2,.three_kingdoms.player.AdminSetFacilityLevelH\x00R\x10setFacilityLevel\x12O\n" +
+	"\rteleport_army\x18\x04 \x01(\v2(.three_kingdoms.player.AdminTeleportArmyH\x00R\fteleportArmyB\x05\n" +
+	"\x03cmd\"\x94\x01\n" +
+	"\x12AdminGrantResource\x12\x12\n" +
+	"\x04wood\x18\x01 \x01(\x05R\x04wood\x12\x12\n" +
+	"\x04iron\x18\x02 \x01(\x05R\x04iron\x12\x14\n" +
+	"\x05stone\x18\x03 \x01(\x05R\x05stone\x12\x14\n" +
+	"\x05grain\x18\x04 \x01(\x05R\x05grain\x12\x12\n" +
+	"\x04gold\x18\x05 \x01(\x05R\x04gold\x12\x16\n" +
+	"\x06decree\x18\x06 \x01(\x05R\x06decree\"Z\n" +
+	"\x15AdminSetFacilityLevel\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\x03R\x06cityId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\x05R\x04type\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"E\n" +
+	"\x11AdminTeleportArmy\x12\x17\n" +
+	"\aarmy_id\x18\x01 \x01(\x05R\x06armyId\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\x03R\x06cityId\"\xbd\x01\n" +
+	"\rAdminResponse\x12%\n" +
+	"\bresource\x18\x01 \x01(\v2\t.ResourceR\bresource\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\x03R\x06cityId\x12;\n" +
+	"\bfacility\x18\x03 \x01(\v2\x1f.three_kingdoms.player.FacilityR\bfacility\x12/\n" +
+	"\x04army\x18\x04 \x01(\v2\x1b.three_kingdoms.player.ArmyR\x04army2f\n" +
 	"\rPlayerService\x12U\n" +
 	"\x06Handle\x12$.three_kingdoms.player.PlayerRequest\x1a%.three_kingdoms.player.PlayerResponseB3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
 
@@ -3347,7 +7595,7 @@ func file_player_player_proto_rawDescGZIP() []byte {
 	return file_player_player_proto_rawDescData
 }
 
-var file_player_player_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_player_player_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
 var file_player_player_proto_goTypes = []any{
 	(*PlayerRequest)(nil),             // 0: three_kingdoms.player.PlayerRequest
 	(*PlayerResponse)(nil),            // 1: three_kingdoms.player.PlayerResponse
@@ -3361,141 +7609,297 @@ var file_player_player_proto_goTypes = []any{
 	(*MyPropertyResponse)(nil),        // 9: three_kingdoms.player.MyPropertyResponse
 	(*PosTagListRequest)(nil),         // 10: three_kingdoms.player.PosTagListRequest
 	(*PosTagListResponse)(nil),        // 11: three_kingdoms.player.PosTagListResponse
-	(*MyGeneralsRequest)(nil),         // 12: three_kingdoms.player.MyGeneralsRequest
-	(*MyGeneralsResponse)(nil),        // 13: three_kingdoms.player.MyGeneralsResponse
-	(*ArmyListRequest)(nil),           // 14: three_kingdoms.player.ArmyListRequest
-	(*ArmyListResponse)(nil),          // 15: three_kingdoms.player.ArmyListResponse
-	(*WarReportRequest)(nil),          // 16: three_kingdoms.player.WarReportRequest
-	(*WarReportResponse)(nil),         // 17: three_kingdoms.player.WarReportResponse
-	(*SkillListRequest)(nil),          // 18: three_kingdoms.player.SkillListRequest
-	(*SkillListResponse)(nil),         // 19: three_kingdoms.player.SkillListResponse
-	(*ScanBlockRequest)(nil),          // 20: three_kingdoms.player.ScanBlockRequest
-	(*ScanBlockResponse)(nil),         // 21: three_kingdoms.player.ScanBlockResponse
-	(*OpenCollectionRequest)(nil),     // 22: three_kingdoms.player.OpenCollectionRequest
-	(*OpenCollectionResponse)(nil),    // 23: three_kingdoms.player.OpenCollectionResponse
-	(*CollectionRequest)(nil),         // 24: three_kingdoms.player.CollectionRequest
-	(*CollectionResponse)(nil),        // 25: three_kingdoms.player.CollectionResponse
-	(*AllianceListRequest)(nil),       // 26: three_kingdoms.player.AllianceListRequest
-	(*AllianceListResponse)(nil),      // 27: three_kingdoms.player.AllianceListResponse
-	(*AllianceInfoRequest)(nil),       // 28: three_kingdoms.player.AllianceInfoRequest
-	(*AllianceInfoResponse)(nil),      // 29: three_kingdoms.player.AllianceInfoResponse
-	(*AllianceApplyListRequest)(nil),  // 30: three_kingdoms.player.AllianceApplyListRequest
-	(*AllianceApplyListResponse)(nil), // 31: three_kingdoms.player.AllianceApplyListResponse
-	(*DrawGeneralRequest)(nil),        // 32: three_kingdoms.player.DrawGeneralRequest
-	(*DrawGeneralResponse)(nil),       // 33: three_kingdoms.player.DrawGeneralResponse
-	(*FacilitiesRequest)(nil),         // 34: three_kingdoms.player.FacilitiesRequest
-	(*FacilitiesResponse)(nil),        // 35: three_kingdoms.player.FacilitiesResponse
-	(*UpFacilityRequest)(nil),         // 36: three_kingdoms.player.UpFacilityRequest
-	(*UpFacilityResponse)(nil),        // 37: three_kingdoms.player.UpFacilityResponse
-	(*TransformRequest)(nil),          // 38: three_kingdoms.player.TransformRequest
-	(*TransformResponse)(nil),         // 39: three_kingdoms.player.TransformResponse
-	(*DisposeRequest)(nil),            // 40: three_kingdoms.player.DisposeRequest
-	(*DisposeResponse)(nil),           // 41: three_kingdoms.player.DisposeResponse
-	(*ConscriptRequest)(nil),          // 42: three_kingdoms.player.ConscriptRequest
-	(*ConscriptResponse)(nil),         // 43: three_kingdoms.player.ConscriptResponse
-	(*ArmyInfoRequest)(nil),           // 44: three_kingdoms.player.ArmyInfoRequest
-	(*ArmyInfoResponse)(nil),          // 45: three_kingdoms.player.ArmyInfoResponse
-	(*AssignArmyRequest)(nil),         // 46: three_kingdoms.player.AssignArmyRequest
-	(*AssignArmyResponse)(nil),        // 47: three_kingdoms.player.AssignArmyResponse
-	(*common.BizResult)(nil),          // 48: three_kingdoms.common.BizResult
-	(*Role)(nil),                      // 49: Role
-	(*Resource)(nil),                  // 50: Resource
-	(*BuildingCfg)(nil),               // 51: three_kingdoms.player.BuildingCfg
-	(*Building)(nil),                  // 52: three_kingdoms.player.Building
-	(*General)(nil),                   // 53: three_kingdoms.player.General
-	(*City)(nil),                      // 54: three_kingdoms.player.City
-	(*Army)(nil),                      // 55: three_kingdoms.player.Army
-	(*PosTag)(nil),                    // 56: three_kingdoms.player.PosTag
-	(*WarReport)(nil),                 // 57: three_kingdoms.player.WarReport
-	(*Skill)(nil),                     // 58: three_kingdoms.player.Skill
-	(*Alliance)(nil),                  // 59: three_kingdoms.player.Alliance
-	(*ApplyItem)(nil),                 // 60: three_kingdoms.player.ApplyItem
-	(*Facility)(nil),                  // 61: three_kingdoms.player.Facility
+	(*PosTagAddRequest)(nil),          // 12: three_kingdoms.player.PosTagAddRequest
+	(*PosTagAddResponse)(nil),         // 13: three_kingdoms.player.PosTagAddResponse
+	(*PosTagRenameRequest)(nil),       // 14: three_kingdoms.player.PosTagRenameRequest
+	(*PosTagRenameResponse)(nil),      // 15: three_kingdoms.player.PosTagRenameResponse
+	(*PosTagDelRequest)(nil),          // 16: three_kingdoms.player.PosTagDelRequest
+	(*PosTagDelResponse)(nil),         // 17: three_kingdoms.player.PosTagDelResponse
+	(*MyGeneralsRequest)(nil),         // 18: three_kingdoms.player.MyGeneralsRequest
+	(*MyGeneralsResponse)(nil),        // 19: three_kingdoms.player.MyGeneralsResponse
+	(*ArmyListRequest)(nil),           // 20: three_kingdoms.player.ArmyListRequest
+	(*ArmyListResponse)(nil),          // 21: three_kingdoms.player.ArmyListResponse
+	(*WarReportRequest)(nil),          // 22: three_kingdoms.player.WarReportRequest
+	(*WarReportResponse)(nil),         // 23: three_kingdoms.player.WarReportResponse
+	(*SkillListRequest)(nil),          // 24: three_kingdoms.player.SkillListRequest
+	(*SkillListResponse)(nil),         // 25: three_kingdoms.player.SkillListResponse
+	(*ScanBlockRequest)(nil),          // 26: three_kingdoms.player.ScanBlockRequest
+	(*ScanBlockResponse)(nil),         // 27: three_kingdoms.player.ScanBlockResponse
+	(*OpenCollectionRequest)(nil),     // 28: three_kingdoms.player.OpenCollectionRequest
+	(*OpenCollectionResponse)(nil),    // 29: three_kingdoms.player.OpenCollectionResponse
+	(*CollectionRequest)(nil),         // 30: three_kingdoms.player.CollectionRequest
+	(*CollectionResponse)(nil),        // 31: three_kingdoms.player.CollectionResponse
+	(*AllianceListRequest)(nil),       // 32: three_kingdoms.player.AllianceListRequest
+	(*AllianceListResponse)(nil),      // 33: three_kingdoms.player.AllianceListResponse
+	(*AllianceInfoRequest)(nil),       // 34: three_kingdoms.player.AllianceInfoRequest
+	(*AllianceInfoResponse)(nil),      // 35: three_kingdoms.player.AllianceInfoResponse
+	(*AllianceApplyListRequest)(nil),  // 36: three_kingdoms.player.AllianceApplyListRequest
+	(*AllianceApplyListResponse)(nil), // 37: three_kingdoms.player.AllianceApplyListResponse
+	(*DrawGeneralRequest)(nil),        // 38: three_kingdoms.player.DrawGeneralRequest
+	(*DrawGeneralResponse)(nil),       // 39: three_kingdoms.player.DrawGeneralResponse
+	(*FacilitiesRequest)(nil),         // 40: three_kingdoms.player.FacilitiesRequest
+	(*FacilitiesResponse)(nil),        // 41: three_kingdoms.player.FacilitiesResponse
+	(*UpFacilityRequest)(nil),         // 42: three_kingdoms.player.UpFacilityRequest
+	(*UpFacilityResponse)(nil),        // 43: three_kingdoms.player.UpFacilityResponse
+	(*TransformRequest)(nil),          // 44: three_kingdoms.player.TransformRequest
+	(*TransformResponse)(nil),         // 45: three_kingdoms.player.TransformResponse
+	(*DisposeRequest)(nil),            // 46: three_kingdoms.player.DisposeRequest
+	(*DisposeResponse)(nil),           // 47: three_kingdoms.player.DisposeResponse
+	(*ConscriptRequest)(nil),          // 48: three_kingdoms.player.ConscriptRequest
+	(*ConscriptResponse)(nil),         // 49: three_kingdoms.player.ConscriptResponse
+	(*ArmyInfoRequest)(nil),           // 50: three_kingdoms.player.ArmyInfoRequest
+	(*ArmyInfoResponse)(nil),          // 51: three_kingdoms.player.ArmyInfoResponse
+	(*AssignArmyRequest)(nil),         // 52: three_kingdoms.player.AssignArmyRequest
+	(*AssignArmyResponse)(nil),        // 53: three_kingdoms.player.AssignArmyResponse
+	(*ReinforceBackRequest)(nil),      // 54: three_kingdoms.player.ReinforceBackRequest
+	(*ReinforceBackResponse)(nil),     // 55: three_kingdoms.player.ReinforceBackResponse
+	(*GiveUpRequest)(nil),             // 56: three_kingdoms.player.GiveUpRequest
+	(*GiveUpResponse)(nil),            // 57: three_kingdoms.player.GiveUpResponse
+	(*CreateSubCityRequest)(nil),      // 58: three_kingdoms.player.CreateSubCityRequest
+	(*CreateSubCityResponse)(nil),     // 59: three_kingdoms.player.CreateSubCityResponse
+	(*MoveCityRequest)(nil),           // 60: three_kingdoms.player.MoveCityRequest
+	(*MoveCityResponse)(nil),          // 61: three_kingdoms.player.MoveCityResponse
+	(*AllianceCreateRequest)(nil),     // 62: three_kingdoms.player.AllianceCreateRequest
+	(*AllianceCreateResponse)(nil),    // 63: three_kingdoms.player.AllianceCreateResponse
+	(*AllianceJoinRequest)(nil),       // 64: three_kingdoms.player.AllianceJoinRequest
+	(*AllianceJoinResponse)(nil),      // 65: three_kingdoms.player.AllianceJoinResponse
+	(*AllianceVerifyRequest)(nil),     // 66: three_kingdoms.player.AllianceVerifyRequest
+	(*AllianceVerifyResponse)(nil),    // 67: three_kingdoms.player.AllianceVerifyResponse
+	(*AllianceExitRequest)(nil),       // 68: three_kingdoms.player.AllianceExitRequest
+	(*AllianceExitResponse)(nil),      // 69: three_kingdoms.player.AllianceExitResponse
+	(*AllianceKickRequest)(nil),       // 70: three_kingdoms.player.AllianceKickRequest
+	(*AllianceKickResponse)(nil),      // 71: three_kingdoms.player.AllianceKickResponse
+	(*AllianceDismissRequest)(nil),    // 72: three_kingdoms.player.AllianceDismissRequest
+	(*AllianceDismissResponse)(nil),   // 73: three_kingdoms.player.AllianceDismissResponse
+	(*AllianceAppointRequest)(nil),    // 74: three_kingdoms.player.AllianceAppointRequest
+	(*AllianceAppointResponse)(nil),   // 75: three_kingdoms.player.AllianceAppointResponse
+	(*AllianceTransferRequest)(nil),   // 76: three_kingdoms.player.AllianceTransferRequest
+	(*AllianceTransferResponse)(nil),  // 77: three_kingdoms.player.AllianceTransferResponse
+	(*AllianceNoticeRequest)(nil),     // 78: three_kingdoms.player.AllianceNoticeRequest
+	(*AllianceNoticeResponse)(nil),    // 79: three_kingdoms.player.AllianceNoticeResponse
+	(*AllianceLogRequest)(nil),        // 80: three_kingdoms.player.AllianceLogRequest
+	(*AllianceLogResponse)(nil),       // 81: three_kingdoms.player.AllianceLogResponse
+	(*AllianceMarkAddRequest)(nil),    // 82: three_kingdoms.player.AllianceMarkAddRequest
+	(*AllianceMarkAddResponse)(nil),   // 83: three_kingdoms.player.AllianceMarkAddResponse
+	(*AllianceMarkDelRequest)(nil),    // 84: three_kingdoms.player.AllianceMarkDelRequest
+	(*AllianceMarkDelResponse)(nil),   // 85: three_kingdoms.player.AllianceMarkDelResponse
+	(*AllianceMarkListRequest)(nil),   // 86: three_kingdoms.player.AllianceMarkListRequest
+	(*AllianceMarkListResponse)(nil),  // 87: three_kingdoms.player.AllianceMarkListResponse
+	(*DiplomacyProposeRequest)(nil),   // 88: three_kingdoms.player.DiplomacyProposeRequest
+	(*DiplomacyProposeResponse)(nil),  // 89: three_kingdoms.player.DiplomacyProposeResponse
+	(*DiplomacyAcceptRequest)(nil),    // 90: three_kingdoms.player.DiplomacyAcceptRequest
+	(*DiplomacyAcceptResponse)(nil),   // 91: three_kingdoms.player.DiplomacyAcceptResponse
+	(*DiplomacyListRequest)(nil),      // 92: three_kingdoms.player.DiplomacyListRequest
+	(*DiplomacyListResponse)(nil),     // 93: three_kingdoms.player.DiplomacyListResponse
+	(*RallyCreateRequest)(nil),        // 94: three_kingdoms.player.RallyCreateRequest
+	(*RallyCreateResponse)(nil),       // 95: three_kingdoms.player.RallyCreateResponse
+	(*RallyJoinRequest)(nil),          // 96: three_kingdoms.player.RallyJoinRequest
+	(*RallyJoinResponse)(nil),         // 97: three_kingdoms.player.RallyJoinResponse
+	(*RallyListRequest)(nil),          // 98: three_kingdoms.player.RallyListRequest
+	(*RallyListResponse)(nil),         // 99: three_kingdoms.player.RallyListResponse
+	(*LogoutRequest)(nil),             // 100: three_kingdoms.player.LogoutRequest
+	(*LogoutResponse)(nil),            // 101: three_kingdoms.player.LogoutResponse
+	(*AdminRequest)(nil),              // 102: three_kingdoms.player.AdminRequest
+	(*AdminGrantResource)(nil),        // 103: three_kingdoms.player.AdminGrantResource
+	(*AdminSetFacilityLevel)(nil),     // 104: three_kingdoms.player.AdminSetFacilityLevel
+	(*AdminTeleportArmy)(nil),         // 105: three_kingdoms.player.AdminTeleportArmy
+	(*AdminResponse)(nil),             // 106: three_kingdoms.player.AdminResponse
+	(*common.BizResult)(nil),          // 107: three_kingdoms.common.BizResult
+	(*Role)(nil),                      // 108: Role
+	(*Resource)(nil),                  // 109: Resource
+	(*BuildingCfg)(nil),               // 110: three_kingdoms.player.BuildingCfg
+	(*Building)(nil),                  // 111: three_kingdoms.player.Building
+	(*General)(nil),                   // 112: three_kingdoms.player.General
+	(*City)(nil),                      // 113: three_kingdoms.player.City
+	(*Army)(nil),                      // 114: three_kingdoms.player.Army
+	(*PosTag)(nil),                    // 115: three_kingdoms.player.PosTag
+	(*WarReport)(nil),                 // 116: three_kingdoms.player.WarReport
+	(*Skill)(nil),                     // 117: three_kingdoms.player.Skill
+	(AllianceSort)(0),                 // 118: three_kingdoms.player.AllianceSort
+	(*Alliance)(nil),                  // 119: three_kingdoms.player.Alliance
+	(*ApplyItem)(nil),                 // 120: three_kingdoms.player.ApplyItem
+	(*Facility)(nil),                  // 121: three_kingdoms.player.Facility
+	(AllianceApplyStatus)(0),          // 122: three_kingdoms.player.AllianceApplyStatus
+	(AllianceTitle)(0),                // 123: three_kingdoms.player.AllianceTitle
+	(*AllianceLog)(nil),               // 124: three_kingdoms.player.AllianceLog
+	(AllianceMarkKind)(0),             // 125: three_kingdoms.player.AllianceMarkKind
+	(*AllianceMark)(nil),              // 126: three_kingdoms.player.AllianceMark
+	(DiplomacyState)(0),               // 127: three_kingdoms.player.DiplomacyState
+	(*Diplomacy)(nil),                 // 128: three_kingdoms.player.Diplomacy
+	(*Rally)(nil),                     // 129: three_kingdoms.player.Rally
 }
 var file_player_player_proto_depIdxs = []int32{
-	2,  // 0: three_kingdoms.player.PlayerRequest.enterServerRequest:type_name -> three_kingdoms.player.EnterServerRequest
-	4,  // 1: three_kingdoms.player.PlayerRequest.createRoleRequest:type_name -> three_kingdoms.player.CreateRoleRequest
-	6,  // 2: three_kingdoms.player.PlayerRequest.buildingConfRequest:type_name -> three_kingdoms.player.BuildingConfRequest
-	8,  // 3: three_kingdoms.player.PlayerRequest.myPropertyRequest:type_name -> three_kingdoms.player.MyPropertyRequest
-	10, // 4: three_kingdoms.player.PlayerRequest.posTagListRequest:type_name -> three_kingdoms.player.PosTagListRequest
-	12, // 5: three_kingdoms.player.PlayerRequest.myGeneralsRequest:type_name -> three_kingdoms.player.MyGeneralsRequest
-	14, // 6: three_kingdoms.player.PlayerRequest.armyListRequest:type_name -> three_kingdoms.player.ArmyListRequest
-	16, // 7: three_kingdoms.player.PlayerRequest.WarReportRequest:type_name -> three_kingdoms.player.WarReportRequest
-	18, // 8: three_kingdoms.player.PlayerRequest.skillListRequest:type_name -> three_kingdoms.player.SkillListRequest
-	20, // 9: three_kingdoms.player.PlayerRequest.scanBlockRequest:type_name -> three_kingdoms.player.ScanBlockRequest
-	22, // 10: three_kingdoms.player.PlayerRequest.openCollectionRequest:type_name -> three_kingdoms.player.OpenCollectionRequest
-	24, // 11: three_kingdoms.player.PlayerRequest.collectionRequest:type_name -> three_kingdoms.player.CollectionRequest
-	26, // 12: three_kingdoms.player.PlayerRequest.allianceListRequest:type_name -> three_kingdoms.player.AllianceListRequest
-	28, // 13: three_kingdoms.player.PlayerRequest.allianceInfoRequest:type_name -> three_kingdoms.player.AllianceInfoRequest
-	30, // 14: three_kingdoms.player.PlayerRequest.allianceApplyListRequest:type_name -> three_kingdoms.player.AllianceApplyListRequest
-	32, // 15: three_kingdoms.player.PlayerRequest.drawGeneralRequest:type_name -> three_kingdoms.player.DrawGeneralRequest
-	34, // 16: three_kingdoms.player.PlayerRequest.facilitiesRequest:type_name -> three_kingdoms.player.FacilitiesRequest
-	36, // 17: three_kingdoms.player.PlayerRequest.upFacilityRequest:type_name -> three_kingdoms.player.UpFacilityRequest
-	38, // 18: three_kingdoms.player.PlayerRequest.transformRequest:type_name -> three_kingdoms.player.TransformRequest
-	40, // 19: three_kingdoms.player.PlayerRequest.disposeRequest:type_name -> three_kingdoms.player.DisposeRequest
-	42, // 20: three_kingdoms.player.PlayerRequest.ConscriptRequest:type_name -> three_kingdoms.player.ConscriptRequest
-	44, // 21: three_kingdoms.player.PlayerRequest.armyInfoRequest:type_name -> three_kingdoms.player.ArmyInfoRequest
-	46, // 22: three_kingdoms.player.PlayerRequest.assignArmyRequest:type_name -> three_kingdoms.player.AssignArmyRequest
-	48, // 23: three_kingdoms.player.PlayerResponse.result:type_name -> three_kingdoms.common.BizResult
-	3,  // 24: three_kingdoms.player.PlayerResponse.enterServerResponse:type_name -> three_kingdoms.player.EnterServerResponse
-	5,  // 25: three_kingdoms.player.PlayerResponse.createRoleResponse:type_name -> three_kingdoms.player.CreateRoleResponse
-	7,  // 26: three_kingdoms.player.PlayerResponse.buildingConfResponse:type_name -> three_kingdoms.player.BuildingConfResponse
-	9,  // 27: three_kingdoms.player.PlayerResponse.myPropertyResponse:type_name -> three_kingdoms.player.MyPropertyResponse
-	11, // 28: three_kingdoms.player.PlayerResponse.posTagListResponse:type_name -> three_kingdoms.player.PosTagListResponse
-	13, // 29: three_kingdoms.player.PlayerResponse.myGeneralsResponse:type_name -> three_kingdoms.player.MyGeneralsResponse
-	15, // 30: three_kingdoms.player.PlayerResponse.armyListResponse:type_name -> three_kingdoms.player.ArmyListResponse
-	17, // 31: three_kingdoms.player.PlayerResponse.WarReportResponse:type_name -> three_kingdoms.player.WarReportResponse
-	19, // 32: three_kingdoms.player.PlayerResponse.skillListResponse:type_name -> three_kingdoms.player.SkillListResponse
-	21, // 33: three_kingdoms.player.PlayerResponse.scanBlockResponse:type_name -> three_kingdoms.player.ScanBlockResponse
-	23, // 34: three_kingdoms.player.PlayerResponse.openCollectionResponse:type_name -> three_kingdoms.player.OpenCollectionResponse
-	25, // 35: three_kingdoms.player.PlayerResponse.collectionResponse:type_name -> three_kingdoms.player.CollectionResponse
-	27, // 36: three_kingdoms.player.PlayerResponse.allianceListResponse:type_name -> three_kingdoms.player.AllianceListResponse
-	29, // 37: three_kingdoms.player.PlayerResponse.allianceInfoResponse:type_name -> three_kingdoms.player.AllianceInfoResponse
-	31, // 38: three_kingdoms.player.PlayerResponse.allianceApplyListResponse:type_name -> three_kingdoms.player.AllianceApplyListResponse
-	33, // 39: three_kingdoms.player.PlayerResponse.drawGeneralResponse:type_name -> three_kingdoms.player.DrawGeneralResponse
-	35, // 40: three_kingdoms.player.PlayerResponse.facilitiesResponse:type_name -> three_kingdoms.player.FacilitiesResponse
-	37, // 41: three_kingdoms.player.PlayerResponse.upFacilityResponse:type_name -> three_kingdoms.player.UpFacilityResponse
-	39, // 42: three_kingdoms.player.PlayerResponse.transformResponse:type_name -> three_kingdoms.player.TransformResponse
-	41, // 43: three_kingdoms.player.PlayerResponse.disposeResponse:type_name -> three_kingdoms.player.DisposeResponse
-	43, // 44: three_kingdoms.player.PlayerResponse.ConscriptResponse:type_name -> three_kingdoms.player.ConscriptResponse
-	45, // 45: three_kingdoms.player.PlayerResponse.armyInfoResponse:type_name -> three_kingdoms.player.ArmyInfoResponse
-	47, // 46: three_kingdoms.player.PlayerResponse.assignArmyResponse:type_name -> three_kingdoms.player.AssignArmyResponse
-	49, // 47: three_kingdoms.player.EnterServerResponse.role:type_name -> Role
-	50, // 48: three_kingdoms.player.EnterServerResponse.resource:type_name -> Resource
-	49, // 49: three_kingdoms.player.CreateRoleResponse.role:type_name -> Role
-	51, // 50: three_kingdoms.player.BuildingConfResponse.cfgs:type_name -> three_kingdoms.player.BuildingCfg
-	50, // 51: three_kingdoms.player.MyPropertyResponse.resource:type_name -> Resource
-	52, // 52: three_kingdoms.player.MyPropertyResponse.buildings:type_name -> three_kingdoms.player.Building
-	53, // 53: three_kingdoms.player.MyPropertyResponse.generals:type_name -> three_kingdoms.player.General
-	54, // 54: three_kingdoms.player.MyPropertyResponse.cities:type_name -> three_kingdoms.player.City
-	55, // 55: three_kingdoms.player.MyPropertyResponse.armies:type_name -> three_kingdoms.player.Army
-	56, // 56: three_kingdoms.player.PosTagListResponse.posTags:type_name -> three_kingdoms.player.PosTag
-	53, // 57: three_kingdoms.player.MyGeneralsResponse.generals:type_name -> three_kingdoms.player.General
-	55, // 58: three_kingdoms.player.ArmyListResponse.armies:type_name -> three_kingdoms.player.Army
-	57, // 59: three_kingdoms.player.WarReportResponse.warReports:type_name -> three_kingdoms.player.WarReport
-	58, // 60: three_kingdoms.player.SkillListResponse.skills:type_name -> three_kingdoms.player.Skill
-	52, // 61: three_kingdoms.player.ScanBlockResponse.buildings:type_name -> three_kingdoms.player.Building
-	54, // 62: three_kingdoms.player.ScanBlockResponse.cities:type_name -> three_kingdoms.player.City
-	55, // 63: three_kingdoms.player.ScanBlockResponse.Armies:type_name -> three_kingdoms.player.Army
-	59, // 64: three_kingdoms.player.AllianceListResponse.list:type_name -> three_kingdoms.player.Alliance
-	59, // 65: three_kingdoms.player.AllianceInfoResponse.alliance:type_name -> three_kingdoms.player.Alliance
-	60, // 66: three_kingdoms.player.AllianceApplyListResponse.item:type_name -> three_kingdoms.player.ApplyItem
-	53, // 67: three_kingdoms.player.DrawGeneralResponse.generals:type_name -> three_kingdoms.player.General
-	61, // 68: three_kingdoms.player.FacilitiesResponse.facilities:type_name -> three_kingdoms.player.Facility
-	61, // 69: three_kingdoms.player.UpFacilityResponse.facility:type_name -> three_kingdoms.player.Facility
-	50, // 70: three_kingdoms.player.UpFacilityResponse.resource:type_name -> Resource
-	55, // 71: three_kingdoms.player.DisposeResponse.Army:type_name -> three_kingdoms.player.Army
-	55, // 72: three_kingdoms.player.ConscriptResponse.army:type_name -> three_kingdoms.player.Army
-	50, // 73: three_kingdoms.player.ConscriptResponse.resource:type_name -> Resource
-	55, // 74: three_kingdoms.player.ArmyInfoResponse.army:type_name -> three_kingdoms.player.Army
-	55, // 75: three_kingdoms.player.AssignArmyResponse.army:type_name -> three_kingdoms.player.Army
-	0,  // 76: three_kingdoms.player.PlayerService.Handle:input_type -> three_kingdoms.player.PlayerRequest
-	1,  // 77: three_kingdoms.player.PlayerService.Handle:output_type -> three_kingdoms.player.PlayerResponse
-	77, // [77:78] is the sub-list for method output_type
-	76, // [76:77] is the sub-list for method input_type
-	76, // [76:76] is the sub-list for extension type_name
-	76, // [76:76] is the sub-list for extension extendee
-	0,  // [0:76] is the sub-list for field type_name
+	2,   // 0: three_kingdoms.player.PlayerRequest.enterServerRequest:type_name -> three_kingdoms.player.EnterServerRequest
+	4,   // 1: three_kingdoms.player.PlayerRequest.createRoleRequest:type_name -> three_kingdoms.player.CreateRoleRequest
+	6,   // 2: three_kingdoms.player.PlayerRequest.buildingConfRequest:type_name -> three_kingdoms.player.BuildingConfRequest
+	8,   // 3: three_kingdoms.player.PlayerRequest.myPropertyRequest:type_name -> three_kingdoms.player.MyPropertyRequest
+	10,  // 4: three_kingdoms.player.PlayerRequest.posTagListRequest:type_name -> three_kingdoms.player.PosTagListRequest
+	18,  // 5: three_kingdoms.player.PlayerRequest.myGeneralsRequest:type_name -> three_kingdoms.player.MyGeneralsRequest
+	20,  // 6: three_kingdoms.player.PlayerRequest.armyListRequest:type_name -> three_kingdoms.player.ArmyListRequest
+	22,  // 7: three_kingdoms.player.PlayerRequest.WarReportRequest:type_name -> three_kingdoms.player.WarReportRequest
+	24,  // 8: three_kingdoms.player.PlayerRequest.skillListRequest:type_name -> three_kingdoms.player.SkillListRequest
+	26,  // 9: three_kingdoms.player.PlayerRequest.scanBlockRequest:type_name -> three_kingdoms.player.ScanBlockRequest
+	28,  // 10: three_kingdoms.player.PlayerRequest.openCollectionRequest:type_name -> three_kingdoms.player.OpenCollectionRequest
+	30,  // 11: three_kingdoms.player.PlayerRequest.collectionRequest:type_name -> three_kingdoms.player.CollectionRequest
+	32,  // 12: three_kingdoms.player.PlayerRequest.allianceListRequest:type_name -> three_kingdoms.player.AllianceListRequest
+	34,  // 13: three_kingdoms.player.PlayerRequest.allianceInfoRequest:type_name -> three_kingdoms.player.AllianceInfoRequest
+	36,  // 14: three_kingdoms.player.PlayerRequest.allianceApplyListRequest:type_name -> three_kingdoms.player.AllianceApplyListRequest
+	38,  // 15: three_kingdoms.player.PlayerRequest.drawGeneralRequest:type_name -> three_kingdoms.player.DrawGeneralRequest
+	40,  // 16: three_kingdoms.player.PlayerRequest.facilitiesRequest:type_name -> three_kingdoms.player.FacilitiesRequest
+	42,  // 17: three_kingdoms.player.PlayerRequest.upFacilityRequest:type_name -> three_kingdoms.player.UpFacilityRequest
+	44,  // 18: three_kingdoms.player.PlayerRequest.transformRequest:type_name -> three_kingdoms.player.TransformRequest
+	46,  // 19: three_kingdoms.player.PlayerRequest.disposeRequest:type_name -> three_kingdoms.player.DisposeRequest
+	48,  // 20: three_kingdoms.player.PlayerRequest.ConscriptRequest:type_name -> three_kingdoms.player.ConscriptRequest
+	50,  // 21: three_kingdoms.player.PlayerRequest.armyInfoRequest:type_name -> three_kingdoms.player.ArmyInfoRequest
+	52,  // 22: three_kingdoms.player.PlayerRequest.assignArmyRequest:type_name -> three_kingdoms.player.AssignArmyRequest
+	56,  // 23: three_kingdoms.player.PlayerRequest.giveUpRequest:type_name -> three_kingdoms.player.GiveUpRequest
+	12,  // 24: three_kingdoms.player.PlayerRequest.posTagAddRequest:type_name -> three_kingdoms.player.PosTagAddRequest
+	14,  // 25: three_kingdoms.player.PlayerRequest.posTagRenameRequest:type_name -> three_kingdoms.player.PosTagRenameRequest
+	16,  // 26: three_kingdoms.player.PlayerRequest.posTagDelRequest:type_name -> three_kingdoms.player.PosTagDelRequest
+	58,  // 27: three_kingdoms.player.PlayerRequest.createSubCityRequest:type_name -> three_kingdoms.player.CreateSubCityRequest
+	60,  // 28: three_kingdoms.player.PlayerRequest.moveCityRequest:type_name -> three_kingdoms.player.MoveCityRequest
+	62,  // 29: three_kingdoms.player.PlayerRequest.allianceCreateRequest:type_name -> three_kingdoms.player.AllianceCreateRequest
+	64,  // 30: three_kingdoms.player.PlayerRequest.allianceJoinRequest:type_name -> three_kingdoms.player.AllianceJoinRequest
+	66,  // 31: three_kingdoms.player.PlayerRequest.allianceVerifyRequest:type_name -> three_kingdoms.player.AllianceVerifyRequest
+	68,  // 32: three_kingdoms.player.PlayerRequest.allianceExitRequest:type_name -> three_kingdoms.player.AllianceExitRequest
+	70,  // 33: three_kingdoms.player.PlayerRequest.allianceKickRequest:type_name -> three_kingdoms.player.AllianceKickRequest
+	72,  // 34: three_kingdoms.player.PlayerRequest.allianceDismissRequest:type_name -> three_kingdoms.player.AllianceDismissRequest
+	74,  // 35: three_kingdoms.player.PlayerRequest.allianceAppointRequest:type_name -> three_kingdoms.player.AllianceAppointRequest
+	76,  // 36: three_kingdoms.player.PlayerRequest.allianceTransferRequest:type_name -> three_kingdoms.player.AllianceTransferRequest
+	78,  // 37: three_kingdoms.player.PlayerRequest.allianceNoticeRequest:type_name -> three_kingdoms.player.AllianceNoticeRequest
+	80,  // 38: three_kingdoms.player.PlayerRequest.allianceLogRequest:type_name -> three_kingdoms.player.AllianceLogRequest
+	94,  // 39: three_kingdoms.player.PlayerRequest.rallyCreateRequest:type_name -> three_kingdoms.player.RallyCreateRequest
+	96,  // 40: three_kingdoms.player.PlayerRequest.rallyJoinRequest:type_name -> three_kingdoms.player.RallyJoinRequest
+	98,  // 41: three_kingdoms.player.PlayerRequest.rallyListRequest:type_name -> three_kingdoms.player.RallyListRequest
+	54,  // 42: three_kingdoms.player.PlayerRequest.reinforceBackRequest:type_name -> three_kingdoms.player.ReinforceBackRequest
+	82,  // 43: three_kingdoms.player.PlayerRequest.allianceMarkAddRequest:type_name -> three_kingdoms.player.AllianceMarkAddRequest
+	84,  // 44: three_kingdoms.player.PlayerRequest.allianceMarkDelRequest:type_name -> three_kingdoms.player.AllianceMarkDelRequest
+	86,  // 45: three_kingdoms.player.PlayerRequest.allianceMarkListRequest:type_name -> three_kingdoms.player.AllianceMarkListRequest
+	88,  // 46: three_kingdoms.player.PlayerRequest.diplomacyProposeRequest:type_name -> three_kingdoms.player.DiplomacyProposeRequest
+	90,  // 47: three_kingdoms.player.PlayerRequest.diplomacyAcceptRequest:type_name -> three_kingdoms.player.DiplomacyAcceptRequest
+	92,  // 48: three_kingdoms.player.PlayerRequest.diplomacyListRequest:type_name -> three_kingdoms.player.DiplomacyListRequest
+	100, // 49: three_kingdoms.player.PlayerRequest.logoutRequest:type_name -> three_kingdoms.player.LogoutRequest
+	102, // 50: three_kingdoms.player.PlayerRequest.adminRequest:type_name -> three_kingdoms.player.AdminRequest
+	107, // 51: three_kingdoms.player.PlayerResponse.result:type_name -> three_kingdoms.common.BizResult
+	3,   // 52: three_kingdoms.player.PlayerResponse.enterServerResponse:type_name -> three_kingdoms.player.EnterServerResponse
+	5,   // 53: three_kingdoms.player.PlayerResponse.createRoleResponse:type_name -> three_kingdoms.player.CreateRoleResponse
+	7,   // 54: three_kingdoms.player.PlayerResponse.buildingConfResponse:type_name -> three_kingdoms.player.BuildingConfResponse
+	9,   // 55: three_kingdoms.player.PlayerResponse.myPropertyResponse:type_name -> three_kingdoms.player.MyPropertyResponse
+	11,  // 56: three_kingdoms.player.PlayerResponse.posTagListResponse:type_name -> three_kingdoms.player.PosTagListResponse
+	19,  // 57: three_kingdoms.player.PlayerResponse.myGeneralsResponse:type_name -> three_kingdoms.player.MyGeneralsResponse
+	21,  // 58: three_kingdoms.player.PlayerResponse.armyListResponse:type_name -> three_kingdoms.player.ArmyListResponse
+	23,  // 59: three_kingdoms.player.PlayerResponse.WarReportResponse:type_name -> three_kingdoms.player.WarReportResponse
+	25,  // 60: three_kingdoms.player.PlayerResponse.skillListResponse:type_name -> three_kingdoms.player.SkillListResponse
+	27,  // 61: three_kingdoms.player.PlayerResponse.scanBlockResponse:type_name -> three_kingdoms.player.ScanBlockResponse
+	29,  // 62: three_kingdoms.player.PlayerResponse.openCollectionResponse:type_name -> three_kingdoms.player.OpenCollectionResponse
+	31,  // 63: three_kingdoms.player.PlayerResponse.collectionResponse:type_name -> three_kingdoms.player.CollectionResponse
+	33,  // 64: three_kingdoms.player.PlayerResponse.allianceListResponse:type_name -> three_kingdoms.player.AllianceListResponse
+	35,  // 65: three_kingdoms.player.PlayerResponse.allianceInfoResponse:type_name -> three_kingdoms.player.AllianceInfoResponse
+	37,  // 66: three_kingdoms.player.PlayerResponse.allianceApplyListResponse:type_name -> three_kingdoms.player.AllianceApplyListResponse
+	39,  // 67: three_kingdoms.player.PlayerResponse.drawGeneralResponse:type_name -> three_kingdoms.player.DrawGeneralResponse
+	41,  // 68: three_kingdoms.player.PlayerResponse.facilitiesResponse:type_name -> three_kingdoms.player.FacilitiesResponse
+	43,  // 69: three_kingdoms.player.PlayerResponse.upFacilityResponse:type_name -> three_kingdoms.player.UpFacilityResponse
+	45,  // 70: three_kingdoms.player.PlayerResponse.transformResponse:type_name -> three_kingdoms.player.TransformResponse
+	47,  // 71: three_kingdoms.player.PlayerResponse.disposeResponse:type_name -> three_kingdoms.player.DisposeResponse
+	49,  // 72: three_kingdoms.player.PlayerResponse.ConscriptResponse:type_name -> three_kingdoms.player.ConscriptResponse
+	51,  // 73: three_kingdoms.player.PlayerResponse.armyInfoResponse:type_name -> three_kingdoms.player.ArmyInfoResponse
+	53,  // 74: three_kingdoms.player.PlayerResponse.assignArmyResponse:type_name -> three_kingdoms.player.AssignArmyResponse
+	57,  // 75: three_kingdoms.player.PlayerResponse.giveUpResponse:type_name -> three_kingdoms.player.GiveUpResponse
+	13,  // 76: three_kingdoms.player.PlayerResponse.posTagAddResponse:type_name -> three_kingdoms.player.PosTagAddResponse
+	15,  // 77: three_kingdoms.player.PlayerResponse.posTagRenameResponse:type_name -> three_kingdoms.player.PosTagRenameResponse
+	17,  // 78: three_kingdoms.player.PlayerResponse.posTagDelResponse:type_name -> three_kingdoms.player.PosTagDelResponse
+	59,  // 79: three_kingdoms.player.PlayerResponse.createSubCityResponse:type_name -> three_kingdoms.player.CreateSubCityResponse
+	61,  // 80: three_kingdoms.player.PlayerResponse.moveCityResponse:type_name -> three_kingdoms.player.MoveCityResponse
+	63,  // 81: three_kingdoms.player.PlayerResponse.allianceCreateResponse:type_name -> three_kingdoms.player.AllianceCreateResponse
+	65,  // 82: three_kingdoms.player.PlayerResponse.allianceJoinResponse:type_name -> three_kingdoms.player.AllianceJoinResponse
+	67,  // 83: three_kingdoms.player.PlayerResponse.allianceVerifyResponse:type_name -> three_kingdoms.player.AllianceVerifyResponse
+	69,  // 84: three_kingdoms.player.PlayerResponse.allianceExitResponse:type_name -> three_kingdoms.player.AllianceExitResponse
+	71,  // 85: three_kingdoms.player.PlayerResponse.allianceKickResponse:type_name -> three_kingdoms.player.AllianceKickResponse
+	73,  // 86: three_kingdoms.player.PlayerResponse.allianceDismissResponse:type_name -> three_kingdoms.player.AllianceDismissResponse
+	75,  // 87: three_kingdoms.player.PlayerResponse.allianceAppointResponse:type_name -> three_kingdoms.player.AllianceAppointResponse
+	77,  // 88: three_kingdoms.player.PlayerResponse.allianceTransferResponse:type_name -> three_kingdoms.player.AllianceTransferResponse
+	79,  // 89: three_kingdoms.player.PlayerResponse.allianceNoticeResponse:type_name -> three_kingdoms.player.AllianceNoticeResponse
+	81,  // 90: three_kingdoms.player.PlayerResponse.allianceLogResponse:type_name -> three_kingdoms.player.AllianceLogResponse
+	95,  // 91: three_kingdoms.player.PlayerResponse.rallyCreateResponse:type_name -> three_kingdoms.player.RallyCreateResponse
+	97,  // 92: three_kingdoms.player.PlayerResponse.rallyJoinResponse:type_name -> three_kingdoms.player.RallyJoinResponse
+	99,  // 93: three_kingdoms.player.PlayerResponse.rallyListResponse:type_name -> three_kingdoms.player.RallyListResponse
+	55,  // 94: three_kingdoms.player.PlayerResponse.reinforceBackResponse:type_name -> three_kingdoms.player.ReinforceBackResponse
+	83,  // 95: three_kingdoms.player.PlayerResponse.allianceMarkAddResponse:type_name -> three_kingdoms.player.AllianceMarkAddResponse
+	85,  // 96: three_kingdoms.player.PlayerResponse.allianceMarkDelResponse:type_name -> three_kingdoms.player.AllianceMarkDelResponse
+	87,  // 97: three_kingdoms.player.PlayerResponse.allianceMarkListResponse:type_name -> three_kingdoms.player.AllianceMarkListResponse
+	89,  // 98: three_kingdoms.player.PlayerResponse.diplomacyProposeResponse:type_name -> three_kingdoms.player.DiplomacyProposeResponse
+	91,  // 99: three_kingdoms.player.PlayerResponse.diplomacyAcceptResponse:type_name -> three_kingdoms.player.DiplomacyAcceptResponse
+	93,  // 100: three_kingdoms.player.PlayerResponse.diplomacyListResponse:type_name -> three_kingdoms.player.DiplomacyListResponse
+	101, // 101: three_kingdoms.player.PlayerResponse.logoutResponse:type_name -> three_kingdoms.player.LogoutResponse
+	106, // 102: three_kingdoms.player.PlayerResponse.adminResponse:type_name -> three_kingdoms.player.AdminResponse
+	108, // 103: three_kingdoms.player.EnterServerResponse.role:type_name -> Role
+	109, // 104: three_kingdoms.player.EnterServerResponse.resource:type_name -> Resource
+	108, // 105: three_kingdoms.player.CreateRoleResponse.role:type_name -> Role
+	110, // 106: three_kingdoms.player.BuildingConfResponse.cfgs:type_name -> three_kingdoms.player.BuildingCfg
+	109, // 107: three_kingdoms.player.MyPropertyResponse.resource:type_name -> Resource
+	111, // 108: three_kingdoms.player.MyPropertyResponse.buildings:type_name -> three_kingdoms.player.Building
+	112, // 109: three_kingdoms.player.MyPropertyResponse.generals:type_name -> three_kingdoms.player.General
+	113, // 110: three_kingdoms.player.MyPropertyResponse.cities:type_name -> three_kingdoms.player.City
+	114, // 111: three_kingdoms.player.MyPropertyResponse.armies:type_name -> three_kingdoms.player.Army
+	115, // 112: three_kingdoms.player.MyPropertyResponse.posTags:type_name -> three_kingdoms.player.PosTag
+	115, // 113: three_kingdoms.player.PosTagListResponse.posTags:type_name -> three_kingdoms.player.PosTag
+	115, // 114: three_kingdoms.player.PosTagAddResponse.posTags:type_name -> three_kingdoms.player.PosTag
+	115, // 115: three_kingdoms.player.PosTagRenameResponse.posTags:type_name -> three_kingdoms.player.PosTag
+	115, // 116: three_kingdoms.player.PosTagDelResponse.posTags:type_name -> three_kingdoms.player.PosTag
+	112, // 117: three_kingdoms.player.MyGeneralsResponse.generals:type_name -> three_kingdoms.player.General
+	114, // 118: three_kingdoms.player.ArmyListResponse.armies:type_name -> three_kingdoms.player.Army
+	116, // 119: three_kingdoms.player.WarReportResponse.warReports:type_name -> three_kingdoms.player.WarReport
+	117, // 120: three_kingdoms.player.SkillListResponse.skills:type_name -> three_kingdoms.player.Skill
+	111, // 121: three_kingdoms.player.ScanBlockResponse.buildings:type_name -> three_kingdoms.player.Building
+	113, // 122: three_kingdoms.player.ScanBlockResponse.cities:type_name -> three_kingdoms.player.City
+	114, // 123: three_kingdoms.player.ScanBlockResponse.Armies:type_name -> three_kingdoms.player.Army
+	118, // 124: three_kingdoms.player.AllianceListRequest.sort:type_name -> three_kingdoms.player.AllianceSort
+	119, // 125: three_kingdoms.player.AllianceListResponse.list:type_name -> three_kingdoms.player.Alliance
+	119, // 126: three_kingdoms.player.AllianceInfoResponse.alliance:type_name -> three_kingdoms.player.Alliance
+	120, // 127: three_kingdoms.player.AllianceApplyListResponse.item:type_name -> three_kingdoms.player.ApplyItem
+	112, // 128: three_kingdoms.player.DrawGeneralResponse.generals:type_name -> three_kingdoms.player.General
+	121, // 129: three_kingdoms.player.FacilitiesResponse.facilities:type_name -> three_kingdoms.player.Facility
+	121, // 130: three_kingdoms.player.UpFacilityResponse.facility:type_name -> three_kingdoms.player.Facility
+	109, // 131: three_kingdoms.player.UpFacilityResponse.resource:type_name -> Resource
+	109, // 132: three_kingdoms.player.TransformResponse.resource:type_name -> Resource
+	114, // 133: three_kingdoms.player.DisposeResponse.Army:type_name -> three_kingdoms.player.Army
+	114, // 134: three_kingdoms.player.ConscriptResponse.army:type_name -> three_kingdoms.player.Army
+	109, // 135: three_kingdoms.player.ConscriptResponse.resource:type_name -> Resource
+	114, // 136: three_kingdoms.player.ArmyInfoResponse.army:type_name -> three_kingdoms.player.Army
+	114, // 137: three_kingdoms.player.AssignArmyResponse.army:type_name -> three_kingdoms.player.Army
+	109, // 138: three_kingdoms.player.GiveUpResponse.resource:type_name -> Resource
+	113, // 139: three_kingdoms.player.CreateSubCityResponse.city:type_name -> three_kingdoms.player.City
+	109, // 140: three_kingdoms.player.CreateSubCityResponse.resource:type_name -> Resource
+	113, // 141: three_kingdoms.player.MoveCityResponse.city:type_name -> three_kingdoms.player.City
+	109, // 142: three_kingdoms.player.MoveCityResponse.resource:type_name -> Resource
+	119, // 143: three_kingdoms.player.AllianceCreateResponse.alliance:type_name -> three_kingdoms.player.Alliance
+	109, // 144: three_kingdoms.player.AllianceCreateResponse.resource:type_name -> Resource
+	122, // 145: three_kingdoms.player.AllianceVerifyRequest.decide:type_name -> three_kingdoms.player.AllianceApplyStatus
+	122, // 146: three_kingdoms.player.AllianceVerifyResponse.decide:type_name -> three_kingdoms.player.AllianceApplyStatus
+	123, // 147: three_kingdoms.player.AllianceAppointRequest.title:type_name -> three_kingdoms.player.AllianceTitle
+	123, // 148: three_kingdoms.player.AllianceAppointResponse.title:type_name -> three_kingdoms.player.AllianceTitle
+	124, // 149: three_kingdoms.player.AllianceLogResponse.logs:type_name -> three_kingdoms.player.AllianceLog
+	125, // 150: three_kingdoms.player.AllianceMarkAddRequest.kind:type_name -> three_kingdoms.player.AllianceMarkKind
+	126, // 151: three_kingdoms.player.AllianceMarkListResponse.marks:type_name -> three_kingdoms.player.AllianceMark
+	127, // 152: three_kingdoms.player.DiplomacyProposeRequest.state:type_name -> three_kingdoms.player.DiplomacyState
+	127, // 153: three_kingdoms.player.DiplomacyProposeResponse.state:type_name -> three_kingdoms.player.DiplomacyState
+	128, // 154: three_kingdoms.player.DiplomacyListResponse.relations:type_name -> three_kingdoms.player.Diplomacy
+	129, // 155: three_kingdoms.player.RallyCreateResponse.rally:type_name -> three_kingdoms.player.Rally
+	114, // 156: three_kingdoms.player.RallyJoinResponse.army:type_name -> three_kingdoms.player.Army
+	129, // 157: three_kingdoms.player.RallyListResponse.rallies:type_name -> three_kingdoms.player.Rally
+	103, // 158: three_kingdoms.player.AdminRequest.grant_resource:type_name -> three_kingdoms.player.AdminGrantResource
+	104, // 159: three_kingdoms.player.AdminRequest.set_facility_level:type_name -> three_kingdoms.player.AdminSetFacilityLevel
+	105, // 160: three_kingdoms.player.AdminRequest.teleport_army:type_name -> three_kingdoms.player.AdminTeleportArmy
+	109, // 161: three_kingdoms.player.AdminResponse.resource:type_name -> Resource
+	121, // 162: three_kingdoms.player.AdminResponse.facility:type_name -> three_kingdoms.player.Facility
+	114, // 163: three_kingdoms.player.AdminResponse.army:type_name -> three_kingdoms.player.Army
+	0,   // 164: three_kingdoms.player.PlayerService.Handle:input_type -> three_kingdoms.player.PlayerRequest
+	1,   // 165: three_kingdoms.player.PlayerService.Handle:output_type -> three_kingdoms.player.PlayerResponse
+	165, // [165:166] is the sub-list for method output_type
+	164, // [164:165] is the sub-list for method input_type
+	164, // [164:164] is the sub-list for extension type_name
+	164, // [164:164] is the sub-list for extension extendee
+	0,   // [0:164] is the sub-list for field type_name
 }
 
 func init() { file_player_player_proto_init() }
@@ -3538,6 +7942,34 @@ func file_player_player_proto_init() {
 		(*PlayerRequest_ConscriptRequest)(nil),
 		(*PlayerRequest_ArmyInfoRequest)(nil),
 		(*PlayerRequest_AssignArmyRequest)(nil),
+		(*PlayerRequest_GiveUpRequest)(nil),
+		(*PlayerRequest_PosTagAddRequest)(nil),
+		(*PlayerRequest_PosTagRenameRequest)(nil),
+		(*PlayerRequest_PosTagDelRequest)(nil),
+		(*PlayerRequest_CreateSubCityRequest)(nil),
+		(*PlayerRequest_MoveCityRequest)(nil),
+		(*PlayerRequest_AllianceCreateRequest)(nil),
+		(*PlayerRequest_AllianceJoinRequest)(nil),
+		(*PlayerRequest_AllianceVerifyRequest)(nil),
+		(*PlayerRequest_AllianceExitRequest)(nil),
+		(*PlayerRequest_AllianceKickRequest)(nil),
+		(*PlayerRequest_AllianceDismissRequest)(nil),
+		(*PlayerRequest_AllianceAppointRequest)(nil),
+		(*PlayerRequest_AllianceTransferRequest)(nil),
+		(*PlayerRequest_AllianceNoticeRequest)(nil),
+		(*PlayerRequest_AllianceLogRequest)(nil),
+		(*PlayerRequest_RallyCreateRequest)(nil),
+		(*PlayerRequest_RallyJoinRequest)(nil),
+		(*PlayerRequest_RallyListRequest)(nil),
+		(*PlayerRequest_ReinforceBackRequest)(nil),
+		(*PlayerRequest_AllianceMarkAddRequest)(nil),
+		(*PlayerRequest_AllianceMarkDelRequest)(nil),
+		(*PlayerRequest_AllianceMarkListRequest)(nil),
+		(*PlayerRequest_DiplomacyProposeRequest)(nil),
+		(*PlayerRequest_DiplomacyAcceptRequest)(nil),
+		(*PlayerRequest_DiplomacyListRequest)(nil),
+		(*PlayerRequest_LogoutRequest)(nil),
+		(*PlayerRequest_AdminRequest)(nil),
 	}
 	file_player_player_proto_msgTypes[1].OneofWrappers = []any{
 		(*PlayerResponse_EnterServerResponse)(nil),
@@ -3563,6 +7995,39 @@ func file_player_player_proto_init() {
 		(*PlayerResponse_ConscriptResponse)(nil),
 		(*PlayerResponse_ArmyInfoResponse)(nil),
 		(*PlayerResponse_AssignArmyResponse)(nil),
+		(*PlayerResponse_GiveUpResponse)(nil),
+		(*PlayerResponse_PosTagAddResponse)(nil),
+		(*PlayerResponse_PosTagRenameResponse)(nil),
+		(*PlayerResponse_PosTagDelResponse)(nil),
+		(*PlayerResponse_CreateSubCityResponse)(nil),
+		(*PlayerResponse_MoveCityResponse)(nil),
+		(*PlayerResponse_AllianceCreateResponse)(nil),
+		(*PlayerResponse_AllianceJoinResponse)(nil),
+		(*PlayerResponse_AllianceVerifyResponse)(nil),
+		(*PlayerResponse_AllianceExitResponse)(nil),
+		(*PlayerResponse_AllianceKickResponse)(nil),
+		(*PlayerResponse_AllianceDismissResponse)(nil),
+		(*PlayerResponse_AllianceAppointResponse)(nil),
+		(*PlayerResponse_AllianceTransferResponse)(nil),
+		(*PlayerResponse_AllianceNoticeResponse)(nil),
+		(*PlayerResponse_AllianceLogResponse)(nil),
+		(*PlayerResponse_RallyCreateResponse)(nil),
+		(*PlayerResponse_RallyJoinResponse)(nil),
+		(*PlayerResponse_RallyListResponse)(nil),
+		(*PlayerResponse_ReinforceBackResponse)(nil),
+		(*PlayerResponse_AllianceMarkAddResponse)(nil),
+		(*PlayerResponse_AllianceMarkDelResponse)(nil),
+		(*PlayerResponse_AllianceMarkListResponse)(nil),
+		(*PlayerResponse_DiplomacyProposeResponse)(nil),
+		(*PlayerResponse_DiplomacyAcceptResponse)(nil),
+		(*PlayerResponse_DiplomacyListResponse)(nil),
+		(*PlayerResponse_LogoutResponse)(nil),
+		(*PlayerResponse_AdminResponse)(nil),
+	}
+	file_player_player_proto_msgTypes[102].OneofWrappers = []any{
+		(*AdminRequest_GrantResource)(nil),
+		(*AdminRequest_SetFacilityLevel)(nil),
+		(*AdminRequest_TeleportArmy)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3570,7 +8035,7 @@ func file_player_player_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_player_player_proto_rawDesc), len(file_player_player_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   48,
+			NumMessages:   107,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,21 +22,22 @@ const (
 )
 
 type Resource struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Wood          int32                  `protobuf:"varint,1,opt,name=wood,proto3" json:"wood,omitempty"`
-	Iron          int32                  `protobuf:"varint,2,opt,name=iron,proto3" json:"iron,omitempty"`
-	Stone         int32                  `protobuf:"varint,3,opt,name=stone,proto3" json:"stone,omitempty"`
-	Grain         int32                  `protobuf:"varint,4,opt,name=grain,proto3" json:"grain,omitempty"`
-	Gold          int32                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
-	Decree        int32                  `protobuf:"varint,6,opt,name=decree,proto3" json:"decree,omitempty"`
-	WoodYield     int32                  `protobuf:"varint,7,opt,name=wood_yield,json=woodYield,proto3" json:"wood_yield,omitempty"`
-	IronYield     int32                  `protobuf:"varint,8,opt,name=iron_yield,json=ironYield,proto3" json:"iron_yield,omitempty"`
-	StoneYield    int32                  `protobuf:"varint,9,opt,name=stone_yield,json=stoneYield,proto3" json:"stone_yield,omitempty"`
-	GrainYield    int32                  `protobuf:"varint,10,opt,name=grain_yield,json=grainYield,proto3" json:"grain_yield,omitempty"`
-	GoldYield     int32                  `protobuf:"varint,11,opt,name=gold_yield,json=goldYield,proto3" json:"gold_yield,omitempty"`
-	DepotCapacity int32                  `protobuf:"varint,12,opt,name=depot_capacity,json=depotCapacity,proto3" json:"depot_capacity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Wood           int32                  `protobuf:"varint,1,opt,name=wood,proto3" json:"wood,omitempty"`
+	Iron           int32                  `protobuf:"varint,2,opt,name=iron,proto3" json:"iron,omitempty"`
+	Stone          int32                  `protobuf:"varint,3,opt,name=stone,proto3" json:"stone,omitempty"`
+	Grain          int32                  `protobuf:"varint,4,opt,name=grain,proto3" json:"grain,omitempty"`
+	Gold           int32                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
+	Decree         int32                  `protobuf:"varint,6,opt,name=decree,proto3" json:"decree,omitempty"`
+	WoodYield      int32                  `protobuf:"varint,7,opt,name=wood_yield,json=woodYield,proto3" json:"wood_yield,omitempty"`
+	IronYield      int32                  `protobuf:"varint,8,opt,name=iron_yield,json=ironYield,proto3" json:"iron_yield,omitempty"`
+	StoneYield     int32                  `protobuf:"varint,9,opt,name=stone_yield,json=stoneYield,proto3" json:"stone_yield,omitempty"`
+	GrainYield     int32                  `protobuf:"varint,10,opt,name=grain_yield,json=grainYield,proto3" json:"grain_yield,omitempty"`
+	GoldYield      int32                  `protobuf:"varint,11,opt,name=gold_yield,json=goldYield,proto3" json:"gold_yield,omitempty"`
+	DepotCapacity  int32                  `protobuf:"varint,12,opt,name=depot_capacity,json=depotCapacity,proto3" json:"depot_capacity,omitempty"`
+	DecreeNextTime int64                  `protobuf:"varint,13,opt,name=decree_next_time,json=decreeNextTime,proto3" json:"decree_next_time,omitempty"` // 下一点政令恢复的时间（毫秒），政令已满时为 0
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Resource) Reset() {
@@ -153,11 +154,18 @@ func (x *Resource) GetDepotCapacity() int32 {
 	return 0
 }
 
+func (x *Resource) GetDecreeNextTime() int64 {
+	if x != nil {
+		return x.DecreeNextTime
+	}
+	return 0
+}
+
 var File_player_resource_proto protoreflect.FileDescriptor
 
 const file_player_resource_proto_rawDesc = "" +
 	"\n" +
-	"\x15player/resource.proto\"\xd0\x02\n" +
+	"\x15player/resource.proto\"\xfa\x02\n" +
 	"\bResource\x12\x12\n" +
 	"\x04wood\x18\x01 \x01(\x05R\x04wood\x12\x12\n" +
 	"\x04iron\x18\x02 \x01(\x05R\x04iron\x12\x14\n" +
@@ -176,7 +184,8 @@ const file_player_resource_proto_rawDesc = "" +
 	"grainYield\x12\x1d\n" +
 	"\n" +
 	"gold_yield\x18\v \x01(\x05R\tgoldYield\x12%\n" +
-	"\x0edepot_capacity\x18\f \x01(\x05R\rdepotCapacityB3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
+	"\x0edepot_capacity\x18\f \x01(\x05R\rdepotCapacity\x12(\n" +
+	"\x10decree_next_time\x18\r \x01(\x03R\x0edecreeNextTimeB3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
 
 var (
 	file_player_resource_proto_rawDescOnce sync.Once
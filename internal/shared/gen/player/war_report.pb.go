@@ -43,6 +43,7 @@ type WarReport struct {
 	X                 int32                  `protobuf:"varint,18,opt,name=x,proto3" json:"x,omitempty"`
 	Y                 int32                  `protobuf:"varint,19,opt,name=y,proto3" json:"y,omitempty"`
 	Ctime             int64                  `protobuf:"varint,20,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Defenders         []int32                `protobuf:"varint,21,rep,packed,name=defenders,proto3" json:"defenders,omitempty"` // 参与防守的玩家，含增援的盟友
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -217,11 +218,18 @@ func (x *WarReport) GetCtime() int64 {
 	return 0
 }
 
+func (x *WarReport) GetDefenders() []int32 {
+	if x != nil {
+		return x.Defenders
+	}
+	return nil
+}
+
 var File_player_war_report_proto protoreflect.FileDescriptor
 
 const file_player_war_report_proto_rawDesc = "" +
 	"\n" +
-	"\x17player/war_report.proto\x12\x15three_kingdoms.player\x1a\x10player/arm.proto\x1a\x14player/general.proto\"\xa0\a\n" +
+	"\x17player/war_report.proto\x12\x15three_kingdoms.player\x1a\x10player/arm.proto\x1a\x14player/general.proto\"\xbe\a\n" +
 	"\tWarReport\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -245,7 +253,8 @@ const file_player_war_report_proto_rawDesc = "" +
 	"\x06occupy\x18\x11 \x01(\x05R\x06occupy\x12\f\n" +
 	"\x01x\x18\x12 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x13 \x01(\x05R\x01y\x12\x14\n" +
-	"\x05ctime\x18\x14 \x01(\x03R\x05ctimeB3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
+	"\x05ctime\x18\x14 \x01(\x03R\x05ctime\x12\x1c\n" +
+	"\tdefenders\x18\x15 \x03(\x05R\tdefendersB3Z1ThreeKingdoms/internal/shared/gen/player;playerpbb\x06proto3"
 
 var (
 	file_player_war_report_proto_rawDescOnce sync.Once
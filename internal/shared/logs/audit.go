@@ -0,0 +1,54 @@
+package logs
+
+import (
+	"ThreeKingdoms/internal/shared/serverconfig"
+	"path/filepath"
+	"strings"
+
+	"github.com/natefinch/lumberjack"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var auditLogger *zap.Logger = zap.NewNop()
+
+// InitAudit 审计日志不分级别，JSON 格式单独写 <appName>_audit.log，切割参数和普通日志相同。
+// 没有配置日志目录时写进普通日志
+func InitAudit(appName string, cfg serverconfig.LogConfig) error {
+	if strings.TrimSpace(cfg.FileDir) == "" {
+		auditLogger = logger.Named("audit")
+		return nil
+	}
+	encoderCfg := zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		NameKey:        "logger",
+		MessageKey:     "msg",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+	}
+	writer := &lumberjack.Logger{
+		Filename:   auditFilename(cfg.FileDir, appName),
+		MaxSize:    max(1, cfg.MaxSize),
+		MaxBackups: max(0, cfg.MaxBackups),
+		MaxAge:     max(0, cfg.MaxAge),
+		Compress:   cfg.Compress,
+	}
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), zapcore.AddSync(writer), zapcore.InfoLevel)
+	auditLogger = zap.New(core).Named(appName)
+	return nil
+}
+
+// auditFilename 日志目录下为 <appName>_audit.log，配置的是文件时在同一目录下加 _audit 后缀
+func auditFilename(fileDir, appName string) string {
+	fileName := resolveLogFilename(fileDir, appName)
+	ext := filepath.Ext(fileName)
+	return strings.TrimSuffix(fileName, ext) + "_audit" + ext
+}
+
+// Audit 记录一条审计日志，谁在什么时候做了什么
+func Audit(msg string, fields ...zap.Field) {
+	if auditLogger != nil {
+		auditLogger.Info(msg, fields...)
+	}
+}
@@ -29,6 +29,16 @@ func AesCBCDecrypt(src, key, iv []byte, padding string) ([]byte, error) {
 	return openssl.AesCBCDecrypt(data, key, iv, padding)
 
 }
+
+// AesCBCEncryptRaw 不做 hex 编码，二进制协议使用
+func AesCBCEncryptRaw(src, key, iv []byte) ([]byte, error) {
+	return openssl.AesCBCEncrypt(src, key, iv, openssl.PKCS7_PADDING)
+}
+
+func AesCBCDecryptRaw(src, key, iv []byte) ([]byte, error) {
+	return openssl.AesCBCDecrypt(src, key, iv, openssl.PKCS7_PADDING)
+}
+
 func Md5(text string) string {
 	hashMd5 := md5.New()
 	io.WriteString(hashMd5, text)
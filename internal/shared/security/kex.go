@@ -0,0 +1,129 @@
+package security
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const kexInfo = "ThreeKingdoms ws v1"
+
+var (
+	ErrFrameShort  = errors.New("frame too short")
+	ErrFrameReplay = errors.New("frame seq replayed")
+	ErrSignKeySize = errors.New("sign key must be a 32 byte ed25519 seed")
+)
+
+// NewKeyPair 生成一次性的 X25519 密钥对
+func NewKeyPair() (*ecdh.PrivateKey, error) {
+	return ecdh.X25519().GenerateKey(rand.Reader)
+}
+
+// ParseSignKey 解析配置里 base64 编码的 Ed25519 种子，得到签名一次性公钥用的长期私钥
+func ParseSignKey(seed string) (ed25519.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("decode sign key: %w", err)
+	}
+	if len(raw) != ed25519.SeedSize {
+		return nil, ErrSignKeySize
+	}
+	return ed25519.NewKeyFromSeed(raw), nil
+}
+
+// SignServerPub 用长期私钥签名服务端的一次性公钥。客户端内置对应的公钥校验签名，
+// 中间人替换不了服务端公钥，也就算不出双方派生的密钥
+func SignServerPub(key ed25519.PrivateKey, serverPub []byte) []byte {
+	return ed25519.Sign(key, kexTranscript(serverPub))
+}
+
+// VerifyServerPub 客户端校验服务端一次性公钥的签名
+func VerifyServerPub(pub ed25519.PublicKey, serverPub, sig []byte) bool {
+	return ed25519.Verify(pub, kexTranscript(serverPub), sig)
+}
+
+func kexTranscript(serverPub []byte) []byte {
+	return append([]byte(kexInfo), serverPub...)
+}
+
+// DeriveSessionKeys 用 X25519 共享密钥经 HKDF-SHA256 派生两个方向的 AES-256 密钥，
+// priv 为任意一方的私钥，双方派生的结果一致。salt 为服务端公钥和客户端公钥
+func DeriveSessionKeys(priv *ecdh.PrivateKey, serverPub, clientPub []byte) (c2s, s2c []byte, err error) {
+	peerPub := clientPub
+	if bytes.Equal(priv.PublicKey().Bytes(), clientPub) {
+		peerPub = serverPub
+	}
+	peer, err := ecdh.X25519().NewPublicKey(peerPub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("peer public key: %w", err)
+	}
+	shared, err := priv.ECDH(peer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ecdh: %w", err)
+	}
+	salt := append(append([]byte{}, serverPub...), clientPub...)
+	keys, err := hkdf.Key(sha256.New, shared, salt, kexInfo, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("hkdf: %w", err)
+	}
+	return keys[:32], keys[32:], nil
+}
+
+// AEADStream 单个方向的 AES-GCM 加密流。每帧前 8 字节为帧序号，序号即 nonce，
+// 发送方递增保证 nonce 不重复，接收方要求严格递增，重放和乱序的帧直接拒绝
+type AEADStream struct {
+	aead cipher.AEAD
+	seq  uint64
+}
+
+func NewAEADStream(key []byte) (*AEADStream, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AEADStream{aead: aead}, nil
+}
+
+func (s *AEADStream) nonce(seq uint64) []byte {
+	nonce := make([]byte, s.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], seq)
+	return nonce
+}
+
+// Seal 加密一帧，非并发安全，同一方向只能由一个协程调用
+func (s *AEADStream) Seal(plain []byte) []byte {
+	s.seq++
+	frame := make([]byte, 8, 8+len(plain)+s.aead.Overhead())
+	binary.BigEndian.PutUint64(frame, s.seq)
+	return s.aead.Seal(frame, s.nonce(s.seq), plain, frame[:8])
+}
+
+// Open 解密一帧，帧序号不大于上一帧时视为重放
+func (s *AEADStream) Open(frame []byte) ([]byte, error) {
+	if len(frame) < 8+s.aead.Overhead() {
+		return nil, ErrFrameShort
+	}
+	seq := binary.BigEndian.Uint64(frame[:8])
+	if seq <= s.seq {
+		return nil, ErrFrameReplay
+	}
+	plain, err := s.aead.Open(nil, s.nonce(seq), frame[8:], frame[:8])
+	if err != nil {
+		return nil, err
+	}
+	s.seq = seq
+	return plain, nil
+}
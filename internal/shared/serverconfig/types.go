@@ -43,9 +43,62 @@ type GateServerConfig struct {
 	Port       int    `yaml:"port" mapstructure:"port"`
 	GRPCPort   int    `yaml:"grpc_port" mapstructure:"grpc_port"`
 	NeedSecret bool   `yaml:"need_secret" mapstructure:"need_secret"`
-	SLGProxy   string `yaml:"slg_proxy" mapstructure:"slg_proxy"`
-	ChatProxy  string `yaml:"chat_proxy" mapstructure:"chat_proxy"`
-	LoginProxy string `yaml:"login_proxy" mapstructure:"login_proxy"`
+	// KeyExchange ws 握手改用 X25519 密钥交换和 AES-GCM，BindToken 要求握手带上登录 Token。
+	// KexSignKey 为 base64 的 Ed25519 种子，用来签名一次性公钥，开启 KeyExchange 时必须配置
+	KeyExchange bool   `yaml:"key_exchange" mapstructure:"key_exchange"`
+	KexSignKey  string `yaml:"kex_sign_key" mapstructure:"kex_sign_key"`
+	BindToken   bool   `yaml:"bind_token" mapstructure:"bind_token"`
+	// ResumeGrace 断线后可以凭 resume token 接回会话的秒数
+	ResumeGrace int             `yaml:"resume_grace" mapstructure:"resume_grace"`
+	RateLimit   RateLimitConfig `yaml:"rate_limit" mapstructure:"rate_limit"`
+	// ws 服务端心跳，单位秒，为 0 不启用
+	PingInterval int `yaml:"ping_interval" mapstructure:"ping_interval"`
+	PongWait     int `yaml:"pong_wait" mapstructure:"pong_wait"`
+	IdleTimeout  int `yaml:"idle_timeout" mapstructure:"idle_timeout"`
+	// Registry uid 所在 gate 的路由表，memory 只适用于单个 gate，多个 gate 部署时用 mongo
+	Registry string `yaml:"registry" mapstructure:"registry"`
+	// AdvertiseAddr 其他进程访问本 gate grpc 的地址，也是本 gate 在路由表里的标识，为空时用 host:grpc_port
+	AdvertiseAddr string      `yaml:"advertise_addr" mapstructure:"advertise_addr"`
+	Admin         AdminConfig `yaml:"admin" mapstructure:"admin"`
+	SLGProxy      string      `yaml:"slg_proxy" mapstructure:"slg_proxy"`
+	ChatProxy     string      `yaml:"chat_proxy" mapstructure:"chat_proxy"`
+	LoginProxy    string      `yaml:"login_proxy" mapstructure:"login_proxy"`
+}
+
+// AdminConfig gate 的 http 运营接口，请求头带 Authorization: Bearer <token>，token 为空的项不生效
+type AdminConfig struct {
+	Enable bool         `yaml:"enable" mapstructure:"enable"`
+	Tokens []AdminToken `yaml:"tokens" mapstructure:"tokens"`
+}
+
+// AdminToken name 为操作人，记入审计日志
+type AdminToken struct {
+	Name  string `yaml:"name" mapstructure:"name"`
+	Token string `yaml:"token" mapstructure:"token"`
+}
+
+// RateLimitConfig ws 请求限流，令牌桶按秒补充 rate 个，最多攒 burst 个，rate 为 0 不限
+type RateLimitConfig struct {
+	Enable bool              `yaml:"enable" mapstructure:"enable"`
+	Conn   RateConfig        `yaml:"conn" mapstructure:"conn"`     // 单连接所有路由合计
+	Routes []RouteRateConfig `yaml:"routes" mapstructure:"routes"` // 单连接单路由
+	// DisconnectAfter 一分钟内超限多少次断开连接
+	DisconnectAfter int `yaml:"disconnect_after" mapstructure:"disconnect_after"`
+	// BanAfter 同一账号 BanWindow 秒内被断开多少次后封禁 BanDuration 秒
+	BanAfter    int `yaml:"ban_after" mapstructure:"ban_after"`
+	BanWindow   int `yaml:"ban_window" mapstructure:"ban_window"`
+	BanDuration int `yaml:"ban_duration" mapstructure:"ban_duration"`
+}
+
+type RateConfig struct {
+	Rate  float64 `yaml:"rate" mapstructure:"rate"`
+	Burst int     `yaml:"burst" mapstructure:"burst"`
+}
+
+type RouteRateConfig struct {
+	Name  string  `yaml:"name" mapstructure:"name"` // 组名.路由名，如 nationMap.scanBlock
+	Rate  float64 `yaml:"rate" mapstructure:"rate"`
+	Burst int     `yaml:"burst" mapstructure:"burst"`
 }
 
 type SLGServerConfig struct {
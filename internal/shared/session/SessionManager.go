@@ -2,11 +2,26 @@ package session
 
 import (
 	"ThreeKingdoms/internal/shared/transport/ws"
+	"slices"
 	"sync"
+	"time"
 )
 
 type Manager interface {
-	Bind(uid int, token string, conn ws.WSConn)
+	// Bind 登录后绑定连接，返回断线重连用的 resume token
+	Bind(uid int, token string, conn ws.WSConn) string
+	// Resume 新连接凭 resume token 接回 uid，补发 ack 之后的推送，返回新的 resume token
+	Resume(resume string, ack int64, conn ws.WSConn) (int, string, error)
+	// Push 给 uid 推送并记入补发缓冲，不在线时只记缓冲
+	Push(uid int, name string, data any)
+	// OnLogout 会话结束（断线超过重连窗口或主动解绑）时回调，at 为断线时间
+	OnLogout(fn func(uid int, at time.Time))
+	// Kick 玩家在其他 gate 登录，踢掉本地会话，不算登出
+	Kick(uid int)
+	// Disconnect 运营踢人：关闭连接并立即结束会话，不留重连窗口，按登出回调。没有会话返回 false
+	Disconnect(uid int) bool
+	// Online 本 gate 上连接着的 uid，升序
+	Online() []int
 	UnbindConn(conn ws.WSConn)
 	UnbindUID(uid int)
 	GetConn(uid int) (ws.WSConn, bool)
@@ -19,24 +34,36 @@ type SessMgr struct {
 	uid2conn  map[int]ws.WSConn
 	conn2uid  map[ws.WSConn]int
 	watched   map[ws.WSConn]struct{}
+
+	// grace 断线后保留 resume token 和推送缓冲的时长
+	grace    time.Duration
+	replays  map[int]*replay
+	resumes  map[string]int
+	onLogout func(uid int, at time.Time)
 }
 
-func NewSessMgr() Manager {
-	return &SessMgr{
+func NewSessMgr(grace time.Duration) Manager {
+	if grace <= 0 {
+		grace = defaultGrace
+	}
+	s := &SessMgr{
 		uid2token: make(map[int]string),
 		uid2conn:  make(map[int]ws.WSConn),
 		conn2uid:  make(map[ws.WSConn]int),
 		watched:   make(map[ws.WSConn]struct{}),
+		grace:     grace,
+		replays:   make(map[int]*replay),
+		resumes:   make(map[string]int),
 	}
+	go s.expireLoop()
+	return s
 }
 
-func (s *SessMgr) Bind(uid int, token string, conn ws.WSConn) {
+func (s *SessMgr) Bind(uid int, token string, conn ws.WSConn) string {
 	if conn == nil {
-		return
+		return ""
 	}
 	s.Lock()
-	defer s.Unlock()
-
 	// 为每条连接只启动一次 watcher：连接关闭后自动解绑，避免 conn2uid 逐步膨胀
 	if _, ok := s.watched[conn]; !ok {
 		s.watched[conn] = struct{}{}
@@ -44,14 +71,23 @@ func (s *SessMgr) Bind(uid int, token string, conn ws.WSConn) {
 	}
 
 	oldConn := s.uid2conn[uid]
-	// 踢掉原来的那个
+	s.uid2conn[uid] = conn
+	s.conn2uid[conn] = uid
+	s.uid2token[uid] = token
+
+	// 重新登录后客户端会重新拉取全量数据，之前的推送不再需要补发
+	s.dropReplay(uid)
+	r := &replay{}
+	s.replays[uid] = r
+	resume := s.rotate(uid, r)
+	s.Unlock()
+
+	// 踢掉原来的那个，连接 I/O 不占着全局锁
 	if oldConn != nil && oldConn != conn {
 		oldConn.Push("robLogin", nil)
 		oldConn.Close()
 	}
-	s.uid2conn[uid] = conn
-	s.conn2uid[conn] = uid
-	s.uid2token[uid] = token
+	return resume
 }
 
 func (s *SessMgr) watchConnDone(conn ws.WSConn) {
@@ -67,6 +103,10 @@ func (s *SessMgr) UnbindConn(conn ws.WSConn) {
 	delete(s.conn2uid, conn)
 	if s.uid2conn[uid] == conn {
 		delete(s.uid2conn, uid)
+		// 断线开始计时，grace 内可以凭 resume token 接回
+		if r, ok := s.replays[uid]; ok {
+			r.offline = time.Now()
+		}
 	}
 }
 
@@ -79,6 +119,62 @@ func (s *SessMgr) UnbindUID(uid int) {
 		delete(s.conn2uid, conn)
 	}
 	delete(s.uid2conn, uid)
+	if _, ok := s.replays[uid]; ok {
+		s.dropReplay(uid)
+		s.logout(uid, time.Now())
+	}
+}
+
+func (s *SessMgr) Kick(uid int) {
+	s.Lock()
+	conn, online := s.uid2conn[uid]
+	if online {
+		delete(s.watched, conn)
+		delete(s.conn2uid, conn)
+	}
+	delete(s.uid2conn, uid)
+	delete(s.uid2token, uid)
+	// 新的会话已经在别的 gate 上开始，这里不再回调登出，否则会覆盖新会话的登录状态
+	s.dropReplay(uid)
+	s.Unlock()
+
+	if online {
+		conn.Push("robLogin", nil)
+		conn.Close()
+	}
+}
+
+func (s *SessMgr) Disconnect(uid int) bool {
+	s.Lock()
+	conn, online := s.uid2conn[uid]
+	if online {
+		delete(s.watched, conn)
+		delete(s.conn2uid, conn)
+	}
+	delete(s.uid2conn, uid)
+	delete(s.uid2token, uid)
+	_, ok := s.replays[uid]
+	if ok {
+		s.dropReplay(uid)
+		s.logout(uid, time.Now())
+	}
+	s.Unlock()
+
+	if online {
+		conn.Close()
+	}
+	return online || ok
+}
+
+func (s *SessMgr) Online() []int {
+	s.RLock()
+	defer s.RUnlock()
+	uids := make([]int, 0, len(s.uid2conn))
+	for uid := range s.uid2conn {
+		uids = append(uids, uid)
+	}
+	slices.Sort(uids)
+	return uids
 }
 
 func (s *SessMgr) GetConn(uid int) (ws.WSConn, bool) {
@@ -0,0 +1,196 @@
+package session
+
+import (
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultGrace = 2 * time.Minute
+	// replaySize 每个 uid 最多缓冲的推送条数，超出丢弃最早的
+	replaySize = 256
+)
+
+var (
+	ErrResumeInvalid = errors.New("resume token invalid or expired")
+	// ErrResumeGap 客户端确认的序号之后的推送已经被挤出缓冲，只能重新登录
+	ErrResumeGap = errors.New("missed pushes no longer buffered")
+)
+
+type pushItem struct {
+	seq  int64
+	name string
+	data any
+}
+
+// replay 单个 uid 的推送缓冲，序号从 1 开始递增
+type replay struct {
+	// sendMu 在释放全局锁之前拿到，保证同一个 uid 的推送按序号入队，不占着全局锁做连接 I/O
+	sendMu  sync.Mutex
+	resume  string
+	seq     int64
+	items   []pushItem
+	offline time.Time // 在线时为零值
+}
+
+func (r *replay) add(name string, data any) int64 {
+	r.seq++
+	if len(r.items) >= replaySize {
+		copy(r.items, r.items[1:])
+		r.items = r.items[:len(r.items)-1]
+	}
+	r.items = append(r.items, pushItem{seq: r.seq, name: name, data: data})
+	return r.seq
+}
+
+// after ack 之后的推送，ack 之后有被挤出缓冲的推送时返回 false
+func (r *replay) after(ack int64) ([]pushItem, bool) {
+	if ack >= r.seq {
+		return nil, true
+	}
+	if len(r.items) == 0 || r.items[0].seq > ack+1 {
+		return nil, false
+	}
+	return r.items[ack+1-r.items[0].seq:], true
+}
+
+func (r *replay) expired(now time.Time, grace time.Duration) bool {
+	return !r.offline.IsZero() && now.Sub(r.offline) > grace
+}
+
+// rotate 换一个新的 resume token，旧的立即失效，调用方持有锁
+func (s *SessMgr) rotate(uid int, r *replay) string {
+	if r.resume != "" {
+		delete(s.resumes, r.resume)
+	}
+	r.resume = rand.Text()
+	s.resumes[r.resume] = uid
+	return r.resume
+}
+
+// dropReplay 调用方持有锁
+func (s *SessMgr) dropReplay(uid int) {
+	r, ok := s.replays[uid]
+	if !ok {
+		return
+	}
+	delete(s.resumes, r.resume)
+	delete(s.replays, uid)
+}
+
+// expire 断线超过 grace，会话结束，按断线时间记登出。调用方持有锁
+func (s *SessMgr) expire(uid int) {
+	r, ok := s.replays[uid]
+	if !ok {
+		return
+	}
+	s.dropReplay(uid)
+	s.logout(uid, r.offline)
+}
+
+func (s *SessMgr) OnLogout(fn func(uid int, at time.Time)) {
+	s.Lock()
+	defer s.Unlock()
+	s.onLogout = fn
+}
+
+// logout 异步回调，不占着锁等下游。调用方持有锁
+func (s *SessMgr) logout(uid int, at time.Time) {
+	if s.onLogout == nil {
+		return
+	}
+	go s.onLogout(uid, at)
+}
+
+func (s *SessMgr) Resume(resume string, ack int64, conn ws.WSConn) (int, string, error) {
+	if conn == nil || resume == "" {
+		return 0, "", ErrResumeInvalid
+	}
+	s.Lock()
+	uid, ok := s.resumes[resume]
+	if !ok {
+		s.Unlock()
+		return 0, "", ErrResumeInvalid
+	}
+	r := s.replays[uid]
+	if r == nil || r.expired(time.Now(), s.grace) {
+		s.expire(uid)
+		s.Unlock()
+		return 0, "", ErrResumeInvalid
+	}
+	// 握手绑定了 Token 的连接只能接回同一个账号
+	if bindUID, ok := conn.GetProperty(ws.ConnKeyBindUID).(int); ok && bindUID != uid {
+		s.Unlock()
+		return 0, "", ErrResumeInvalid
+	}
+	items, ok := r.after(ack)
+	if !ok {
+		s.dropReplay(uid)
+		s.Unlock()
+		return 0, "", ErrResumeGap
+	}
+	// 缓冲会被之后的推送挤动，复制一份在锁外补发
+	items = append([]pushItem(nil), items...)
+
+	if _, ok := s.watched[conn]; !ok {
+		s.watched[conn] = struct{}{}
+		go s.watchConnDone(conn)
+	}
+	oldConn := s.uid2conn[uid]
+	s.uid2conn[uid] = conn
+	s.conn2uid[conn] = uid
+	r.offline = time.Time{}
+	next := s.rotate(uid, r)
+	r.sendMu.Lock()
+	s.Unlock()
+	defer r.sendMu.Unlock()
+
+	// 服务端还没发现旧连接断开时，直接关掉旧连接
+	if oldConn != nil && oldConn != conn {
+		oldConn.Close()
+	}
+	// 补发的推送先于 resume 的应答和之后的新推送到达客户端
+	for _, item := range items {
+		conn.PushSeq(item.name, item.seq, item.data)
+	}
+	return uid, next, nil
+}
+
+func (s *SessMgr) Push(uid int, name string, data any) {
+	s.Lock()
+	conn := s.uid2conn[uid]
+	r, ok := s.replays[uid]
+	if !ok {
+		s.Unlock()
+		// 没有登录过的 uid 不缓冲
+		if conn != nil {
+			conn.Push(name, data)
+		}
+		return
+	}
+	seq := r.add(name, data)
+	r.sendMu.Lock()
+	s.Unlock()
+	defer r.sendMu.Unlock()
+	if conn != nil {
+		conn.PushSeq(name, seq, data)
+	}
+}
+
+// expireLoop 定期清理断线超过 grace 的推送缓冲
+func (s *SessMgr) expireLoop() {
+	ticker := time.NewTicker(s.grace)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		s.Lock()
+		for uid, r := range s.replays {
+			if r.expired(now, s.grace) {
+				s.expire(uid)
+			}
+		}
+		s.Unlock()
+	}
+}
@@ -72,6 +72,8 @@ const OutArmNotMatch = 63         //兵种不符
 const PosNotSkill = 64            //该位置没有技能
 const SkillLevelFull = 65         //技能等级已满
 const RoleNameExist = 66          //昵称已经存在
+const TooFrequent = 67            //请求太频繁
+const AccountBanned = 68          //账号被临时封禁
 const SystemError = 500           //系统错误
 const NotLogin = 501              //未登录错误
 
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"ThreeKingdoms/internal/shared/logs"
+	"ThreeKingdoms/internal/shared/serverconfig"
+	"ThreeKingdoms/internal/shared/transport"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// adminOperatorKey 校验通过后操作人名字在 gin.Context 中的 key
+const adminOperatorKey = "admin.operator"
+
+// AdminAuth 运营接口鉴权：Authorization: Bearer <token>，逐个比较配置的 token，失败记审计日志
+func AdminAuth(conf serverconfig.AdminConfig) gin.HandlerFunc {
+	tokens := make([]serverconfig.AdminToken, 0, len(conf.Tokens))
+	for _, t := range conf.Tokens {
+		if t.Token != "" {
+			tokens = append(tokens, t)
+		}
+	}
+	return func(c *gin.Context) {
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if ok && token != "" {
+			for _, t := range tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(t.Token)) == 1 {
+					c.Set(adminOperatorKey, t.Name)
+					c.Next()
+					return
+				}
+			}
+		}
+		logs.Audit("admin auth failed",
+			zap.String("ip", c.ClientIP()),
+			zap.String("path", c.Request.URL.Path),
+		)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":   transport.PermissionDenied,
+			"errmsg": "unauthorized",
+		})
+	}
+}
+
+// AdminOperator 当前请求的操作人，没有经过 AdminAuth 时为空
+func AdminOperator(c *gin.Context) string {
+	return c.GetString(adminOperatorKey)
+}
@@ -3,6 +3,8 @@ package ws
 import (
 	"encoding/json"
 	"errors"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // BindJSON 将 WsMsgReq.Body.Msg 反序列化到目标结构体。
@@ -16,3 +18,11 @@ func BindJSON(req *WsMsgReq, dst any) error {
 	}
 	return json.Unmarshal(raw, dst)
 }
+
+// Bind 二进制编码的请求直接按 proto 解析到目标消息，其余走 BindJSON。
+func Bind(req *WsMsgReq, dst any) error {
+	if m, ok := dst.(proto.Message); ok && req.Binary() {
+		return proto.Unmarshal(req.Body.Payload, m)
+	}
+	return BindJSON(req, dst)
+}
@@ -0,0 +1,150 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-think/openssl"
+	"google.golang.org/protobuf/proto"
+
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
+	"ThreeKingdoms/internal/shared/security"
+	"ThreeKingdoms/internal/shared/transport"
+)
+
+// errDecrypt 解密失败，密钥不一致，需要重新握手
+var errDecrypt = errors.New("ws decrypt failed")
+
+// errNoSecret 还没有完成握手，没有可用的密钥
+var errNoSecret = errors.New("ws secret key not ready")
+
+// encodeJSON 旧协议：json -> AES-CBC 零填充 -> hex -> gzip
+func encodeJSON(body *RespBody, key string) ([]byte, error) {
+	marshal, err := marshalJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := security.AesCBCEncrypt(marshal, []byte(key), []byte(key), openssl.ZEROS_PADDING)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	return security.Zip(encrypted)
+}
+
+func decodeJSON(data []byte, key string) (*ReqBody, error) {
+	secretData, err := security.UnZip(data)
+	if err != nil {
+		return nil, fmt.Errorf("unzip: %w", err)
+	}
+	decrypted, err := security.AesCBCDecrypt(secretData, []byte(key), []byte(key), openssl.ZEROS_PADDING)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errDecrypt, err)
+	}
+	return unmarshalJSON(decrypted)
+}
+
+func marshalJSON(body *RespBody) ([]byte, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("marshal json: %w", err)
+	}
+	return data, nil
+}
+
+func unmarshalJSON(data []byte) (*ReqBody, error) {
+	reqBody := &ReqBody{}
+	if err := json.Unmarshal(data, reqBody); err != nil {
+		return nil, fmt.Errorf("unmarshal json: %w", err)
+	}
+	return reqBody, nil
+}
+
+// encodeProto 二进制协议：WsEnvelope -> AES-CBC PKCS7 填充，不再 hex 和 gzip
+func encodeProto(body *RespBody, key string) ([]byte, error) {
+	data, err := marshalProto(body)
+	if err != nil {
+		return nil, err
+	}
+	return security.AesCBCEncryptRaw(data, []byte(key), []byte(key))
+}
+
+func decodeProto(data []byte, key string) (*ReqBody, error) {
+	decrypted, err := security.AesCBCDecryptRaw(data, []byte(key), []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errDecrypt, err)
+	}
+	return unmarshalProto(decrypted)
+}
+
+// marshalProto proto 消息直接放进 payload，其他类型的消息按 json 放进 payload
+func marshalProto(body *RespBody) ([]byte, error) {
+	env := &gatepb.WsEnvelope{Seq: body.Seq, Name: body.Name, Code: int32(body.Code), PushSeq: body.PushSeq}
+	msg := body.Msg
+	// 应答由 Push 包了一层，拆开后直接带上应答的 seq 和业务码
+	if inner, ok := msg.(*WsMsgResp); ok && inner != nil && inner.Body != nil {
+		env.Seq, env.Code = inner.Body.Seq, int32(inner.Body.Code)
+		msg = inner.Body.Msg
+	}
+	var err error
+	switch v := msg.(type) {
+	case nil:
+	case proto.Message:
+		env.Payload, err = proto.Marshal(v)
+	case string:
+		if env.Code != transport.OK {
+			env.Msg = v
+			break
+		}
+		env.Payload, err = json.Marshal(v)
+		env.Json = true
+	default:
+		env.Payload, err = json.Marshal(v)
+		env.Json = true
+	}
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return nil, fmt.Errorf("marshal envelope: %w", err)
+	}
+	return data, nil
+}
+
+func unmarshalProto(data []byte) (*ReqBody, error) {
+	env := &gatepb.WsEnvelope{}
+	if err := proto.Unmarshal(data, env); err != nil {
+		return nil, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+	reqBody := &ReqBody{Seq: env.Seq, Name: env.Name}
+	if env.Json {
+		if len(env.Payload) > 0 {
+			if err := json.Unmarshal(env.Payload, &reqBody.Msg); err != nil {
+				return nil, fmt.Errorf("unmarshal payload: %w", err)
+			}
+		}
+		return reqBody, nil
+	}
+	// 空消息编码后没有字节，这里保持非 nil，Binary 据此判断编码
+	reqBody.Payload = env.Payload
+	if reqBody.Payload == nil {
+		reqBody.Payload = []byte{}
+	}
+	return reqBody, nil
+}
+
+// marshalBody 按编码序列化，不加密，密钥交换后的连接由 AES-GCM 加密
+func marshalBody(codec string, body *RespBody) ([]byte, error) {
+	if codec == CodecProto {
+		return marshalProto(body)
+	}
+	return marshalJSON(body)
+}
+
+func unmarshalBody(codec string, data []byte) (*ReqBody, error) {
+	if codec == CodecProto {
+		return unmarshalProto(data)
+	}
+	return unmarshalJSON(data)
+}
@@ -0,0 +1,146 @@
+package ws
+
+import (
+	"testing"
+
+	playerpb "ThreeKingdoms/internal/shared/gen/player"
+	"ThreeKingdoms/internal/shared/transport"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const benchKey = "0123456789abcdef"
+
+// benchResp 一次视野扫描的应答，100 个建筑和 20 支军队，接近实际的大消息
+func benchResp() *playerpb.ScanBlockResponse {
+	resp := &playerpb.ScanBlockResponse{}
+	for i := 0; i < 100; i++ {
+		resp.Buildings = append(resp.Buildings, &playerpb.Building{
+			PlayerId:   int32(1000 + i),
+			Rnick:      "玩家名字",
+			Name:       "领地",
+			UnionId:    12,
+			UnionName:  "联盟名字",
+			X:          int32(i % 10),
+			Y:          int32(i / 10),
+			Type:       52,
+			Level:      int32(i%9 + 1),
+			CurDurable: 10000,
+			MaxDurable: 10000,
+			OccupyTime: 1700000000000,
+		})
+	}
+	for i := 0; i < 20; i++ {
+		resp.Armies = append(resp.Armies, &playerpb.Army{
+			Id:       int32(i + 1),
+			CityId:   3,
+			Order:    int32(i%5 + 1),
+			Generals: []int32{101, 102, 103},
+			Soldiers: []int32{1000, 800, 800},
+			ConTimes: []int64{0, 0, 0},
+			ConCnts:  []int32{0, 0, 0},
+			Cmd:      1,
+			FromX:    10,
+			FromY:    10,
+			ToX:      int32(i),
+			ToY:      int32(i),
+			Start:    1700000000000,
+			End:      1700000010000,
+		})
+	}
+	return resp
+}
+
+func benchBody() *RespBody {
+	inner := &WsMsgResp{Body: &RespBody{Seq: 7, Name: "nationMap.scanBlock", Code: transport.OK, Msg: benchResp()}}
+	return &RespBody{Name: "nationMap.scanBlock", Msg: inner}
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	data, err := encodeProto(benchBody(), benchKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 应答的信封可以按请求的格式解出来，用来校验 payload
+	req, err := decodeProto(data, benchKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Seq != 7 || req.Name != "nationMap.scanBlock" {
+		t.Fatalf("unexpected envelope seq=%d name=%s", req.Seq, req.Name)
+	}
+	got := &playerpb.ScanBlockResponse{}
+	if err := proto.Unmarshal(req.Payload, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, benchResp()) {
+		t.Fatal("payload mismatch")
+	}
+}
+
+func benchmarkEncode(b *testing.B, encode func(*RespBody, string) ([]byte, error)) {
+	body := benchBody()
+	size := 0
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := encode(body, benchKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+		size = len(data)
+	}
+	b.ReportMetric(float64(size), "wire-bytes")
+}
+
+func BenchmarkEncodeJSON(b *testing.B) {
+	benchmarkEncode(b, encodeJSON)
+}
+
+func BenchmarkEncodeProto(b *testing.B) {
+	benchmarkEncode(b, encodeProto)
+}
+
+func benchmarkDecode(b *testing.B, codec string) {
+	req := &ReqBody{Seq: 7, Name: "nationMap.scanBlock", Msg: benchResp()}
+	var data []byte
+	var err error
+	if codec == CodecProto {
+		data, err = encodeProto(&RespBody{Seq: req.Seq, Name: req.Name, Code: transport.OK, Msg: req.Msg}, benchKey)
+	} else {
+		data, err = encodeJSON(&RespBody{Seq: req.Seq, Name: req.Name, Code: transport.OK, Msg: req.Msg}, benchKey)
+	}
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if codec == CodecProto {
+			body, err := decodeProto(data, benchKey)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := proto.Unmarshal(body.Payload, &playerpb.ScanBlockResponse{}); err != nil {
+				b.Fatal(err)
+			}
+			continue
+		}
+		body, err := decodeJSON(data, benchKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := BindJSON(&WsMsgReq{Body: body}, &playerpb.ScanBlockResponse{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(len(data)), "wire-bytes")
+}
+
+func BenchmarkDecodeJSON(b *testing.B) {
+	benchmarkDecode(b, CodecJSON)
+}
+
+func BenchmarkDecodeProto(b *testing.B) {
+	benchmarkDecode(b, CodecProto)
+}
@@ -0,0 +1,280 @@
+package middlewares
+
+import (
+	"ThreeKingdoms/internal/shared/serverconfig"
+	"ThreeKingdoms/internal/shared/transport"
+	"ThreeKingdoms/internal/shared/transport/ws"
+	"ThreeKingdoms/modules/kit/logx"
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// connKeyLimit 连接上的限流状态
+const connKeyLimit = "rateLimit"
+
+// strikeWindow 超限次数的统计窗口，超过窗口没有再超限时重新计数
+const strikeWindow = time.Minute
+
+// cleanupEvery 清理过期断开记录和封禁的间隔
+const cleanupEvery = time.Minute
+
+// bucket 令牌桶，rate 为 0 时不限
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64, burst int, now time.Time) *bucket {
+	if burst <= 0 {
+		burst = 1
+	}
+	return &bucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+}
+
+func (b *bucket) allow(now time.Time) bool {
+	if b == nil || b.rate <= 0 {
+		return true
+	}
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+type connLimit struct {
+	sync.Mutex
+	conn       *bucket
+	routes     map[string]*bucket
+	strikes    int
+	lastStrike time.Time
+	kicked     bool // 已经断开过，关闭前读到的消息不再重复计数
+}
+
+// RateLimitStats 限流计数，Rejected 按路由统计
+type RateLimitStats struct {
+	Rejected    map[string]int64 `json:"rejected"`
+	Disconnects int64            `json:"disconnects"`
+	Bans        int64            `json:"bans"`
+}
+
+// RateLimiter 令牌桶限流：单连接合计和单路由各一个桶，
+// 超限先拒绝，一分钟内超限过多断开连接，同一账号反复被断开则临时封禁
+type RateLimiter struct {
+	conf   serverconfig.RateLimitConfig
+	routes map[string]serverconfig.RouteRateConfig
+	log    logx.Logger
+
+	mu          sync.Mutex
+	kicks       map[int][]time.Time // uid 被断开的时间，用于判断是否封禁
+	bans        map[int]time.Time   // uid 解封时间
+	rejected    map[string]int64
+	disconnects int64
+	banCount    int64
+}
+
+func NewRateLimiter(conf serverconfig.RateLimitConfig, l logx.Logger) *RateLimiter {
+	routes := make(map[string]serverconfig.RouteRateConfig, len(conf.Routes))
+	for _, r := range conf.Routes {
+		routes[r.Name] = r
+	}
+	rl := &RateLimiter{
+		conf:     conf,
+		routes:   routes,
+		log:      l,
+		kicks:    make(map[int][]time.Time),
+		bans:     make(map[int]time.Time),
+		rejected: make(map[string]int64),
+	}
+	if conf.Enable && conf.BanAfter > 0 {
+		go rl.cleanupLoop()
+	}
+	return rl
+}
+
+// cleanupLoop 定期清理：没有攒够次数被封禁的账号，断开记录过了 BanWindow 就删掉，到期的封禁也一并删掉
+func (rl *RateLimiter) cleanupLoop() {
+	ticker := time.NewTicker(cleanupEvery)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		rl.cleanup(now)
+	}
+}
+
+func (rl *RateLimiter) cleanup(now time.Time) {
+	window := time.Duration(rl.conf.BanWindow) * time.Second
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for uid, kicks := range rl.kicks {
+		if len(kicks) == 0 || now.Sub(kicks[len(kicks)-1]) > window {
+			delete(rl.kicks, uid)
+		}
+	}
+	for uid, until := range rl.bans {
+		if !until.After(now) {
+			delete(rl.bans, uid)
+		}
+	}
+}
+
+// Middleware 挂在 Router 上对所有路由生效
+func (rl *RateLimiter) Middleware() ws.MiddlewareFunc {
+	return func(next ws.HandlerFunc) ws.HandlerFunc {
+		if !rl.conf.Enable {
+			return next
+		}
+		return func(ctx context.Context, req *ws.WsMsgReq, resp *ws.WsMsgResp) {
+			now := time.Now()
+			if rl.checkBanned(req.Conn, resp, now) {
+				return
+			}
+			if !rl.allow(req.Conn, req.Body.Name, now) {
+				rl.reject(req.Conn, req.Body.Name, resp, now)
+				return
+			}
+			next(ctx, req, resp)
+			// 登录和重连之后才知道 uid，被封禁的账号在这里断开
+			rl.checkBanned(req.Conn, resp, now)
+		}
+	}
+}
+
+func (rl *RateLimiter) limitOf(conn ws.WSConn, now time.Time) *connLimit {
+	if v, ok := conn.GetProperty(connKeyLimit).(*connLimit); ok {
+		return v
+	}
+	v := &connLimit{
+		conn:   newBucket(rl.conf.Conn.Rate, rl.conf.Conn.Burst, now),
+		routes: make(map[string]*bucket),
+	}
+	conn.SetProperty(connKeyLimit, v)
+	return v
+}
+
+func (rl *RateLimiter) allow(conn ws.WSConn, route string, now time.Time) bool {
+	cl := rl.limitOf(conn, now)
+	cl.Lock()
+	defer cl.Unlock()
+	if r, ok := rl.routes[route]; ok {
+		b, ok := cl.routes[route]
+		if !ok {
+			b = newBucket(r.Rate, r.Burst, now)
+			cl.routes[route] = b
+		}
+		if !b.allow(now) {
+			return false
+		}
+	}
+	return cl.conn.allow(now)
+}
+
+// reject 记一次超限，超过 DisconnectAfter 次断开连接
+func (rl *RateLimiter) reject(conn ws.WSConn, route string, resp *ws.WsMsgResp, now time.Time) {
+	cl := rl.limitOf(conn, now)
+	cl.Lock()
+	if now.Sub(cl.lastStrike) > strikeWindow {
+		cl.strikes = 0
+	}
+	cl.strikes++
+	cl.lastStrike = now
+	strikes := cl.strikes
+	kick := !cl.kicked && rl.conf.DisconnectAfter > 0 && strikes >= rl.conf.DisconnectAfter
+	if kick {
+		cl.kicked = true
+	}
+	cl.Unlock()
+
+	uid, _ := conn.GetProperty(ws.ConnKeyUID).(int)
+	rl.mu.Lock()
+	rl.rejected[route]++
+	rl.mu.Unlock()
+	rl.log.Warn("ws rate limited",
+		zap.String("route", route),
+		zap.String("addr", conn.Addr()),
+		zap.Int("uid", uid),
+		zap.Int("strikes", strikes))
+
+	resp.Body.Code = transport.TooFrequent
+	resp.Body.Msg = "请求太频繁"
+	if kick {
+		rl.disconnect(conn, uid, now)
+	}
+}
+
+// disconnect 断开连接，同一账号 BanWindow 内被断开 BanAfter 次则封禁
+func (rl *RateLimiter) disconnect(conn ws.WSConn, uid int, now time.Time) {
+	rl.mu.Lock()
+	rl.disconnects++
+	banned := false
+	if uid > 0 && rl.conf.BanAfter > 0 {
+		window := time.Duration(rl.conf.BanWindow) * time.Second
+		kicks := rl.kicks[uid][:0]
+		for _, t := range rl.kicks[uid] {
+			if now.Sub(t) <= window {
+				kicks = append(kicks, t)
+			}
+		}
+		kicks = append(kicks, now)
+		rl.kicks[uid] = kicks
+		if len(kicks) >= rl.conf.BanAfter {
+			rl.bans[uid] = now.Add(time.Duration(rl.conf.BanDuration) * time.Second)
+			delete(rl.kicks, uid)
+			rl.banCount++
+			banned = true
+		}
+	}
+	rl.mu.Unlock()
+
+	rl.log.Warn("ws rate limit disconnect",
+		zap.String("addr", conn.Addr()),
+		zap.Int("uid", uid),
+		zap.Bool("banned", banned))
+	conn.Close()
+}
+
+// checkBanned 封禁中的账号拒绝请求并断开连接
+func (rl *RateLimiter) checkBanned(conn ws.WSConn, resp *ws.WsMsgResp, now time.Time) bool {
+	uid, ok := conn.GetProperty(ws.ConnKeyUID).(int)
+	if !ok || !rl.Banned(uid, now) {
+		return false
+	}
+	resp.Body.Code = transport.AccountBanned
+	resp.Body.Msg = "账号被临时封禁"
+	conn.Close()
+	return true
+}
+
+// Banned 账号是否在封禁中，到期的顺带清掉
+func (rl *RateLimiter) Banned(uid int, now time.Time) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	until, ok := rl.bans[uid]
+	if !ok {
+		return false
+	}
+	if !until.After(now) {
+		delete(rl.bans, uid)
+		return false
+	}
+	return true
+}
+
+func (rl *RateLimiter) Stats() RateLimitStats {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rejected := make(map[string]int64, len(rl.rejected))
+	for k, v := range rl.rejected {
+		rejected[k] = v
+	}
+	return RateLimitStats{Rejected: rejected, Disconnects: rl.disconnects, Bans: rl.banCount}
+}
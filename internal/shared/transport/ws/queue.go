@@ -0,0 +1,175 @@
+package ws
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 推送的优先级，数值越小越先发
+const (
+	PriorityHigh   = iota // 请求的应答、握手
+	PriorityNormal        // 普通推送
+	PriorityLow           // 可合并的推送，如军队位置
+	priorityCount
+)
+
+const (
+	queueCap = 1000
+	// highWater 队列长度超过后开始计时，持续 slowTimeout 仍未降下来视为慢连接断开
+	highWater    = 800
+	slowTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// Mergeable 推送的消息实现后按低优先级发送，队列里同一个 key 还没发出的旧消息直接被替换。
+// 被替换的推送不会再发，客户端收到的 pushSeq 可能不连续
+type Mergeable interface {
+	MergeKey() string
+}
+
+// PushStats 所有连接的推送统计
+type PushStats struct {
+	Conns      int64 `json:"conns"`
+	QueueDepth int64 `json:"queueDepth"`
+	Dropped    int64 `json:"dropped"`
+	Merged     int64 `json:"merged"`
+	SlowClosed int64 `json:"slowClosed"`
+}
+
+var pushStats struct {
+	conns, depth, dropped, merged, slowClosed atomic.Int64
+}
+
+// pushPriority 按推送路由指定的优先级，没有指定的按消息类型决定
+var pushPriority sync.Map
+
+// SetPushPriority 指定某个推送路由的优先级，如战斗结果需要和应答一样优先发送
+func SetPushPriority(name string, priority int) {
+	pushPriority.Store(name, priority)
+}
+
+func Stats() PushStats {
+	return PushStats{
+		Conns:      pushStats.conns.Load(),
+		QueueDepth: pushStats.depth.Load(),
+		Dropped:    pushStats.dropped.Load(),
+		Merged:     pushStats.merged.Load(),
+		SlowClosed: pushStats.slowClosed.Load(),
+	}
+}
+
+type queued struct {
+	msg *WsMsgResp
+	key string
+}
+
+// sendQueue 连接的发送队列，入队不阻塞，按优先级出队
+type sendQueue struct {
+	mu        sync.Mutex
+	items     [priorityCount][]*queued
+	merge     map[string]*queued
+	size      int
+	overSince time.Time
+	closed    bool
+	notify    chan struct{}
+}
+
+func newSendQueue() *sendQueue {
+	return &sendQueue{
+		merge:  make(map[string]*queued),
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// push 入队，队列满时先丢最早的低优先级消息，还是满的话丢掉新来的普通推送，应答不丢。
+// 返回 false 表示连接持续积压超过 slowTimeout
+func (q *sendQueue) push(msg *WsMsgResp, priority int, key string, now time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return true
+	}
+	if key != "" {
+		if old, ok := q.merge[key]; ok {
+			old.msg = msg
+			pushStats.merged.Add(1)
+			return true
+		}
+	}
+	if q.size >= queueCap && priority != PriorityHigh {
+		if len(q.items[PriorityLow]) == 0 {
+			pushStats.dropped.Add(1)
+			return q.healthy(now)
+		}
+		q.removeLocked(PriorityLow)
+		pushStats.dropped.Add(1)
+	}
+
+	item := &queued{msg: msg, key: key}
+	q.items[priority] = append(q.items[priority], item)
+	if key != "" {
+		q.merge[key] = item
+	}
+	q.size++
+	pushStats.depth.Add(1)
+	if q.size >= highWater && q.overSince.IsZero() {
+		q.overSince = now
+	}
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return q.healthy(now)
+}
+
+func (q *sendQueue) healthy(now time.Time) bool {
+	return q.overSince.IsZero() || now.Sub(q.overSince) <= slowTimeout
+}
+
+// pop 取优先级最高的一条
+func (q *sendQueue) pop() (*WsMsgResp, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for p := range q.items {
+		if len(q.items[p]) > 0 {
+			return q.removeLocked(p).msg, true
+		}
+	}
+	return nil, false
+}
+
+func (q *sendQueue) removeLocked(priority int) *queued {
+	item := q.items[priority][0]
+	q.items[priority][0] = nil
+	q.items[priority] = q.items[priority][1:]
+	if item.key != "" {
+		delete(q.merge, item.key)
+	}
+	q.size--
+	pushStats.depth.Add(-1)
+	if q.size < highWater {
+		q.overSince = time.Time{}
+	}
+	return item
+}
+
+func (q *sendQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// clear 连接关闭时清空，扣掉统计里的队列长度，之后的入队直接忽略
+func (q *sendQueue) clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	pushStats.depth.Add(-int64(q.size))
+	q.items = [priorityCount][]*queued{}
+	q.merge = make(map[string]*queued)
+	q.size = 0
+	q.overSince = time.Time{}
+	q.closed = true
+}
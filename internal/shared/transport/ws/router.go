@@ -38,8 +38,9 @@ func (g *Group) Handle(name string, h HandlerFunc) {
 }
 
 type Router struct {
-	groups map[string]*Group
-	log    logx.Logger
+	groups      map[string]*Group
+	middlewares []MiddlewareFunc // 所有路由共用的中间件，最先执行
+	log         logx.Logger
 }
 
 func NewRouter(l logx.Logger) *Router {
@@ -52,6 +53,10 @@ func NewRouter(l logx.Logger) *Router {
 	}
 }
 
+func (r *Router) Use(middlewares ...MiddlewareFunc) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
 func (r *Router) Group(prefix string) *Group {
 	group := r.groups[prefix]
 	if group == nil {
@@ -89,6 +94,10 @@ func (r *Router) Dispatch(req *WsMsgReq, resp *WsMsgResp) {
 		handlerFunc = middleware(handlerFunc)
 	}
 
+	for _, middleware := range r.middlewares {
+		handlerFunc = middleware(handlerFunc)
+	}
+
 	handlerFunc(ctx, req, resp)
 }
 
@@ -2,15 +2,36 @@ package ws
 
 import (
 	"ThreeKingdoms/modules/kit/logx"
+	"crypto/ed25519"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
 )
 
+// Security 连接的加密方式，KeyExchange 为 false 时沿用下发明文密钥的旧握手
+type Security struct {
+	KeyExchange bool
+	// SignKey 签名一次性公钥的长期私钥，开启 KeyExchange 时必须设置
+	SignKey ed25519.PrivateKey
+	// BindToken 密钥交换必须带上有效的登录 Token
+	BindToken bool
+}
+
+// Keepalive 服务端心跳：每 PingInterval 发 ping，PongWait 内没有收到任何消息（含 pong）视为断线，
+// IdleTimeout 内除心跳外没有任何请求视为空闲断开，为 0 不启用
+type Keepalive struct {
+	PingInterval time.Duration
+	PongWait     time.Duration
+	IdleTimeout  time.Duration
+}
+
 type Server struct {
-	router *Router
-	log    logx.Logger
+	router    *Router
+	security  Security
+	keepalive Keepalive
+	log       logx.Logger
 }
 
 func NewServer(r *Router, l logx.Logger) *Server {
@@ -20,6 +41,14 @@ func NewServer(r *Router, l logx.Logger) *Server {
 	}
 }
 
+func (s *Server) Security(sec Security) {
+	s.security = sec
+}
+
+func (s *Server) Keepalive(k Keepalive) {
+	s.keepalive = k
+}
+
 func (s *Server) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	upgrader := websocket.Upgrader{
 		// 允许所有CORS跨域请求
@@ -37,6 +66,8 @@ func (s *Server) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 
 	wsServer := NewWsServer(wsConn, s.log)
 	wsServer.Router(s.router)
+	wsServer.security = s.security
+	wsServer.keepalive = s.keepalive
 	wsServer.Run()
 	wsServer.handshake()
 }
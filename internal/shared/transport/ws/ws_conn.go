@@ -5,6 +5,8 @@ type ReqBody struct {
 	Name  string `json:"name"`
 	Msg   any    `json:"msg"`
 	Proxy string `json:"proxy"`
+	// Payload 二进制编码时的请求消息，json 编码时为空
+	Payload []byte `json:"-"`
 }
 
 type RespBody struct {
@@ -12,6 +14,8 @@ type RespBody struct {
 	Name string `json:"name"`
 	Code int    `json:"code"`
 	Msg  any    `json:"msg"`
+	// PushSeq 推送的序号，断线重连时客户端带上最后收到的序号补发推送
+	PushSeq int64 `json:"pushSeq,omitempty"`
 }
 
 type WsMsgReq struct {
@@ -21,6 +25,13 @@ type WsMsgReq struct {
 
 type WsMsgResp struct {
 	Body *RespBody
+	// codec 写出时使用的编码，为空取连接当前的编码
+	codec string
+}
+
+// Binary 请求是否走二进制编码，是的话应答可以直接返回 proto 消息
+func (r *WsMsgReq) Binary() bool {
+	return r != nil && r.Body != nil && r.Body.Payload != nil
 }
 
 // 理解为 request请求 请求会有参数 请求中放参数 取参数
@@ -30,6 +41,7 @@ type WSConn interface {
 	RemoveProperty(key string)
 	Addr() string
 	Push(name string, data any)
+	PushSeq(name string, seq int64, data any)
 	Close()
 	// Done 用于感知连接生命周期结束（连接关闭时该 channel 会被关闭）
 	Done() <-chan struct{}
@@ -37,6 +49,18 @@ type WSConn interface {
 
 type Handshake struct {
 	Key string `json:"key"`
+	// Codecs 服务端支持的编码，客户端回复 handshake 选择其一，不回复则一直用 json
+	Codecs []string `json:"codecs,omitempty"`
+	Codec  string   `json:"codec,omitempty"`
+	// Kex 开启密钥交换时为 x25519，此时不下发 Key，双方交换 Pub 后派生密钥。
+	// Sig 为服务端长期 Ed25519 私钥对 Pub 的签名，客户端用内置的公钥校验后才能继续
+	Kex string `json:"kex,omitempty"`
+	Pub string `json:"pub,omitempty"`
+	Sig string `json:"sig,omitempty"`
+	// Bind 为 true 时客户端必须带上登录的 Token，Token 用派生出的 c2s 密钥加密成一帧（帧序号 1）后 base64，
+	// 不以明文出现在链路上
+	Bind  bool   `json:"bind,omitempty"`
+	Token string `json:"token,omitempty"`
 }
 
 type Heartbeat struct {
@@ -44,9 +68,21 @@ type Heartbeat struct {
 	STime int64 `json:"stime"`
 }
 
+// 连接的编码方式
+const (
+	CodecJSON  = "json"
+	CodecProto = "proto"
+)
+
+// KexX25519 握手使用的密钥交换算法
+const KexX25519 = "x25519"
+
 const (
+	CodecKey     = "codec"
 	HandshakeMsg = "handshake"
 	SecretKey    = "secretKey"
 	ConnKeyUID   = "uid"
-	HeartbeatMsg = "heartbeat"
+	// ConnKeyBindUID 握手时绑定的 Token 对应的 uid
+	ConnKeyBindUID = "bindUid"
+	HeartbeatMsg   = "heartbeat"
 )
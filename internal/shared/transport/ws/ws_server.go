@@ -2,36 +2,52 @@ package ws
 
 import (
 	"ThreeKingdoms/modules/kit/logx"
+	"crypto/ecdh"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/go-think/openssl"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
 
 	"ThreeKingdoms/internal/shared/security"
+	"ThreeKingdoms/internal/shared/transport"
 	"ThreeKingdoms/internal/shared/utils"
 )
 
 type WsServer struct {
 	conn     *websocket.Conn
 	router   *Router
-	outChan  chan *WsMsgResp
+	queue    *sendQueue
 	Seq      int64
 	property map[string]any
 	sync.RWMutex
 	done      chan struct{}
 	closeOnce sync.Once
 	log       logx.Logger
+
+	security  Security
+	keepalive Keepalive
+	// lastActive 最后一次收到请求（不含心跳）的时间，用于空闲断开
+	lastActive atomic.Int64
+	// kexKey 握手下发的一次性私钥，交换完成后丢弃
+	kexKey *ecdh.PrivateKey
+	// in 只在读协程使用，out 只在写协程使用
+	in  *security.AEADStream
+	out *security.AEADStream
 }
 
 func NewWsServer(wsConn *websocket.Conn, l logx.Logger) *WsServer {
+	pushStats.conns.Add(1)
 	return &WsServer{
 		conn:     wsConn,
-		outChan:  make(chan *WsMsgResp, 1000),
+		queue:    newSendQueue(),
 		property: make(map[string]any),
 		Seq:      0,
 		done:     make(chan struct{}),
@@ -66,14 +82,50 @@ func (s *WsServer) Addr() string {
 }
 
 func (s *WsServer) Push(name string, data any) {
+	s.PushSeq(name, 0, data)
+}
+
+// PushSeq 不阻塞，请求的应答和指定了高优先级的推送优先发送，可合并的推送最后发送
+func (s *WsServer) PushSeq(name string, seq int64, data any) {
 	rsp := WsMsgResp{
 		Body: &RespBody{
-			Seq:  0,
-			Name: name,
-			Msg:  data,
+			Seq:     0,
+			Name:    name,
+			Msg:     data,
+			PushSeq: seq,
 		},
 	}
-	s.outChan <- &rsp
+	priority, key := PriorityNormal, ""
+	switch v := data.(type) {
+	case *WsMsgResp:
+		priority = PriorityHigh
+	case Mergeable:
+		priority, key = PriorityLow, name+":"+v.MergeKey()
+	default:
+		if p, ok := pushPriority.Load(name); ok {
+			priority = p.(int)
+		}
+	}
+	s.enqueue(&rsp, priority, key)
+}
+
+func (s *WsServer) enqueue(msg *WsMsgResp, priority int, key string) {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	if !s.queue.push(msg, priority, key, time.Now()) {
+		// 客户端长时间读不过来，断开让它重连后走 resume
+		pushStats.slowClosed.Add(1)
+		s.log.Warn("ws_server slow consumer closed", zap.String("addr", s.Addr()), zap.Int("queue", s.queue.len()))
+		s.Close()
+	}
+}
+
+// QueueLen 发送队列中还没发出的消息数
+func (s *WsServer) QueueLen() int {
+	return s.queue.len()
 }
 
 func (s *WsServer) Run() {
@@ -89,57 +141,74 @@ func (s *WsServer) readMsgLoop() {
 		}
 		s.Close()
 	}()
+	s.lastActive.Store(time.Now().UnixNano())
+	if wait := s.keepalive.PongWait; wait > 0 {
+		// 收到任何消息（含 pong）都顺延读超时，死连接到期后 ReadMessage 返回超时
+		_ = s.conn.SetReadDeadline(time.Now().Add(wait))
+		s.conn.SetPongHandler(func(string) error {
+			return s.conn.SetReadDeadline(time.Now().Add(wait))
+		})
+	}
 	for {
 		_, data, err := s.conn.ReadMessage()
 		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				s.log.Info("ws_server read timeout", zap.String("addr", s.Addr()))
+				return
+			}
 			s.log.Error("ws_server read msg", zap.Error(err))
 			return
 		}
-
-		// 前端发送的是压缩加密过的json
-		//1. 解压缩
-		secretData, err := security.UnZip(data)
-		if err != nil {
-			s.log.Error("ws_server readMsgLoop unzip", zap.Error(err))
-			continue
-		}
-
-		// 2.获取密匙
-		secretKey := s.GetProperty(SecretKey)
-		if secretKey == nil {
-			s.log.Error("ws_server readMsgLoop not found secretKey", zap.String(SecretKey, string(secretData)))
-			continue
+		if wait := s.keepalive.PongWait; wait > 0 {
+			_ = s.conn.SetReadDeadline(time.Now().Add(wait))
 		}
 
-		// 3.解密数据
-		key := secretKey.(string)
-		decryptedData, err := security.AesCBCDecrypt(secretData, []byte(key), []byte(key), openssl.ZEROS_PADDING)
-		if err != nil {
-			s.log.Error("ws_server readMsgLoop decrypt error", zap.Error(err))
-			// 出错后，发起握手
-			s.handshake()
+		// 1.开启密钥交换时，第一条消息是客户端的公钥
+		if s.security.KeyExchange && s.in == nil {
+			if err := s.exchange(data); err != nil {
+				s.log.Error("ws_server key exchange error", zap.Error(err))
+				return
+			}
 			continue
 		}
 
-		// 4.转为 json
-		reqBody := ReqBody{}
-		err = json.Unmarshal(decryptedData, &reqBody)
+		// 2.按协商的编码解出请求，json 为压缩加密过的 json，proto 为加密过的 WsEnvelope
+		codec := s.codec()
+		reqBody, err := s.decode(codec, data)
 		if err != nil {
-			s.log.Error("ws_server readMsgLoop unmarshal json error", zap.Error(err))
+			s.log.Error("ws_server readMsgLoop decode error", zap.String("codec", codec), zap.Error(err))
+			if s.security.KeyExchange {
+				// 密钥交换后解不开的帧是被篡改或重放的，直接断开
+				return
+			}
+			if errors.Is(err, errDecrypt) {
+				// 出错后，发起握手
+				s.handshake()
+			}
 			continue
 		}
 
-		// 5.分发消息
-		req := WsMsgReq{Body: &reqBody, Conn: s}
+		// 3.分发消息
+		req := WsMsgReq{Body: reqBody, Conn: s}
 		// req 和 resp 的 Version 必须一致
 		resp := WsMsgResp{Body: &RespBody{Seq: req.Body.Seq, Name: reqBody.Name, Msg: reqBody.Msg}}
-		if reqBody.Name == HeartbeatMsg {
+		switch reqBody.Name {
+		case HeartbeatMsg:
 			// 回复客户端心跳，心跳放服务端合适，目前只能满足客户端的条件
 			h := &Heartbeat{}
 			mapstructure.Decode(reqBody.Msg, h)
 			h.STime = time.Now().UnixNano() / 1e6
 			resp.Body.Msg = h
-		} else {
+		case HandshakeMsg:
+			// 客户端选择编码，应答仍按旧编码发出，之后的消息使用新编码
+			h := &Handshake{}
+			mapstructure.Decode(reqBody.Msg, h)
+			s.negotiate(h, &resp)
+			s.enqueue(&WsMsgResp{Body: &RespBody{Name: reqBody.Name, Msg: &resp}, codec: codec}, PriorityHigh, "")
+			continue
+		default:
+			s.lastActive.Store(time.Now().UnixNano())
 			s.log.Info("ws_server read msg", zap.Any("data", reqBody))
 			s.router.Dispatch(&req, &resp)
 		}
@@ -148,16 +217,171 @@ func (s *WsServer) readMsgLoop() {
 	}
 }
 
+// decode 密钥交换后按 AES-GCM 解密，否则使用握手下发的密钥
+func (s *WsServer) decode(codec string, data []byte) (*ReqBody, error) {
+	if s.security.KeyExchange {
+		plain, err := s.in.Open(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errDecrypt, err)
+		}
+		return unmarshalBody(codec, plain)
+	}
+	secretKey, ok := s.GetProperty(SecretKey).(string)
+	if !ok {
+		return nil, errNoSecret
+	}
+	if codec == CodecProto {
+		return decodeProto(data, secretKey)
+	}
+	return decodeJSON(data, secretKey)
+}
+
+func (s *WsServer) encode(codec string, body *RespBody) ([]byte, error) {
+	if s.security.KeyExchange {
+		s.RLock()
+		out := s.out
+		s.RUnlock()
+		if out == nil {
+			return nil, errNoSecret
+		}
+		plain, err := marshalBody(codec, body)
+		if err != nil {
+			return nil, err
+		}
+		return out.Seal(plain), nil
+	}
+	secretKey, ok := s.GetProperty(SecretKey).(string)
+	if !ok {
+		return nil, errNoSecret
+	}
+	if codec == CodecProto {
+		return encodeProto(body, secretKey)
+	}
+	return encodeJSON(body, secretKey)
+}
+
+// exchange 处理客户端的公钥，派生两个方向的密钥，握手应答已经用新密钥加密
+func (s *WsServer) exchange(data []byte) error {
+	s.Lock()
+	priv := s.kexKey
+	s.kexKey = nil
+	s.Unlock()
+	if priv == nil {
+		return errors.New("key exchange not started")
+	}
+
+	plain, err := security.UnZip(data)
+	if err != nil {
+		return fmt.Errorf("unzip: %w", err)
+	}
+	reqBody, err := unmarshalJSON(plain)
+	if err != nil {
+		return err
+	}
+	if reqBody.Name != HandshakeMsg {
+		return fmt.Errorf("expect handshake, got %q", reqBody.Name)
+	}
+	h := &Handshake{}
+	mapstructure.Decode(reqBody.Msg, h)
+	pub, err := base64.StdEncoding.DecodeString(h.Pub)
+	if err != nil {
+		return fmt.Errorf("decode pub: %w", err)
+	}
+
+	c2s, s2c, err := security.DeriveSessionKeys(priv, priv.PublicKey().Bytes(), pub)
+	if err != nil {
+		return err
+	}
+	in, err := security.NewAEADStream(c2s)
+	if err != nil {
+		return err
+	}
+	out, err := security.NewAEADStream(s2c)
+	if err != nil {
+		return err
+	}
+	if s.security.BindToken {
+		// Token 用 c2s 密钥加密后随公钥一起发送，占用 c2s 的第一个帧序号
+		sealed, err := base64.StdEncoding.DecodeString(h.Token)
+		if err != nil {
+			return fmt.Errorf("decode bind token: %w", err)
+		}
+		token, err := in.Open(sealed)
+		if err != nil {
+			return fmt.Errorf("open bind token: %w", err)
+		}
+		_, claims, err := security.ParseToken(string(token))
+		if err != nil {
+			return fmt.Errorf("bind token: %w", err)
+		}
+		s.SetProperty(ConnKeyBindUID, claims.Uid)
+	}
+	s.in = in
+	s.Lock()
+	s.out = out
+	s.Unlock()
+
+	resp := WsMsgResp{Body: &RespBody{Seq: reqBody.Seq, Name: HandshakeMsg}}
+	s.negotiate(h, &resp)
+	s.Push(HandshakeMsg, &resp)
+	return nil
+}
+
+// negotiate 处理客户端的编码选择，不支持的编码保持不变
+func (s *WsServer) negotiate(h *Handshake, resp *WsMsgResp) {
+	switch h.Codec {
+	case CodecJSON, CodecProto:
+		s.SetProperty(CodecKey, h.Codec)
+	}
+	resp.Body.Code = transport.OK
+	resp.Body.Msg = &Handshake{Codec: s.codec()}
+}
+
+func (s *WsServer) codec() string {
+	if codec, ok := s.GetProperty(CodecKey).(string); ok && codec != "" {
+		return codec
+	}
+	return CodecJSON
+}
+
 func (s *WsServer) writeMsgLoop() {
+	// ping 和空闲检查共用一个定时器，不发 ping 时按空闲超时检查
+	var tick <-chan time.Time
+	if period := s.keepalive.PingInterval; period > 0 || s.keepalive.IdleTimeout > 0 {
+		if period <= 0 {
+			period = s.keepalive.IdleTimeout
+		}
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
 	for {
 		select {
-		case msg, ok := <-s.outChan:
-			if ok {
+		case <-s.queue.notify:
+			for {
+				msg, ok := s.queue.pop()
+				if !ok {
+					break
+				}
 				if msg.Body.Name != HeartbeatMsg {
 					s.log.Info("ws_server write msg", zap.Any("msg", msg))
 				}
 				s.write(msg)
 			}
+		case now := <-tick:
+			if idle := s.keepalive.IdleTimeout; idle > 0 && now.Sub(time.Unix(0, s.lastActive.Load())) > idle {
+				s.log.Info("ws_server idle closed", zap.String("addr", s.Addr()))
+				s.Close()
+				return
+			}
+			if s.keepalive.PingInterval <= 0 {
+				continue
+			}
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, now.Add(writeTimeout)); err != nil {
+				s.log.Info("ws_server ping error", zap.String("addr", s.Addr()), zap.Error(err))
+				s.Close()
+				return
+			}
 		case <-s.done:
 			return
 		}
@@ -168,6 +392,8 @@ func (s *WsServer) Close() {
 	s.closeOnce.Do(func() {
 		_ = s.conn.Close()
 		close(s.done)
+		s.queue.clear()
+		pushStats.conns.Add(-1)
 	})
 }
 
@@ -176,65 +402,64 @@ func (s *WsServer) Done() <-chan struct{} {
 }
 
 func (s *WsServer) write(msg *WsMsgResp) {
-	// 转成 json
-	marshal, err := json.Marshal(msg.Body)
-	if err != nil {
-		s.log.Error("ws_server write marshal json error", zap.Error(err))
-		return
+	codec := msg.codec
+	if codec == "" {
+		codec = s.codec()
 	}
-
-	// 获取密匙
-	secretKey := s.GetProperty(SecretKey)
-	if secretKey == nil {
-		s.log.Error("ws_server write not found secretKey", zap.Any("msg", msg))
-		return
-	}
-
-	// 加密
-	key := secretKey.(string)
-	encryptedData, err := security.AesCBCEncrypt(marshal, []byte(key), []byte(key), openssl.ZEROS_PADDING)
+	data, err := s.encode(codec, msg.Body)
 	if err != nil {
-		s.log.Error("ws_server write decrypt error", zap.Error(err))
+		s.log.Error("ws_server write encode error", zap.String("codec", codec), zap.Any("msg", msg), zap.Error(err))
 		return
 	}
 
-	// 压缩
-	zip, err := security.Zip(encryptedData)
-	if err != nil {
-		s.log.Error("ws_server write zip error", zap.Error(err))
-	}
-
-	// 压缩后的密文是二进制字节流，必须走 BinaryMessage，不能走 TextMessage
-	if err := s.conn.WriteMessage(websocket.BinaryMessage, zip); err != nil {
+	// 密文是二进制字节流，必须走 BinaryMessage，不能走 TextMessage
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := s.conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		// 写超时或出错后连接已不可用
 		s.log.Error("ws_server write error", zap.Error(err))
+		s.Close()
 	}
 }
 
 func (s *WsServer) handshake() {
-	secretKey := ""
-	key := s.GetProperty(SecretKey)
-	if key == nil {
-		secretKey = utils.RandSeq(16)
+	handshake := &Handshake{Codecs: []string{CodecJSON, CodecProto}}
+	if s.security.KeyExchange {
+		// 只下发一次性公钥和它的签名，密钥由双方各自派生，不在链路上传输
+		priv, err := security.NewKeyPair()
+		if err != nil {
+			s.log.Error("ws_server handshake generate key error", zap.Error(err))
+			s.Close()
+			return
+		}
+		s.Lock()
+		s.kexKey = priv
+		s.Unlock()
+		handshake.Kex = KexX25519
+		handshake.Pub = base64.StdEncoding.EncodeToString(priv.PublicKey().Bytes())
+		handshake.Sig = base64.StdEncoding.EncodeToString(security.SignServerPub(s.security.SignKey, priv.PublicKey().Bytes()))
+		handshake.Bind = s.security.BindToken
 	} else {
-		secretKey = key.(string)
+		secretKey, ok := s.GetProperty(SecretKey).(string)
+		if !ok {
+			secretKey = utils.RandSeq(16)
+			s.SetProperty(SecretKey, secretKey)
+		}
+		handshake.Key = secretKey
 	}
-
-	handshake := &Handshake{Key: secretKey}
 	body := &RespBody{Name: HandshakeMsg, Msg: handshake}
 
 	data, err := json.Marshal(body)
 	if err != nil {
 		s.log.Error("ws_server handshake marshal json error", zap.Error(err))
-	}
-
-	if secretKey != "" {
-		s.SetProperty(SecretKey, secretKey)
-	} else {
-		s.RemoveProperty(SecretKey)
+		return
 	}
 
 	// 压缩
 	zipData, err := security.Zip(data)
+	if err != nil {
+		s.log.Error("ws_server handshake zip error", zap.Error(err))
+		return
+	}
 
 	if err := s.conn.WriteMessage(websocket.BinaryMessage, zipData); err != nil {
 		s.log.Error("ws_server handshake write error", zap.Error(err))
@@ -0,0 +1,105 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+// diplomacyOf 两个联盟之间当前生效的外交状态，到期视为中立
+func diplomacyOf(world *entity.WorldEntity, a, b AllianceID, now time.Time) messages.DiplomacyState {
+	if a <= 0 || b <= 0 || a == b {
+		return messages.DIPLOMACY_NEUTRAL
+	}
+	relations, ok := world.GetDiplomacies(a)
+	if !ok {
+		return messages.DIPLOMACY_NEUTRAL
+	}
+	v, ok := relations[b]
+	if !ok || !v.Until.After(now) {
+		return messages.DIPLOMACY_NEUTRAL
+	}
+	return messages.DiplomacyState(v.State)
+}
+
+// atPeace 停战和互不侵犯期间双方不能互相攻打
+func atPeace(world *entity.WorldEntity, a, b AllianceID, now time.Time) bool {
+	state := diplomacyOf(world, a, b, now)
+	return state == messages.DIPLOMACY_TRUCE || state == messages.DIPLOMACY_NAP
+}
+
+func atWar(world *entity.WorldEntity, a, b AllianceID, now time.Time) bool {
+	return diplomacyOf(world, a, b, now) == messages.DIPLOMACY_WAR
+}
+
+// warFree 免战保护，宣战的双方之间没有免战
+func (s *WorldService) warFree(world *entity.WorldEntity, attackerAlliance AllianceID, cell entity.CellState, now time.Time) bool {
+	if atWar(world, attackerAlliance, AllianceID(cell.Occupancy.AllianceId), now) {
+		return false
+	}
+	return s.IsWarFree(now.UnixMilli(), cell.OccupyTime.UnixMilli())
+}
+
+// warDestroy 宣战期间攻城的破坏力按配置放大
+func warDestroy(world *entity.WorldEntity, attacker entity.ArmyState, cell entity.CellState, destroy int, now time.Time) int {
+	rate := basic.BasicConf.Diplomacy.WarDestroy
+	if rate <= 0 || !atWar(world, attacker.AllianceId, AllianceID(cell.Occupancy.AllianceId), now) {
+		return destroy
+	}
+	return destroy * rate / 100
+}
+
+// SetDiplomacy 记录联盟同步过来的外交状态，双方各存一份，顺带清掉到期的
+func (s *WorldService) SetDiplomacy(w *WorldActor, req *messages.AWDiplomacy) {
+	world := w.Entity()
+	now := time.Now()
+	set := func(a, b AllianceID) {
+		relations, ok := world.GetDiplomacies(a)
+		if !ok || relations == nil {
+			relations = make(map[AllianceID]entity.DiplomacyState)
+		}
+		for k, v := range relations {
+			if !v.Until.After(now) {
+				delete(relations, k)
+			}
+		}
+		if req.State != messages.DIPLOMACY_NEUTRAL && req.Until.After(now) {
+			relations[b] = entity.DiplomacyState{State: int8(req.State), Until: req.Until}
+		} else {
+			delete(relations, b)
+		}
+		if len(relations) == 0 {
+			world.DelDiplomacies(a)
+			return
+		}
+		world.PutDiplomacies(a, relations)
+	}
+	a, b := AllianceID(req.AllianceId), AllianceID(req.TargetId)
+	if a <= 0 || b <= 0 || a == b {
+		return
+	}
+	set(a, b)
+	set(b, a)
+}
+
+// ClearDiplomacy 联盟解散时删掉它和其他联盟之间的全部外交状态
+func (s *WorldService) ClearDiplomacy(world *entity.WorldEntity, allianceID AllianceID) {
+	if allianceID <= 0 {
+		return
+	}
+	relations, _ := world.GetDiplomacies(allianceID)
+	for other := range relations {
+		rest, ok := world.GetDiplomacies(other)
+		if !ok {
+			continue
+		}
+		delete(rest, allianceID)
+		if len(rest) == 0 {
+			world.DelDiplomacies(other)
+		} else {
+			world.PutDiplomacies(other, rest)
+		}
+	}
+	world.DelDiplomacies(allianceID)
+}
@@ -28,9 +28,24 @@ func (d *Dispatcher) registerAll() {
 	register(d, WH.HandleHWCreateCity)
 	register(d, WH.HandleHWMyCities)
 	register(d, WH.HandleHWScanBlock)
+	register(d, WH.HandleHWClearView)
 	register(d, WH.HandleHWAttack)
 	register(d, WH.HandleHWBack)
 	register(d, WH.HandleHWSyncCityFacility)
+	register(d, WH.HandleHWTeleportArmy)
+	register(d, WH.HandleHWReclamation)
+	register(d, WH.HandleHWGiveUp)
+	register(d, WH.HandleHWMarketTrade)
+	register(d, WH.HandleHWCreateSubCity)
+	register(d, WH.HandleHWMoveCity)
+	register(d, WH.HandleHWSyncAlliance)
+	register(d, WH.HandleHWRallyCreate)
+	register(d, WH.HandleHWRallyJoin)
+	register(d, WH.HandleHWRallyList)
+	register(d, WH.HandleHWReinforce)
+	register(d, WH.HandleHWReinforceBack)
+	register(d, WH.HandleAWDiplomacy)
+	register(d, WH.HandleAWAllianceDismissed)
 }
 
 func register[Req messages.WorldMessage](
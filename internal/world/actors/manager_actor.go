@@ -54,7 +54,7 @@ func (m *ManagerActor) handleWorldPushBatch(msg *messages.WorldPushBatch) {
 		return
 	}
 	if err := m.pusher.PushWorldPushBatch(context.Background(), msg); err != nil {
-		logs.Error("push world batch failed", zap.Error(err), zap.Int("items", len(msg.Items)), zap.String("msg_type", string(msg.MsgType)))
+		logs.Error("push world batch failed", zap.Error(err), zap.Int("items", len(msg.Items)))
 	}
 }
 
@@ -0,0 +1,94 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+// 集市资源种类：木 铁 石 粮
+const marketResourceCnt = 4
+
+// MarketTrade 按当前行情校验兑换，成交后卖出的资源降价、买入的资源涨价
+func (s *WorldService) MarketTrade(w *WorldActor, req *messages.HWMarketTrade) *messages.WHMarketTrade {
+	resp := &messages.WHMarketTrade{OK: false}
+	if w == nil || w.Entity() == nil || req == nil || req.Rate <= 0 {
+		return resp
+	}
+	world := w.Entity()
+	prices := s.marketPrices(world, time.Now())
+
+	// 卖出资源按兑换率折算后的价值必须覆盖买入资源的价值
+	budget := 0
+	cost := 0
+	for i := 0; i < marketResourceCnt; i++ {
+		budget += req.From[i] * prices[i]
+		cost += req.To[i] * prices[i]
+	}
+	budget = budget * req.Rate / 100
+	if cost <= 0 || cost > budget {
+		resp.Prices = prices
+		return resp
+	}
+
+	step := basic.BasicConf.Market.VolumeStep
+	if step > 0 {
+		for i := 0; i < marketResourceCnt; i++ {
+			prices[i] = clampMarketPrice(prices[i] - req.From[i]/step + req.To[i]/step)
+		}
+	}
+	world.UpdateMarket(func(m *entity.MarketEntity) {
+		m.ReplacePrices(prices[:])
+	})
+
+	resp.OK = true
+	resp.Prices = prices
+	return resp
+}
+
+// marketPrices 惰性结算价格回归，返回当前行情
+func (s *WorldService) marketPrices(world *entity.WorldEntity, now time.Time) [marketResourceCnt]int {
+	var prices [marketResourceCnt]int
+	for i := range prices {
+		prices[i] = basic.MarketBasePrice
+	}
+	if world.Market() == nil {
+		world.SetMarket(entity.MarketState{Prices: prices[:], LastDrift: now})
+		return prices
+	}
+
+	market := world.Market()
+	market.ForEachPrices(func(i int, v int) {
+		if i < marketResourceCnt {
+			prices[i] = v
+		}
+	})
+
+	driftTime := time.Duration(basic.BasicConf.Market.DriftTime) * time.Second
+	if driftTime <= 0 {
+		return prices
+	}
+	turn := int(now.Sub(market.LastDrift()) / driftTime)
+	if turn <= 0 {
+		return prices
+	}
+	for i := range prices {
+		if prices[i] > basic.MarketBasePrice {
+			prices[i] = max(prices[i]-turn, basic.MarketBasePrice)
+		} else if prices[i] < basic.MarketBasePrice {
+			prices[i] = min(prices[i]+turn, basic.MarketBasePrice)
+		}
+	}
+	lastDrift := market.LastDrift().Add(time.Duration(turn) * driftTime)
+	world.UpdateMarket(func(m *entity.MarketEntity) {
+		m.ReplacePrices(prices[:])
+		m.SetLastDrift(lastDrift)
+	})
+	return prices
+}
+
+func clampMarketPrice(price int) int {
+	band := basic.BasicConf.Market.PriceBand
+	return max(basic.MarketBasePrice-band, min(price, basic.MarketBasePrice+band))
+}
@@ -0,0 +1,272 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/world/entity"
+	"sort"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// needRally 高等级系统城市只能集结攻打
+func needRally(cell entity.CellState) bool {
+	level := basic.BasicConf.Rally.CityLevel
+	return cell.CellType == _map.MapBuildSysCity && level > 0 && cell.Level >= level
+}
+
+// RallyCreate 发起集结，集结点为发起人主城，截止时间到了集结的军队一起出发
+func (s *WorldService) RallyCreate(ctx actor.Context, w *WorldActor, req *messages.HWRallyCreate) *messages.WHRally {
+	now := time.Now()
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+	allianceID := AllianceID(req.AllianceId)
+
+	leaderCity := mainCity(world, playerID)
+	if allianceID <= 0 || leaderCity == nil || leaderCity.AllianceId != allianceID {
+		ctx.Logger().Error("rally leader not in alliance")
+		return nil
+	}
+	target, b := world.GetWorldMap(_map.ToPosition(req.Target.X, req.Target.Y))
+	if !b || (target.Occupancy.Owner == 0 && !isSysBuilding(target)) {
+		ctx.Logger().Error("rally target invalid")
+		return nil
+	}
+	kind := s.attackKindOf(world, playerID, allianceID, target, now)
+	if kind != attackNormal && kind != attackSys {
+		ctx.Logger().Error("rally target can not attack")
+		return nil
+	}
+
+	// 同一个目标同时只能有一个集结
+	rallyID := 1
+	exists := false
+	world.ForEachRallies(func(k int, v entity.RallyState) {
+		rallyID = max(rallyID, k+1)
+		if v.AllianceId == allianceID && v.TargetX == target.Pos.X && v.TargetY == target.Pos.Y {
+			exists = true
+		}
+	})
+	if exists {
+		ctx.Logger().Error("rally target exists")
+		return nil
+	}
+
+	rally := entity.RallyState{
+		Id:         rallyID,
+		AllianceId: allianceID,
+		LeaderId:   playerID,
+		TargetX:    target.Pos.X,
+		TargetY:    target.Pos.Y,
+		RallyX:     leaderCity.Pos.X,
+		RallyY:     leaderCity.Pos.Y,
+		Deadline:   now.Add(time.Duration(basic.BasicConf.Rally.WaitTime) * time.Second),
+	}
+	world.PutRallies(rallyID, rally)
+	return &messages.WHRally{OK: true, Rally: toMessageRally(rally)}
+}
+
+// RallyJoin 军队前往集结点，截止前到不了的不能参加
+func (s *WorldService) RallyJoin(ctx actor.Context, w *WorldActor, req *messages.HWRallyJoin) *messages.WHRallyJoin {
+	now := time.Now()
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	rally, b := world.GetRallies(req.RallyId)
+	if !b {
+		ctx.Logger().Error("rally not found")
+		return nil
+	}
+	city := armyCity(world, playerID, CityID(req.Army.CityId))
+	if city == nil || city.AllianceId != rally.AllianceId {
+		ctx.Logger().Error("rally member not in alliance")
+		return nil
+	}
+	if limit := basic.BasicConf.Rally.MemberLimit; limit > 0 && len(rally.Joined) >= limit {
+		ctx.Logger().Error("rally is full")
+		return nil
+	}
+	for _, m := range rally.Joined {
+		if m.PlayerId == playerID && m.ArmyId == ArmyID(req.Army.Id) {
+			ctx.Logger().Error("army joined rally already")
+			return nil
+		}
+	}
+	end := now.Add(marchDuration(world, city.AllianceId))
+	if end.After(rally.Deadline) {
+		ctx.Logger().Error("army can not arrive before deadline")
+		return nil
+	}
+
+	army := toWorldArmyState(req.Army)
+	army.FromX = city.Pos.X
+	army.FromY = city.Pos.Y
+	army.ToX = rally.RallyX
+	army.ToY = rally.RallyY
+	army.Cmd = entity.ArmyCmdRally
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = end
+
+	armies, b := world.GetArmies(playerID)
+	if !b || armies == nil {
+		armies = make(map[entity.ArmyID]entity.ArmyState)
+	}
+	armies[ArmyID(army.Id)] = army
+	world.PutArmies(playerID, armies)
+	s.dispatchArmyMarch(world, army)
+
+	world.UpdateRallies(rally.Id, func(v *entity.RallyEntity) {
+		v.AppendJoined(entity.RallyMemberState{PlayerId: playerID, ArmyId: ArmyID(army.Id)})
+	})
+	return &messages.WHRallyJoin{
+		OK:        true,
+		RallyPos:  messages.Pos{X: rally.RallyX, Y: rally.RallyY},
+		StartTime: now,
+		EndTime:   end,
+	}
+}
+
+// RallyList 本联盟等待中的集结，按截止时间排序
+func (s *WorldService) RallyList(w *WorldActor, req *messages.HWRallyList) *messages.WHRallyList {
+	out := make([]messages.Rally, 0)
+	w.Entity().ForEachRallies(func(k int, v entity.RallyState) {
+		if v.AllianceId == AllianceID(req.AllianceId) {
+			out = append(out, toMessageRally(v))
+		}
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Deadline != out[j].Deadline {
+			return out[i].Deadline < out[j].Deadline
+		}
+		return out[i].Id < out[j].Id
+	})
+	return &messages.WHRallyList{OK: true, Rallies: out}
+}
+
+// arriveRally 军队到达集结点后原地等待，集结已经出发或取消的直接返回
+func (s *WorldService) arriveRally(sender messageSender, w *WorldActor, army entity.ArmyState, now time.Time) {
+	world := w.Entity()
+	if !s.inRally(world, army) {
+		s.marchBack(sender, w, army, entity.CellState{Pos: entity.PosState{X: army.ToX, Y: army.ToY}}, now)
+		return
+	}
+	world.UpdateArmies(army.PlayerId, func(v map[entity.ArmyID]*entity.ArmyEntity) {
+		if armyEntity, ok := v[ArmyID(army.Id)]; ok {
+			armyEntity.SetState(entity.ArmyStop)
+		}
+	})
+	if updated, ok := GetArmy(world, army.PlayerId, ArmyID(army.Id)); ok {
+		s.pushArmySync(sender, w, updated)
+	}
+}
+
+func (s *WorldService) inRally(world *entity.WorldEntity, army entity.ArmyState) bool {
+	found := false
+	world.ForEachRallies(func(k int, v entity.RallyState) {
+		if v.RallyX != army.ToX || v.RallyY != army.ToY {
+			return
+		}
+		for _, m := range v.Joined {
+			if m.PlayerId == army.PlayerId && m.ArmyId == ArmyID(army.Id) {
+				found = true
+			}
+		}
+	})
+	return found
+}
+
+// departRallies 到了截止时间，集结点上的军队同时出发，同时到达后依次和守军交战；
+// 还在路上的军队直接返回
+func (s *WorldService) departRallies(sender messageSender, w *WorldActor, now time.Time) {
+	world := w.Entity()
+	due := make([]entity.RallyState, 0)
+	world.ForEachRallies(func(k int, v entity.RallyState) {
+		if !v.Deadline.After(now) {
+			due = append(due, v)
+		}
+	})
+	for _, rally := range due {
+		world.DelRallies(rally.Id)
+		end := now.Add(marchDuration(world, rally.AllianceId))
+		for _, m := range rally.Joined {
+			army, ok := GetArmy(world, m.PlayerId, m.ArmyId)
+			if !ok || army.Cmd != entity.ArmyCmdRally {
+				continue
+			}
+			if army.State == entity.ArmyRunning {
+				s.recallRallyArmy(sender, w, army, now)
+				continue
+			}
+			army.FromX, army.FromY = rally.RallyX, rally.RallyY
+			army.ToX, army.ToY = rally.TargetX, rally.TargetY
+			army.Cmd = entity.ArmyCmdAttack
+			army.State = entity.ArmyRunning
+			army.StartTime = now
+			army.EndTime = end
+			army.RallyId = rally.Id
+			s.replaceArmyState(world, army)
+			s.dispatchArmyMarch(world, army)
+			s.pushArmySync(sender, w, army)
+		}
+	}
+}
+
+// recallRallyArmy 没赶上集结的军队从当前位置返回出发的城池
+func (s *WorldService) recallRallyArmy(sender messageSender, w *WorldActor, army entity.ArmyState, now time.Time) {
+	world := w.Entity()
+	if marches, ok := world.GetMarches(army.PlayerId); ok {
+		if march, ok := marches[ArmyID(army.Id)]; ok {
+			s.removeMarchFromIndex(world, march)
+		}
+	}
+	x, y := marchArmyPos(army)
+	army.ToX, army.ToY = army.FromX, army.FromY
+	army.FromX, army.FromY = x, y
+	army.Cmd = entity.ArmyCmdBack
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = now.Add(time.Second * 10)
+	s.replaceArmyState(world, army)
+	s.dispatchArmyMarch(world, army)
+	s.pushArmySync(sender, w, army)
+}
+
+// backFromRally 集结军队返回到集结点后，继续返回自己的城池
+func (s *WorldService) backFromRally(sender messageSender, w *WorldActor, army entity.ArmyState, now time.Time) bool {
+	if army.RallyId <= 0 {
+		return false
+	}
+	army.RallyId = 0
+	city := armyCity(w.Entity(), army.PlayerId, army.CityId)
+	if city == nil || (city.Pos.X == army.ToX && city.Pos.Y == army.ToY) {
+		s.replaceArmyState(w.Entity(), army)
+		return false
+	}
+	army.FromX, army.FromY = army.ToX, army.ToY
+	army.ToX, army.ToY = city.Pos.X, city.Pos.Y
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = now.Add(time.Second * 10)
+	s.replaceArmyState(w.Entity(), army)
+	s.dispatchArmyMarch(w.Entity(), army)
+	s.pushArmySync(sender, w, army)
+	return true
+}
+
+func toMessageRally(r entity.RallyState) messages.Rally {
+	armies := make([]messages.RallyArmy, 0, len(r.Joined))
+	for _, m := range r.Joined {
+		armies = append(armies, messages.RallyArmy{PlayerId: int(m.PlayerId), ArmyId: int(m.ArmyId)})
+	}
+	return messages.Rally{
+		Id:       r.Id,
+		LeaderId: int(r.LeaderId),
+		Target:   messages.Pos{X: r.TargetX, Y: r.TargetY},
+		RallyPos: messages.Pos{X: r.RallyX, Y: r.RallyY},
+		Deadline: timeToMillis(r.Deadline),
+		Armies:   armies,
+	}
+}
@@ -0,0 +1,240 @@
+package actors
+
+import (
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/world/entity"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// reinforceCapacity 可容纳的增援军队数，城池按城池等级，领地按格子等级
+func reinforceCapacity(world *entity.WorldEntity, cell entity.CellState) int {
+	conf := basic.BasicConf.Reinforce
+	capacity := int(cell.Level) * conf.CellPerLevel
+	if cell.CellType == _map.MapPlayerCity {
+		capacity = 0
+		if cities, ok := world.GetCityByPlayer(PlayerID(cell.Occupancy.Owner)); ok {
+			if city, ok := cities[CityID(cell.Occupancy.RefId)]; ok {
+				capacity = int(city.Level) * conf.CityPerLevel
+			}
+		}
+	}
+	if conf.Max > 0 {
+		capacity = min(capacity, conf.Max)
+	}
+	return capacity
+}
+
+// canReinforce 只能增援同联盟其他成员的城池和领地，且还有空位
+func canReinforce(world *entity.WorldEntity, playerID PlayerID, allianceID AllianceID, cell entity.CellState) bool {
+	if cell.Occupancy.Owner <= 0 || PlayerID(cell.Occupancy.Owner) == playerID {
+		return false
+	}
+	if allianceID <= 0 || AllianceID(cell.Occupancy.AllianceId) != allianceID {
+		return false
+	}
+	return len(cell.Occupancy.Reinforces) < reinforceCapacity(world, cell)
+}
+
+// Reinforce 军队前往盟友的格子，到达后加入增援列表
+func (s *WorldService) Reinforce(ctx actor.Context, w *WorldActor, req *messages.HWReinforce) *messages.WHReinforce {
+	now := time.Now()
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	cell, b := world.GetWorldMap(_map.ToPosition(req.Pos.X, req.Pos.Y))
+	if !b {
+		ctx.Logger().Error("reinforce target invalid")
+		return nil
+	}
+	city := armyCity(world, playerID, CityID(req.Army.CityId))
+	if city == nil {
+		ctx.Logger().Error("army city not found")
+		return nil
+	}
+	if !canReinforce(world, playerID, city.AllianceId, cell) {
+		ctx.Logger().Error("can not reinforce")
+		return nil
+	}
+
+	army := toWorldArmyState(req.Army)
+	army.FromX = city.Pos.X
+	army.FromY = city.Pos.Y
+	army.ToX = cell.Pos.X
+	army.ToY = cell.Pos.Y
+	army.Cmd = entity.ArmyCmdReinforce
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = now.Add(marchDuration(world, city.AllianceId))
+
+	armies, b := world.GetArmies(playerID)
+	if !b || armies == nil {
+		armies = make(map[entity.ArmyID]entity.ArmyState)
+	}
+	armies[ArmyID(army.Id)] = army
+	world.PutArmies(playerID, armies)
+	s.dispatchArmyMarch(world, army)
+
+	return &messages.WHReinforce{
+		OK:        true,
+		StartTime: now,
+		EndTime:   army.EndTime,
+	}
+}
+
+// arriveReinforce 到达后再校验一次，满员或已不是盟友则原路返回
+func (s *WorldService) arriveReinforce(sender messageSender, w *WorldActor, army entity.ArmyState, now time.Time) {
+	world := w.Entity()
+	pos := _map.ToPosition(army.ToX, army.ToY)
+	cell, b := world.GetWorldMap(pos)
+	if !b || !canReinforce(world, army.PlayerId, army.AllianceId, cell) {
+		s.marchBack(sender, w, army, entity.CellState{Pos: entity.PosState{X: army.ToX, Y: army.ToY}}, now)
+		return
+	}
+	world.UpdateWorldMap(pos, func(v *entity.CellEntity) {
+		v.UpdateOccupancy(func(o *entity.OccupancyEntity) {
+			o.AppendReinforces(entity.ReinforceState{PlayerId: army.PlayerId, ArmyId: ArmyID(army.Id)})
+		})
+	})
+	army.State = entity.ArmyStop
+	army.CellX, army.CellY = army.ToX, army.ToY
+	s.replaceArmyState(world, army)
+	s.pushArmySync(sender, w, army)
+}
+
+// ReinforceBack 领主或增援方遣返增援军队
+func (s *WorldService) ReinforceBack(ctx actor.Context, w *WorldActor, req *messages.HWReinforceBack) *messages.WHReinforceBack {
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+	cell, b := world.GetWorldMap(_map.ToPosition(req.Pos.X, req.Pos.Y))
+	if !b {
+		ctx.Logger().Error("reinforce cell not found")
+		return nil
+	}
+	if playerID != PlayerID(req.OwnerId) && playerID != PlayerID(cell.Occupancy.Owner) {
+		ctx.Logger().Error("can not send reinforce back")
+		return nil
+	}
+	if !s.sendReinforceBack(ctx, w, cell, PlayerID(req.OwnerId), ArmyID(req.ArmyId), time.Now()) {
+		ctx.Logger().Error("reinforce not found")
+		return nil
+	}
+	return &messages.WHReinforceBack{OK: true}
+}
+
+// sendReinforceBack 从增援列表移除并返回出发的城池
+func (s *WorldService) sendReinforceBack(sender messageSender, w *WorldActor, cell entity.CellState, playerID PlayerID, armyID ArmyID, now time.Time) bool {
+	world := w.Entity()
+	index := -1
+	for i, v := range cell.Occupancy.Reinforces {
+		if v.PlayerId == playerID && v.ArmyId == armyID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false
+	}
+	world.UpdateWorldMap(cell.Id, func(v *entity.CellEntity) {
+		v.UpdateOccupancy(func(o *entity.OccupancyEntity) {
+			o.RemoveReinforcesAt(index)
+		})
+	})
+	if army, ok := GetArmy(world, playerID, armyID); ok && army.Cmd == entity.ArmyCmdReinforce {
+		s.marchBack(sender, w, army, cell, now)
+	}
+	return true
+}
+
+// dismissReinforces 遣返格子上的全部增援，keep 返回 true 的保留
+func (s *WorldService) dismissReinforces(sender messageSender, w *WorldActor, pos int, now time.Time, keep func(army entity.ArmyState) bool) {
+	world := w.Entity()
+	cell, ok := world.GetWorldMap(pos)
+	if !ok {
+		return
+	}
+	for _, v := range cell.Occupancy.Reinforces {
+		army, found := GetArmy(world, v.PlayerId, v.ArmyId)
+		if found && keep != nil && keep(army) {
+			continue
+		}
+		if cur, ok := world.GetWorldMap(pos); ok {
+			s.sendReinforceBack(sender, w, cur, v.PlayerId, v.ArmyId, now)
+		}
+	}
+}
+
+// dismissForeignReinforces 联盟变化后，遣返已不是盟友的增援
+func (s *WorldService) dismissForeignReinforces(sender messageSender, w *WorldActor, now time.Time) {
+	world := w.Entity()
+	cells := make(map[int]AllianceID)
+	world.ForEachWorldMap(func(key int, value entity.CellState) {
+		if len(value.Occupancy.Reinforces) > 0 {
+			cells[key] = AllianceID(value.Occupancy.AllianceId)
+		}
+	})
+	for pos, allianceID := range cells {
+		s.dismissReinforces(sender, w, pos, now, func(army entity.ArmyState) bool {
+			return allianceID > 0 && army.AllianceId == allianceID
+		})
+	}
+}
+
+// defendInTurn 增援的盟友按到达顺序先防守，最后是领主的驻军；
+// 攻方没能取胜就结束，每场都生成战报，战报列出全部参战的防守方
+func (s *WorldService) defendInTurn(ctx actor.Context, w *WorldActor, world *entity.WorldEntity, attacker entity.ArmyState, cell entity.CellState, defenders []entity.ArmyState) messages.BattleResult {
+	origin := attacker
+	owner := PlayerID(cell.Occupancy.Owner)
+	players := []PlayerID{owner}
+	reports := make([]messages.WarReport, 0, len(defenders))
+	result := messages.LOSS
+	for i, defender := range defenders {
+		if i > 0 {
+			// 上一场结算时已改为返程，继续下一场前恢复出征状态
+			attacker.FromX, attacker.FromY = origin.FromX, origin.FromY
+			attacker.ToX, attacker.ToY = origin.ToX, origin.ToY
+			attacker.Cmd, attacker.State = origin.Cmd, origin.State
+			attacker.StartTime, attacker.EndTime = origin.StartTime, origin.EndTime
+		}
+		battleContext := initBattleContext(world, attacker, defender)
+		report := s.battle(world, cell, battleContext)
+		reports = append(reports, report)
+		attacker = *battleContext.Attacker
+		if defender.PlayerId != owner {
+			players = append(players, defender.PlayerId)
+		}
+		s.pushBattleResult(ctx, w, *battleContext.Defender)
+		// 全军覆没的增援撤回
+		if defender.PlayerId != owner && armySoldiers(*battleContext.Defender) <= 0 {
+			if cur, ok := world.GetWorldMap(cell.Id); ok {
+				s.sendReinforceBack(ctx, w, cur, defender.PlayerId, ArmyID(defender.Id), time.Now())
+			}
+		}
+		result = report.Result
+		if result != messages.WIN {
+			break
+		}
+	}
+	s.pushBattleResult(ctx, w, attacker)
+
+	ids := make([]int, 0, len(players))
+	for _, v := range players {
+		ids = append(ids, int(v))
+	}
+	for _, report := range reports {
+		report.Defenders = ids
+		s.pushWarReport(ctx, w, report, append([]PlayerID{attacker.PlayerId}, players...)...)
+	}
+	return result
+}
+
+func armySoldiers(army entity.ArmyState) int {
+	total := 0
+	for _, v := range army.Soldiers {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,160 @@
+package actors
+
+import (
+	sharedactor "ThreeKingdoms/internal/shared/actor"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/building"
+	"ThreeKingdoms/internal/shared/gameconfig/general"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/world/entity"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// 系统建筑守军的武将 id 取负数，和玩家武将区分
+const npcGeneralID = -1
+
+func isSysBuilding(cell entity.CellState) bool {
+	return cell.CellType == _map.MapBuildSysFortress || cell.CellType == _map.MapBuildSysCity
+}
+
+// sysBuildingKind 系统建筑只能由联盟成员攻打，不能攻打本联盟的
+func sysBuildingKind(attackerAlliance AllianceID, cell entity.CellState) attackKind {
+	if attackerAlliance <= 0 || AllianceID(cell.Occupancy.AllianceId) == attackerAlliance {
+		return attackDenied
+	}
+	return attackSys
+}
+
+// sysBattle 先逐队击败守军，守军清空后按破坏力扣耐久；
+// 无主的建筑守军清空即占领，有主的还需要把耐久打空
+func (s *WorldService) sysBattle(ctx actor.Context, w *WorldActor, attacker entity.ArmyState, cell entity.CellState, now time.Time) messages.BattleResult {
+	world := w.Entity()
+	result := messages.WIN
+	if npc := npcArmy(cell); cell.Defender > 0 && hasArmyState(npc) {
+		battleContext := initBattleContext(world, attacker, npc)
+		report := s.battle(world, cell, battleContext)
+		s.pushWarReport(ctx, w, report, attacker.PlayerId)
+		s.pushBattleResult(ctx, w, *battleContext.Attacker)
+		result = report.Result
+		if result == messages.WIN {
+			world.UpdateWorldMap(cell.Id, func(v *entity.CellEntity) {
+				v.SetDefender(max(v.Defender()-1, 0))
+			})
+		}
+	} else {
+		result = s.startBattle(ctx, w, world, attacker, cell)
+	}
+
+	cur, ok := world.GetWorldMap(cell.Id)
+	if !ok || result != messages.WIN || cur.Defender > 0 {
+		return result
+	}
+	if cur.Occupancy.AllianceId <= 0 || cur.CurDurable <= 0 {
+		s.captureSysBuilding(ctx, w, cur, attacker, now)
+	}
+	return result
+}
+
+// captureSysBuilding 系统建筑归攻方联盟，耐久和守军恢复
+func (s *WorldService) captureSysBuilding(sender messageSender, w *WorldActor, cell entity.CellState, attacker entity.ArmyState, now time.Time) {
+	world := w.Entity()
+	allianceName := ""
+	if city := mainCity(world, attacker.PlayerId); city != nil {
+		allianceName = city.AllianceName
+	}
+	defender := 0
+	if cfg := building.BuildingConf.GetCfg(cell.CellType, cell.Level); cfg != nil {
+		defender = cfg.Defender
+	}
+	oldAlliance := cell.Occupancy.AllianceId
+	world.UpdateWorldMap(cell.Id, func(v *entity.CellEntity) {
+		v.SetOccupancy(entity.OccupancyState{
+			Kind:         cell.CellType,
+			AllianceId:   int(attacker.AllianceId),
+			AllianceName: allianceName,
+		})
+		v.SetOccupyTime(now)
+		v.SetGiveUpTime(time.Time{})
+		v.SetCurDurable(v.MaxDurable())
+		v.SetDefender(defender)
+	})
+
+	s.reportAllianceLog(sender, w, oldAlliance, messages.ALLIANCE_LOG_LOSE, cell)
+	s.reportAllianceLog(sender, w, int(attacker.AllianceId), messages.ALLIANCE_LOG_CAPTURE, cell)
+
+	alliancePID, ok := w.ResolveManagerPID(sharedactor.ManagerPIDAlliance)
+	if !ok || alliancePID == nil {
+		return
+	}
+	worldID := 0
+	if wid := w.WorldID(); wid != nil {
+		worldID = int(*wid)
+	}
+	sender.Send(alliancePID, &messages.WASysBuildingChanged{
+		WorldId:    worldID,
+		AllianceId: int(attacker.AllianceId),
+		Building: messages.SysBuilding{
+			Pos:   messages.Pos{X: cell.Pos.X, Y: cell.Pos.Y},
+			Kind:  cell.CellType,
+			Level: cell.Level,
+		},
+	})
+}
+
+// npcArmy 按建筑等级生成一队守军，武将按格子下标从配置中轮流选取
+func npcArmy(cell entity.CellState) entity.ArmyState {
+	soldiers := basic.BasicConf.SysBuild.NpcSoldiers(cell.Level)
+	list := general.General.GList
+	if soldiers <= 0 || len(list) == 0 {
+		return entity.ArmyState{}
+	}
+	army := entity.ArmyState{
+		Generals: make([]entity.GeneralState, 0, basic.ArmyGCnt),
+		Soldiers: make([]int, 0, basic.ArmyGCnt),
+		CellX:    cell.Pos.X,
+		CellY:    cell.Pos.Y,
+	}
+	for i := 0; i < basic.ArmyGCnt; i++ {
+		cfg := list[(cell.Id+i)%len(list)]
+		army.Generals = append(army.Generals, entity.GeneralState{
+			Id:            npcGeneralID - i,
+			CfgId:         cfg.CfgId,
+			Level:         cell.Level,
+			ForceAdded:    cfg.ForceGrow * int(cell.Level),
+			StrategyAdded: cfg.StrategyGrow * int(cell.Level),
+			DefenseAdded:  cfg.DefenseGrow * int(cell.Level),
+			SpeedAdded:    cfg.SpeedGrow * int(cell.Level),
+		})
+		army.Soldiers = append(army.Soldiers, soldiers)
+	}
+	return army
+}
+
+// allianceSpeed 联盟占领的系统建筑提供的行军速度加成，百分比
+func allianceSpeed(world *entity.WorldEntity, allianceID AllianceID) int {
+	if allianceID <= 0 {
+		return 0
+	}
+	speed := 0
+	for pos := range _map.MapConf.SysBuilding {
+		cell, ok := world.GetWorldMap(pos)
+		if !ok || AllianceID(cell.Occupancy.AllianceId) != allianceID {
+			continue
+		}
+		speed += basic.BasicConf.SysBuild.BonusOf(cell.CellType).Speed
+	}
+	return speed
+}
+
+// marchDuration 出征的行军时间，按联盟速度加成缩短
+func marchDuration(world *entity.WorldEntity, allianceID AllianceID) time.Duration {
+	base := time.Second * 10
+	speed := allianceSpeed(world, allianceID)
+	if speed <= 0 {
+		return base
+	}
+	return base * 100 / time.Duration(100+speed)
+}
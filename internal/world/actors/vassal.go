@@ -0,0 +1,213 @@
+package actors
+
+import (
+	sharedactor "ThreeKingdoms/internal/shared/actor"
+	"ThreeKingdoms/internal/shared/actor/messages"
+	"ThreeKingdoms/internal/shared/gameconfig/basic"
+	"ThreeKingdoms/internal/shared/gameconfig/map"
+	"ThreeKingdoms/internal/shared/logs"
+	"ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+// attackKind 攻击目标的判定结果
+type attackKind int8
+
+const (
+	attackDenied   attackKind = iota // 不能攻击
+	attackNormal                     // 普通攻击
+	attackRebel                      // 附庸攻打上级联盟成员的主城，胜利后脱离
+	attackLiberate                   // 盟友解救沦陷的主城，到达即脱离
+	attackSys                        // 攻打系统城市或要塞
+)
+
+// vassalOf 玩家所属的上级联盟，未沦陷或已到期返回 0
+func vassalOf(world *entity.WorldEntity, playerID PlayerID, now time.Time) int {
+	city := mainCity(world, playerID)
+	if city == nil || city.ParentId <= 0 || !city.VassalEnd.After(now) {
+		return 0
+	}
+	return city.ParentId
+}
+
+// isMainCityCell 格子是否为领主的主城
+func isMainCityCell(world *entity.WorldEntity, cell entity.CellState) bool {
+	if cell.CellType != _map.MapPlayerCity || cell.Occupancy.Owner <= 0 {
+		return false
+	}
+	city := mainCity(world, PlayerID(cell.Occupancy.Owner))
+	return city != nil && city.Pos.X == cell.Pos.X && city.Pos.Y == cell.Pos.Y
+}
+
+// attackKindOf 替代 CanAttack，加入附庸的判定：
+// 附庸不能攻打上级联盟成员，只能反叛对方主城；盟友之间只能解救沦陷的主城；
+// 停战和互不侵犯的联盟之间不能攻打
+func (s *WorldService) attackKindOf(world *entity.WorldEntity, attackerID PlayerID, attackerAlliance AllianceID, cell entity.CellState, now time.Time) attackKind {
+	defenderID := PlayerID(cell.Occupancy.Owner)
+	defenderAlliance := AllianceID(cell.Occupancy.AllianceId)
+	if attackerID == defenderID {
+		return attackDenied
+	}
+	if parentID := vassalOf(world, attackerID, now); parentID > 0 && AllianceID(parentID) == defenderAlliance {
+		if isMainCityCell(world, cell) {
+			return attackRebel
+		}
+		return attackDenied
+	}
+	if isSysBuilding(cell) {
+		if atPeace(world, attackerAlliance, defenderAlliance, now) {
+			return attackDenied
+		}
+		return sysBuildingKind(attackerAlliance, cell)
+	}
+	if !s.CanAttack(world, attackerID, defenderID, attackerAlliance, defenderAlliance, now) {
+		if attackerAlliance == defenderAlliance && vassalOf(world, defenderID, now) > 0 && isMainCityCell(world, cell) {
+			return attackLiberate
+		}
+		return attackDenied
+	}
+	return attackNormal
+}
+
+// afterBattle 战斗结算后处理沦陷：主城耐久打空则沦为攻方联盟的附庸，反叛胜利则脱离
+func (s *WorldService) afterBattle(sender messageSender, w *WorldActor, attacker entity.ArmyState, defender entity.CellState, kind attackKind, result messages.BattleResult, now time.Time) {
+	world := w.Entity()
+	if kind == attackRebel {
+		if result == messages.WIN {
+			s.setVassal(sender, w, attacker.PlayerId, 0, time.Time{})
+		}
+		return
+	}
+	if attacker.AllianceId <= 0 || !isMainCityCell(world, defender) {
+		return
+	}
+	cell, ok := world.GetWorldMap(defender.Id)
+	if !ok || cell.CurDurable > 0 {
+		return
+	}
+	defenderID := PlayerID(cell.Occupancy.Owner)
+	if vassalOf(world, defenderID, now) > 0 {
+		return
+	}
+	duration := time.Duration(basic.BasicConf.Vassal.Duration) * time.Second
+	if duration <= 0 {
+		return
+	}
+	// 沦陷后主城耐久回满，沦陷期间不会被再次打下
+	DurableChange(world, cell, cell.MaxDurable)
+	s.setVassal(sender, w, defenderID, int(attacker.AllianceId), now.Add(duration))
+}
+
+// setVassal 修改玩家的沦陷状态，parentID 为 0 表示脱离
+func (s *WorldService) setVassal(sender messageSender, w *WorldActor, playerID PlayerID, parentID int, end time.Time) {
+	world := w.Entity()
+	main := mainCity(world, playerID)
+	if main == nil {
+		return
+	}
+	oldParent := main.ParentId
+	s.applyVassal(sender, w, playerID, parentID, end)
+
+	if cell, ok := world.GetWorldMap(_map.ToPosition(main.Pos.X, main.Pos.Y)); ok {
+		if parentID > 0 {
+			s.reportAllianceLog(sender, w, parentID, messages.ALLIANCE_LOG_CAPTURE, cell)
+		} else {
+			s.reportAllianceLog(sender, w, oldParent, messages.ALLIANCE_LOG_LOSE, cell)
+		}
+	}
+}
+
+// releaseVassalsOf 上级联盟解散，它的附庸直接脱离。联盟已不存在，不再记联盟动态
+func (s *WorldService) releaseVassalsOf(sender messageSender, w *WorldActor, allianceID int) {
+	if allianceID <= 0 {
+		return
+	}
+	for playerID := range w.vassals {
+		if main := mainCity(w.Entity(), playerID); main != nil && main.ParentId == allianceID {
+			s.applyVassal(sender, w, playerID, 0, time.Time{})
+		}
+	}
+}
+
+// applyVassal 写入沦陷状态并通知玩家和视野内的玩家
+func (s *WorldService) applyVassal(sender messageSender, w *WorldActor, playerID PlayerID, parentID int, end time.Time) {
+	world := w.Entity()
+	world.UpdateCityByPlayer(playerID, func(value map[CityID]*entity.CityEntity) {
+		for _, city := range value {
+			if city == nil {
+				continue
+			}
+			city.SetParentId(parentID)
+			city.SetVassalEnd(end)
+		}
+	})
+	cells := make([]int, 0)
+	world.ForEachWorldMap(func(key int, value entity.CellState) {
+		if PlayerID(value.Occupancy.Owner) == playerID {
+			cells = append(cells, key)
+		}
+	})
+	for _, key := range cells {
+		world.UpdateWorldMap(key, func(value *entity.CellEntity) {
+			value.UpdateOccupancy(func(o *entity.OccupancyEntity) {
+				o.SetParentId(parentID)
+			})
+		})
+	}
+	if parentID > 0 {
+		w.vassals[playerID] = end
+	} else {
+		delete(w.vassals, playerID)
+	}
+	s.pushVassalCities(sender, w, playerID)
+	s.notifyVassal(sender, w, playerID, parentID, end)
+}
+
+// releaseVassal 释放到期的附庸
+func (s *WorldService) releaseVassal(sender messageSender, w *WorldActor, now time.Time) {
+	for playerID, end := range w.vassals {
+		if end.After(now) {
+			continue
+		}
+		s.setVassal(sender, w, playerID, 0, time.Time{})
+	}
+}
+
+func (s *WorldService) pushVassalCities(sender messageSender, w *WorldActor, playerID PlayerID) {
+	worldPID := w.WorldPID()
+	if sender == nil || worldPID == nil {
+		return
+	}
+	cities, ok := w.Entity().GetCityByPlayer(playerID)
+	if !ok {
+		return
+	}
+	for _, city := range cities {
+		if batch := buildCityPushBatch(w, playerID, city, city.Pos); batch != nil && len(batch.Items) > 0 {
+			sender.Send(worldPID, batch)
+		}
+	}
+}
+
+func (s *WorldService) notifyVassal(sender messageSender, w *WorldActor, playerID PlayerID, parentID int, end time.Time) {
+	if sender == nil {
+		return
+	}
+	playerManagerPID, ok := w.ResolveManagerPID(sharedactor.ManagerPIDPlayer)
+	if !ok || playerManagerPID == nil {
+		logs.Warn("player manager actor pid is nil, skip vassal notify")
+		return
+	}
+	worldID := 0
+	if wid := w.WorldID(); wid != nil {
+		worldID = int(*wid)
+	}
+	sender.Send(playerManagerPID, &messages.WHVassalChanged{
+		PlayerBaseMessage: messages.PlayerBaseMessage{
+			WorldId:  worldID,
+			PlayerId: int(playerID),
+		},
+		ParentId:  parentID,
+		VassalEnd: end,
+	})
+}
@@ -34,6 +34,10 @@ type WorldActor struct {
 	flushStop  chan struct{}
 	// 玩家的视野
 	PlayerView map[PlayerID]View
+	// 放弃中的领地 pos -> 生效时间
+	giveUps map[int]time.Time
+	// 沦陷中的玩家 -> 到期时间
+	vassals map[PlayerID]time.Time
 }
 
 func NewWorldActor(worldID WorldID, repo port.WorldRepository, resolver sharedactor.ManagerPIDResolver) *WorldActor {
@@ -43,6 +47,9 @@ func NewWorldActor(worldID WorldID, repo port.WorldRepository, resolver sharedac
 		dc:         dc.NewWorldDC(repo),
 		resolver:   resolver,
 		dispatcher: NewDispatcher(),
+		PlayerView: make(map[PlayerID]View),
+		giveUps:    make(map[int]time.Time),
+		vassals:    make(map[PlayerID]time.Time),
 	}
 }
 
@@ -82,6 +89,9 @@ func (w *WorldActor) Receive(ctx actor.Context) {
 		}
 		// 检查
 		WS.march(ctx, w)
+		WS.releaseGiveUp(ctx, w, time.Now())
+		WS.releaseVassal(ctx, w, time.Now())
+		WS.departRallies(ctx, w, time.Now())
 		return
 	case messages.WorldMessage:
 		if msg == nil {
@@ -123,6 +133,19 @@ func (w *WorldActor) init(actorCtx actor.Context) {
 		_ = w.dc.FlushSync(context.TODO())
 	}
 
+	e.ForEachWorldMap(func(key int, value entity.CellState) {
+		if !value.GiveUpTime.IsZero() {
+			w.giveUps[key] = value.GiveUpTime
+		}
+	})
+	e.ForEachCityByPlayer(func(key PlayerID, value map[CityID]entity.CityState) {
+		for _, city := range value {
+			if city.IsMain && city.ParentId > 0 {
+				w.vassals[key] = city.VassalEnd
+			}
+		}
+	})
+
 	w.state = Online
 	w.entity = e
 	w.startFlushLoop(actorCtx)
@@ -203,26 +226,31 @@ func (w *WorldActor) buildInitialMap() map[int]entity.CellState {
 	mapConf := _map.MapConf
 	cells := make(map[int]entity.CellState)
 	for _, v := range mapConf.Confs {
-		// 获取此地块的配置
-		cfg := building.BuildingConf.GetCfg(v.Type, v.Level)
-		if cfg == nil {
-			panic("build conf not found")
-		}
-		cell := entity.CellState{
-			Id:         v.Cid,
-			Pos:        entity.PosState{X: v.X, Y: v.Y},
-			CellType:   v.Type,
-			Level:      v.Level,
-			Name:       cfg.Name,
-			Wood:       cfg.Wood,
-			Iron:       cfg.Iron,
-			Stone:      cfg.Stone,
-			Grain:      cfg.Grain,
-			MaxDurable: cfg.Durable,
-			CurDurable: cfg.Durable,
-			Defender:   cfg.Defender,
-		}
+		cell := newMapCell(v)
 		cells[cell.Id] = cell
 	}
 	return cells
 }
+
+// newMapCell 按地图配置生成初始地块，迁城后旧址也用它还原
+func newMapCell(v _map.MapCell) entity.CellState {
+	// 获取此地块的配置
+	cfg := building.BuildingConf.GetCfg(v.Type, v.Level)
+	if cfg == nil {
+		panic("build conf not found")
+	}
+	return entity.CellState{
+		Id:         v.Cid,
+		Pos:        entity.PosState{X: v.X, Y: v.Y},
+		CellType:   v.Type,
+		Level:      v.Level,
+		Name:       cfg.Name,
+		Wood:       cfg.Wood,
+		Iron:       cfg.Iron,
+		Stone:      cfg.Stone,
+		Grain:      cfg.Grain,
+		MaxDurable: cfg.Durable,
+		CurDurable: cfg.Durable,
+		Defender:   cfg.Defender,
+	}
+}
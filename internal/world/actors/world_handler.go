@@ -4,6 +4,7 @@ import (
 	"ThreeKingdoms/internal/shared/actor/messages"
 	"ThreeKingdoms/internal/world/entity"
 	"sort"
+	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 )
@@ -55,6 +56,10 @@ func (h *WorldHandler) HandleHWScanBlock(ctx actor.Context, w *WorldActor, reque
 	ctx.Respond(WS.ScanBlock(w, request))
 }
 
+func (h *WorldHandler) HandleHWClearView(ctx actor.Context, w *WorldActor, request *messages.HWClearView) {
+	delete(w.PlayerView, PlayerID(request.PlayerId))
+}
+
 func (h *WorldHandler) HandleHWAttack(ctx actor.Context, w *WorldActor, req *messages.HWAttack) {
 	attack := WS.Attack(ctx, w, req)
 	if attack == nil {
@@ -75,6 +80,117 @@ func (h *WorldHandler) HandleHWBack(ctx actor.Context, w *WorldActor, req *messa
 	ctx.Respond(back)
 }
 
+func (h *WorldHandler) HandleHWReclamation(ctx actor.Context, w *WorldActor, req *messages.HWReclamation) {
+	reclamation := WS.Reclamation(ctx, w, req)
+	if reclamation == nil {
+		reclamation = &messages.WHReclamation{
+			OK: false,
+		}
+	}
+	ctx.Respond(reclamation)
+}
+
+func (h *WorldHandler) HandleHWGiveUp(ctx actor.Context, w *WorldActor, req *messages.HWGiveUp) {
+	giveUp := WS.GiveUp(ctx, w, req)
+	if giveUp == nil {
+		giveUp = &messages.WHGiveUp{
+			OK: false,
+		}
+	}
+	ctx.Respond(giveUp)
+}
+
+func (h *WorldHandler) HandleHWCreateSubCity(ctx actor.Context, w *WorldActor, req *messages.HWCreateSubCity) {
+	subCity := WS.CreateSubCity(ctx, w, req)
+	if subCity == nil {
+		subCity = &messages.WHCreateSubCity{
+			OK: false,
+		}
+	}
+	ctx.Respond(subCity)
+}
+
+func (h *WorldHandler) HandleHWMoveCity(ctx actor.Context, w *WorldActor, req *messages.HWMoveCity) {
+	moveCity := WS.MoveCity(ctx, w, req)
+	if moveCity == nil {
+		moveCity = &messages.WHMoveCity{
+			OK: false,
+		}
+	}
+	ctx.Respond(moveCity)
+}
+
+func (h *WorldHandler) HandleHWSyncAlliance(ctx actor.Context, w *WorldActor, req *messages.HWSyncAlliance) {
+	resp := WS.SyncAlliance(w.Entity(), req)
+	if resp.OK {
+		WS.dismissForeignReinforces(ctx, w, time.Now())
+	}
+	ctx.Respond(resp)
+}
+
+func (h *WorldHandler) HandleHWMarketTrade(ctx actor.Context, w *WorldActor, req *messages.HWMarketTrade) {
+	ctx.Respond(WS.MarketTrade(w, req))
+}
+
 func (h *WorldHandler) HandleHWSyncCityFacility(ctx actor.Context, w *WorldActor, req *messages.HWSyncCityFacility) {
 	ctx.Respond(WS.SyncCityFacility(w.Entity(), req))
 }
+
+func (h *WorldHandler) HandleHWTeleportArmy(ctx actor.Context, w *WorldActor, req *messages.HWTeleportArmy) {
+	ctx.Respond(WS.TeleportArmy(w.Entity(), req))
+}
+
+func (h *WorldHandler) HandleHWRallyCreate(ctx actor.Context, w *WorldActor, req *messages.HWRallyCreate) {
+	rally := WS.RallyCreate(ctx, w, req)
+	if rally == nil {
+		rally = &messages.WHRally{
+			OK: false,
+		}
+	}
+	ctx.Respond(rally)
+}
+
+func (h *WorldHandler) HandleHWRallyJoin(ctx actor.Context, w *WorldActor, req *messages.HWRallyJoin) {
+	join := WS.RallyJoin(ctx, w, req)
+	if join == nil {
+		join = &messages.WHRallyJoin{
+			OK: false,
+		}
+	}
+	ctx.Respond(join)
+}
+
+func (h *WorldHandler) HandleHWRallyList(ctx actor.Context, w *WorldActor, req *messages.HWRallyList) {
+	ctx.Respond(WS.RallyList(w, req))
+}
+
+func (h *WorldHandler) HandleHWReinforce(ctx actor.Context, w *WorldActor, req *messages.HWReinforce) {
+	reinforce := WS.Reinforce(ctx, w, req)
+	if reinforce == nil {
+		reinforce = &messages.WHReinforce{
+			OK: false,
+		}
+	}
+	ctx.Respond(reinforce)
+}
+
+func (h *WorldHandler) HandleHWReinforceBack(ctx actor.Context, w *WorldActor, req *messages.HWReinforceBack) {
+	back := WS.ReinforceBack(ctx, w, req)
+	if back == nil {
+		back = &messages.WHReinforceBack{
+			OK: false,
+		}
+	}
+	ctx.Respond(back)
+}
+
+// HandleAWDiplomacy 联盟外交变更，只记录不应答
+func (h *WorldHandler) HandleAWDiplomacy(ctx actor.Context, w *WorldActor, req *messages.AWDiplomacy) {
+	WS.SetDiplomacy(w, req)
+}
+
+// HandleAWAllianceDismissed 联盟解散，只清理不应答
+func (h *WorldHandler) HandleAWAllianceDismissed(ctx actor.Context, w *WorldActor, req *messages.AWAllianceDismissed) {
+	WS.ClearDiplomacy(w.Entity(), AllianceID(req.AllianceId))
+	WS.releaseVassalsOf(ctx, w, req.AllianceId)
+}
@@ -29,22 +29,18 @@ func (p *GRPCWorldPushBatchPusher) PushWorldPushBatch(ctx context.Context, batch
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	items := make([]*gatepb.WorldPushItem, 0, len(batch.Items))
+	items := make([]*gatepb.PushItem, 0, len(batch.Items))
 	for _, item := range batch.Items {
-		if item.Army == nil {
+		if item == nil || item.Payload == nil {
 			continue
 		}
-		items = append(items, &gatepb.WorldPushItem{
-			PlayerId: item.PlayerID,
-			Army:     item.Army,
-		})
+		items = append(items, item)
 	}
 	if len(items) == 0 {
 		return nil
 	}
-	resp, err := p.client.PushWorldBatch(ctx, &gatepb.PushWorldBatchRequest{
+	resp, err := p.client.PushBatch(ctx, &gatepb.PushBatchRequest{
 		WorldId: int32(batch.WorldId),
-		MsgType: string(batch.MsgType),
 		Items:   items,
 	})
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"ThreeKingdoms/internal/shared/gameconfig/general"
 	"ThreeKingdoms/internal/shared/gameconfig/map"
 	"ThreeKingdoms/internal/shared/gameconfig/skill"
+	gatepb "ThreeKingdoms/internal/shared/gen/gate"
 	playerpb "ThreeKingdoms/internal/shared/gen/player"
 	"ThreeKingdoms/internal/shared/logs"
 	"ThreeKingdoms/internal/shared/utils"
@@ -80,13 +81,188 @@ func (s *WorldService) CreateCity(e *entity.WorldEntity, request *messages.HWCre
 		v.Occupancy().SetRefId(request.PlayerId)
 		v.Occupancy().SetOwner(request.PlayerId)
 		v.Occupancy().SetRoleNick(request.NickName)
-		v.Occupancy().SetAllianceId(request.PlayerId)
+		v.Occupancy().SetAllianceId(request.AllianceId)
 		v.Occupancy().SetAllianceName(request.AllianceName)
 		// garrison & parentId
 	})
 	return &messages.WHCreateCity{CityId: int(id), X: x, Y: y}
 }
 
+// CreateSubCity 在自己的领地上建分城
+func (s *WorldService) CreateSubCity(ctx actor.Context, w *WorldActor, req *messages.HWCreateSubCity) *messages.WHCreateSubCity {
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	main := mainCity(world, playerID)
+	if main == nil {
+		ctx.Logger().Error("main city not found")
+		return nil
+	}
+
+	pos := _map.ToPosition(req.Pos.X, req.Pos.Y)
+	cell, b := world.GetWorldMap(pos)
+	if !b || PlayerID(cell.Occupancy.Owner) != playerID {
+		ctx.Logger().Error("sub city target is not own territory")
+		return nil
+	}
+	if cell.CellType == _map.MapPlayerCity || !cell.GiveUpTime.IsZero() {
+		ctx.Logger().Error("sub city target is unavailable")
+		return nil
+	}
+	if !canBuildSubCity(world, req.Pos.X, req.Pos.Y) {
+		ctx.Logger().Error("sub city too close to other city")
+		return nil
+	}
+
+	id, err := utils.NextSnowflakeID()
+	if err != nil {
+		ctx.Logger().Error("sub city id", "err", err)
+		return nil
+	}
+	cityID := CityID(id)
+	cities, _ := world.GetCityByPlayer(playerID)
+	cities[cityID] = CityState{
+		CityId:       cityID,
+		Name:         req.Name,
+		AllianceId:   main.AllianceId,
+		AllianceName: main.AllianceName,
+		Pos:          entity.PosState{X: req.Pos.X, Y: req.Pos.Y},
+		IsMain:       false,
+		Level:        1,
+		CurDurable:   basic.BasicConf.City.Durable,
+		MaxDurable:   basic.BasicConf.City.Durable,
+		Facility:     toWorldFacilityStates(req.Facilities),
+	}
+	world.PutCityByPlayer(playerID, cities)
+
+	world.UpdateWorldMap(pos, func(v *entity.CellEntity) {
+		v.SetMaxDurable(basic.BasicConf.City.Durable)
+		v.SetCurDurable(basic.BasicConf.City.Durable)
+		v.SetCellType(_map.MapPlayerCity)
+		v.Occupancy().SetKind(int8(_map.MapPlayerCity))
+	})
+	return &messages.WHCreateSubCity{OK: true, CityId: int(id)}
+}
+
+// 分城建在自己的领地上，只要求周围 5 格内没有其他城池
+func canBuildSubCity(w *entity.WorldEntity, x int, y int) bool {
+	if x+1 >= _map.MapWidth || y+1 >= _map.MapHeight || y-1 < 0 || x-1 < 0 {
+		return false
+	}
+	for i := x - 5; i <= x+5; i++ {
+		for j := y - 5; j <= y+5; j++ {
+			cell, ok := w.GetWorldMap(_map.ToPosition(i, j))
+			if !ok {
+				continue
+			}
+			if IsSysBuilding(cell.Occupancy.Kind) || cell.Occupancy.Kind == _map.MapPlayerCity {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MoveCity 迁移主城：旧址还原为野地，新址按建城规则占据，并通知附近的视野
+func (s *WorldService) MoveCity(ctx actor.Context, w *WorldActor, req *messages.HWMoveCity) *messages.WHMoveCity {
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	main := mainCity(world, playerID)
+	if main == nil {
+		ctx.Logger().Error("main city not found")
+		return nil
+	}
+	if !CanBuildCity(world, req.Pos.X, req.Pos.Y) {
+		ctx.Logger().Error("can not build city here")
+		return nil
+	}
+	// 主城出征中的军队回城会找不到家
+	if armies, b := world.GetArmies(playerID); b {
+		for _, army := range armies {
+			if army.CityId == main.CityId && army.State == entity.ArmyRunning {
+				ctx.Logger().Error("army is running")
+				return nil
+			}
+		}
+	}
+
+	oldPos := _map.ToPosition(main.Pos.X, main.Pos.Y)
+	// 迁城后增援留在原地没有意义，先全部遣返
+	s.dismissReinforces(ctx, w, oldPos, time.Now(), nil)
+	oldCell, _ := world.GetWorldMap(oldPos)
+	if conf, ok := _map.MapConf.Confs[oldPos]; ok {
+		world.PutWorldMap(oldPos, newMapCell(conf))
+	}
+
+	newPos := _map.ToPosition(req.Pos.X, req.Pos.Y)
+	world.UpdateWorldMap(newPos, func(v *entity.CellEntity) {
+		v.SetMaxDurable(basic.BasicConf.City.Durable)
+		v.SetCurDurable(basic.BasicConf.City.Durable)
+		v.SetCellType(_map.MapPlayerCity)
+		v.SetOccupancy(oldCell.Occupancy)
+	})
+
+	world.UpdateCityByPlayer(playerID, func(value map[CityID]*entity.CityEntity) {
+		city, ok := value[main.CityId]
+		if !ok || city == nil {
+			return
+		}
+		city.SetPos(entity.PosState{X: req.Pos.X, Y: req.Pos.Y})
+		city.SetCurDurable(basic.BasicConf.City.Durable)
+		city.SetOccupyTime(time.Time{})
+	})
+	// 主城的军队以新址为家：停在外面的（增援、驻守）回城时走到新址，空闲的跟着迁移
+	world.UpdateArmies(playerID, func(value map[ArmyID]*entity.ArmyEntity) {
+		for _, army := range value {
+			if army == nil || army.CityId() != main.CityId {
+				continue
+			}
+			army.SetFromX(req.Pos.X)
+			army.SetFromY(req.Pos.Y)
+			if army.Cmd() == entity.ArmyCmdIdle {
+				army.SetCellX(req.Pos.X)
+				army.SetCellY(req.Pos.Y)
+				army.SetToX(req.Pos.X)
+				army.SetToY(req.Pos.Y)
+			}
+		}
+	})
+
+	moved := *main
+	moved.Pos = entity.PosState{X: req.Pos.X, Y: req.Pos.Y}
+	moved.CurDurable = basic.BasicConf.City.Durable
+	if batch := buildCityPushBatch(w, playerID, moved, main.Pos); batch != nil && len(batch.Items) > 0 {
+		if worldPID := w.WorldPID(); worldPID != nil {
+			ctx.Send(worldPID, batch)
+		}
+	}
+	return &messages.WHMoveCity{OK: true, CityId: int(main.CityId)}
+}
+
+// TeleportArmy 运营把军队送回所属城池，只处理停在原地的空闲军队；world 还没记录这支军队时无需同步
+func (s *WorldService) TeleportArmy(world *entity.WorldEntity, req *messages.HWTeleportArmy) *messages.WHTeleportArmy {
+	resp := &messages.WHTeleportArmy{OK: false}
+	if world == nil || req == nil || req.PlayerId <= 0 {
+		return resp
+	}
+	playerID := PlayerID(req.PlayerId)
+	army, ok := GetArmy(world, playerID, ArmyID(req.ArmyId))
+	if !ok {
+		resp.OK = true
+		return resp
+	}
+	if army.CityId != CityID(req.CityId) || army.Cmd != entity.ArmyCmdIdle || army.State != entity.ArmyStop {
+		return resp
+	}
+	army.CellX, army.CellY = req.Pos.X, req.Pos.Y
+	army.FromX, army.FromY = req.Pos.X, req.Pos.Y
+	army.ToX, army.ToY = req.Pos.X, req.Pos.Y
+	s.replaceArmyState(world, army)
+	resp.OK = true
+	return resp
+}
+
 func (s *WorldService) SyncCityFacility(world *entity.WorldEntity, req *messages.HWSyncCityFacility) *messages.WHSyncCityFacility {
 	resp := &messages.WHSyncCityFacility{OK: false}
 	if world == nil || req == nil || req.PlayerId <= 0 || req.CityId <= 0 {
@@ -115,6 +291,49 @@ func (s *WorldService) SyncCityFacility(world *entity.WorldEntity, req *messages
 	return resp
 }
 
+// SyncAlliance 玩家联盟变化后，同步其城池、领地和军队的联盟归属
+func (s *WorldService) SyncAlliance(world *entity.WorldEntity, req *messages.HWSyncAlliance) *messages.WHSyncAlliance {
+	resp := &messages.WHSyncAlliance{OK: false}
+	if world == nil || req == nil || req.PlayerId <= 0 {
+		return resp
+	}
+	playerID := PlayerID(req.PlayerId)
+	allianceID := AllianceID(req.AllianceId)
+
+	world.UpdateCityByPlayer(playerID, func(value map[CityID]*entity.CityEntity) {
+		for _, city := range value {
+			if city == nil {
+				continue
+			}
+			city.SetAllianceId(allianceID)
+			city.SetAllianceName(req.AllianceName)
+		}
+	})
+	cells := make([]int, 0)
+	world.ForEachWorldMap(func(key int, value entity.CellState) {
+		if PlayerID(value.Occupancy.Owner) == playerID {
+			cells = append(cells, key)
+		}
+	})
+	for _, key := range cells {
+		world.UpdateWorldMap(key, func(value *entity.CellEntity) {
+			value.UpdateOccupancy(func(o *entity.OccupancyEntity) {
+				o.SetAllianceId(req.AllianceId)
+				o.SetAllianceName(req.AllianceName)
+			})
+		})
+	}
+	world.UpdateArmies(playerID, func(value map[ArmyID]*entity.ArmyEntity) {
+		for _, army := range value {
+			if army != nil {
+				army.SetAllianceId(allianceID)
+			}
+		}
+	})
+	resp.OK = true
+	return resp
+}
+
 func (s *WorldService) ScanBlock(w *WorldActor, request *messages.HWScanBlock) *messages.WHScanBlock {
 	if request == nil {
 		return &messages.WHScanBlock{}
@@ -208,35 +427,30 @@ func (s *WorldService) Attack(ctx actor.Context, w *WorldActor, req *messages.HW
 
 	defenderPos := _map.ToPosition(req.DefenderPos.X, req.DefenderPos.Y)
 	defenderCell, b := world.GetWorldMap(defenderPos)
-	if !b || defenderCell.Occupancy.Owner == 0 {
+	if !b || (defenderCell.Occupancy.Owner == 0 && !isSysBuilding(defenderCell)) {
 		ctx.Logger().Error("request param invalid")
 		return nil
 	}
 
-	attackerCities, b := world.GetCityByPlayer(playerID)
-	if !b {
-		ctx.Logger().Error("request param invalid")
-		return nil
-	}
-	var attackerCity *entity.CityState
-	for _, city := range attackerCities {
-		if city.IsMain {
-			attackerCity = &city
-		}
-	}
+	attackerCity := armyCity(world, playerID, CityID(req.Army.CityId))
 	if attackerCity == nil {
 		ctx.Logger().Error("attacker city not found")
 		return nil
 	}
 
-	// 自己的城池 和联盟的城池 都不能攻击
-	if !s.CanAttack(PlayerID(req.PlayerID()), PlayerID(defenderCell.Occupancy.Owner), attackerCity.AllianceId, AllianceID(defenderCell.Occupancy.AllianceId)) {
+	// 自己的城池 和联盟的城池 都不能攻击，附庸只能反叛，盟友只能解救
+	kind := s.attackKindOf(world, playerID, attackerCity.AllianceId, defenderCell, now)
+	if kind == attackDenied {
 		ctx.Logger().Error("can not attack")
 		return nil
 	}
+	if needRally(defenderCell) {
+		ctx.Logger().Error("target needs rally")
+		return nil
+	}
 
 	//是否免战 比如刚占领 不能被攻击
-	if s.IsWarFree(now.UnixMilli(), defenderCell.OccupyTime.UnixMilli()) {
+	if kind != attackLiberate && s.warFree(world, attackerCity.AllianceId, defenderCell, now) {
 		ctx.Logger().Error("war free")
 		return nil
 	}
@@ -251,7 +465,7 @@ func (s *WorldService) Attack(ctx actor.Context, w *WorldActor, req *messages.HW
 	army.State = entity.ArmyRunning
 	army.StartTime = now
 	// 实际按照速度来
-	army.EndTime = now.Add(time.Second * 10)
+	army.EndTime = now.Add(marchDuration(world, attackerCity.AllianceId))
 
 	// 加入军队池
 	armyID := ArmyID(army.Id)
@@ -271,6 +485,132 @@ func (s *WorldService) Attack(ctx actor.Context, w *WorldActor, req *messages.HW
 	}
 }
 
+// 屯田：只能前往自己的领地
+func (s *WorldService) Reclamation(ctx actor.Context, w *WorldActor, req *messages.HWReclamation) *messages.WHReclamation {
+	now := time.Now()
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	cell, b := world.GetWorldMap(_map.ToPosition(req.Pos.X, req.Pos.Y))
+	if !b || PlayerID(cell.Occupancy.Owner) != playerID {
+		ctx.Logger().Error("reclamation target is not own territory")
+		return nil
+	}
+	if !cell.GiveUpTime.IsZero() {
+		ctx.Logger().Error("reclamation target is giving up")
+		return nil
+	}
+
+	city := armyCity(world, playerID, CityID(req.Army.CityId))
+	if city == nil {
+		ctx.Logger().Error("army city not found")
+		return nil
+	}
+
+	army := toWorldArmyState(req.Army)
+	army.FromX = city.Pos.X
+	army.FromY = city.Pos.Y
+	army.ToX = cell.Pos.X
+	army.ToY = cell.Pos.Y
+	army.Cmd = entity.ArmyCmdReclamation
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = now.Add(marchDuration(world, city.AllianceId))
+
+	armies, b := world.GetArmies(playerID)
+	if !b || armies == nil {
+		armies = make(map[entity.ArmyID]entity.ArmyState)
+	}
+	armies[ArmyID(army.Id)] = army
+	world.PutArmies(playerID, armies)
+
+	s.dispatchArmyMarch(world, army)
+
+	return &messages.WHReclamation{
+		OK:        true,
+		StartTime: now,
+		EndTime:   army.EndTime,
+	}
+}
+
+// 放弃领地：到期后由 tick 释放
+func (s *WorldService) GiveUp(ctx actor.Context, w *WorldActor, req *messages.HWGiveUp) *messages.WHGiveUp {
+	now := time.Now()
+	world := w.Entity()
+	playerID := PlayerID(req.PlayerId)
+
+	pos := _map.ToPosition(req.Pos.X, req.Pos.Y)
+	cell, b := world.GetWorldMap(pos)
+	if !b || PlayerID(cell.Occupancy.Owner) != playerID {
+		ctx.Logger().Error("give up target is not own territory")
+		return nil
+	}
+	// 城池不能放弃
+	if cell.CellType == _map.MapPlayerCity {
+		ctx.Logger().Error("can not give up city")
+		return nil
+	}
+	if !cell.GiveUpTime.IsZero() {
+		ctx.Logger().Error("territory is giving up already")
+		return nil
+	}
+
+	giveUpTime := now.Add(time.Duration(basic.BasicConf.Build.GiveUpTime) * time.Second)
+	world.UpdateWorldMap(pos, func(v *entity.CellEntity) {
+		v.SetGiveUpTime(giveUpTime)
+	})
+	w.giveUps[pos] = giveUpTime
+
+	return &messages.WHGiveUp{
+		OK:         true,
+		GiveUpTime: giveUpTime,
+	}
+}
+
+// 释放到期的放弃领地
+func (s *WorldService) releaseGiveUp(sender messageSender, w *WorldActor, now time.Time) {
+	world := w.Entity()
+	for pos, giveUpTime := range w.giveUps {
+		if giveUpTime.After(now) {
+			continue
+		}
+		delete(w.giveUps, pos)
+		if cell, ok := world.GetWorldMap(pos); ok {
+			s.reportAllianceLog(sender, w, cell.Occupancy.AllianceId, messages.ALLIANCE_LOG_LOSE, cell)
+		}
+		s.dismissReinforces(sender, w, pos, now, nil)
+		world.UpdateWorldMap(pos, func(v *entity.CellEntity) {
+			v.SetOccupancy(entity.OccupancyState{})
+			v.SetOccupyTime(time.Time{})
+			v.SetGiveUpTime(time.Time{})
+			v.SetCurDurable(v.MaxDurable())
+		})
+	}
+}
+
+func mainCity(world *entity.WorldEntity, playerID PlayerID) *entity.CityState {
+	cities, b := world.GetCityByPlayer(playerID)
+	if !b {
+		return nil
+	}
+	for _, city := range cities {
+		if city.IsMain {
+			return &city
+		}
+	}
+	return nil
+}
+
+// armyCity 军队出发的城池，找不到时回落到主城
+func armyCity(world *entity.WorldEntity, playerID PlayerID, cityID CityID) *entity.CityState {
+	if cities, b := world.GetCityByPlayer(playerID); b {
+		if city, ok := cities[cityID]; ok {
+			return &city
+		}
+	}
+	return mainCity(world, playerID)
+}
+
 // 返回
 func (s *WorldService) Back(ctx actor.Context, w *WorldActor, req *messages.HWBack) *messages.WHBack {
 	now := time.Now()
@@ -396,23 +736,41 @@ func (s *WorldService) handleArrive(ctx actor.Context, w *WorldActor, world *ent
 	case entity.ArmyCmdAttack:
 		defenderPos := _map.ToPosition(army.ToX, army.ToY)
 		defenderCell, b := world.GetWorldMap(defenderPos)
-		if !b || defenderCell.Occupancy.Owner == 0 {
+		if !b || (defenderCell.Occupancy.Owner == 0 && !isSysBuilding(defenderCell)) {
 			logs.Warn("not found the defender, can not attack")
 			return
 		}
-		// 自己的城池 和联盟的城池 都不能攻击
-		if !s.CanAttack(army.PlayerId, PlayerID(defenderCell.Occupancy.Owner), army.AllianceId, AllianceID(defenderCell.Occupancy.AllianceId)) {
+		// 自己的城池 和联盟的城池 都不能攻击，附庸只能反叛，盟友只能解救
+		kind := s.attackKindOf(world, army.PlayerId, army.AllianceId, defenderCell, now)
+		if kind == attackDenied || (needRally(defenderCell) && army.RallyId <= 0) {
 			logs.Warn("can not attack")
 			return
 		}
+		if kind == attackLiberate {
+			s.setVassal(ctx, w, PlayerID(defenderCell.Occupancy.Owner), 0, time.Time{})
+			s.marchBack(ctx, w, army, defenderCell, now)
+			return
+		}
 
 		//是否免战 比如刚占领 不能被攻击
-		if s.IsWarFree(now.UnixMilli(), defenderCell.OccupyTime.UnixMilli()) {
+		if s.warFree(world, army.AllianceId, defenderCell, now) {
 			logs.Warn("war free")
 			return
 		}
-		s.startBattle(ctx, w, world, army, defenderCell)
+		if kind == attackSys {
+			s.sysBattle(ctx, w, army, defenderCell, now)
+			return
+		}
+		result := s.startBattle(ctx, w, world, army, defenderCell)
+		s.afterBattle(ctx, w, army, defenderCell, kind, result, now)
+	case entity.ArmyCmdRally:
+		s.arriveRally(ctx, w, army, now)
+	case entity.ArmyCmdReinforce:
+		s.arriveReinforce(ctx, w, army, now)
 	case entity.ArmyCmdBack:
+		if s.backFromRally(ctx, w, army, now) {
+			return
+		}
 		world.UpdateArmies(army.PlayerId, func(v map[entity.ArmyID]*entity.ArmyEntity) {
 			armyEntity, ok := v[ArmyID(army.Id)]
 			if ok {
@@ -425,6 +783,18 @@ func (s *WorldService) handleArrive(ctx actor.Context, w *WorldActor, world *ent
 		if updated, ok := GetArmy(world, army.PlayerId, ArmyID(army.Id)); ok {
 			s.pushArmySync(ctx, w, updated)
 		}
+	case entity.ArmyCmdReclamation:
+		// 屯田完成，领地仍属于自己时结算产出，然后原路返回
+		s.pushReclamationYield(ctx, w, army)
+		army.FromX, army.ToX = army.ToX, army.FromX
+		army.FromY, army.ToY = army.ToY, army.FromY
+		army.Cmd = entity.ArmyCmdBack
+		army.State = entity.ArmyRunning
+		army.StartTime = now
+		army.EndTime = now.Add(time.Second * 10)
+		s.replaceArmyState(world, army)
+		s.dispatchArmyMarch(world, army)
+		s.pushArmySync(ctx, w, army)
 	}
 }
 
@@ -477,26 +847,73 @@ func buildArmyPushBatch(w *WorldActor, x, y int, army *entity.ArmyState) *messag
 	}
 	maxMapX := _map.MapWidth - 1
 	maxMapY := _map.MapHeight - 1
-	msg := make([]messages.WorldPushItem, 0)
+	msg := make([]*gatepb.PushItem, 0)
 	for id, view := range w.PlayerView {
 		viewMaxX := min(maxMapX, view.X+view.Length-1)
 		viewMaxY := min(maxMapY, view.Y+view.Length-1)
 
 		if x >= view.X && x <= viewMaxX &&
 			y >= view.Y && y <= viewMaxY {
-			item := messages.WorldPushItem{
-				PlayerID: int64(id),
-				Army:     toPlayerPBArmy(*army),
-			}
-			msg = append(msg, item)
+			msg = append(msg, &gatepb.PushItem{
+				PlayerId: int64(id),
+				Payload:  &gatepb.PushItem_Army{Army: toPlayerPBArmy(*army)},
+			})
 		}
 	}
 	return &messages.WorldPushBatch{
 		WorldBaseMessage: messages.WorldBaseMessage{
 			WorldId: int(*w.worldID),
 		},
-		MsgType: messages.ArmyPush,
-		Items:   msg,
+		Items: msg,
+	}
+}
+
+// 城池位置变化，新旧位置在视野内的玩家都要推送
+func buildCityPushBatch(w *WorldActor, playerID PlayerID, city entity.CityState, oldPos entity.PosState) *messages.WorldPushBatch {
+	if w == nil {
+		return nil
+	}
+	maxMapX := _map.MapWidth - 1
+	maxMapY := _map.MapHeight - 1
+	inView := func(view View, x, y int) bool {
+		viewMaxX := min(maxMapX, view.X+view.Length-1)
+		viewMaxY := min(maxMapY, view.Y+view.Length-1)
+		return x >= view.X && x <= viewMaxX && y >= view.Y && y <= viewMaxY
+	}
+	pbCity := toPlayerPBCity(city, playerID)
+	msg := make([]*gatepb.PushItem, 0)
+	for id, view := range w.PlayerView {
+		if inView(view, city.Pos.X, city.Pos.Y) || inView(view, oldPos.X, oldPos.Y) {
+			msg = append(msg, &gatepb.PushItem{
+				PlayerId: int64(id),
+				Payload:  &gatepb.PushItem_City{City: pbCity},
+			})
+		}
+	}
+	return &messages.WorldPushBatch{
+		WorldBaseMessage: messages.WorldBaseMessage{
+			WorldId: int(*w.worldID),
+		},
+		Items: msg,
+	}
+}
+
+func toPlayerPBCity(city entity.CityState, playerID PlayerID) *playerpb.City {
+	msg := ToMessagesCity(city, playerID)
+	return &playerpb.City{
+		PlayerId:   int32(msg.PlayerId),
+		CityId:     msg.CityId,
+		Name:       msg.Name,
+		UnionId:    int32(msg.AllianceId),
+		UnionName:  msg.AllianceName,
+		ParentId:   int32(msg.ParentId),
+		X:          int32(msg.Pos.X),
+		Y:          int32(msg.Pos.Y),
+		IsMain:     msg.IsMain,
+		Level:      int32(msg.Level),
+		CurDurable: int32(msg.CurDurable),
+		MaxDurable: int32(msg.MaxDurable),
+		OccupyTime: msg.OccupyTime,
 	}
 }
 
@@ -655,7 +1072,7 @@ func (s *WorldService) IsWarFree(now, occupyMills int64) bool {
 	return false
 }
 
-func (s *WorldService) CanAttack(attackerId, defenderId PlayerID, attackerAlliance, defenderAlliance AllianceID) bool {
+func (s *WorldService) CanAttack(world *entity.WorldEntity, attackerId, defenderId PlayerID, attackerAlliance, defenderAlliance AllianceID, now time.Time) bool {
 	// 盟友的城池不能攻击
 	if attackerAlliance != 0 && attackerAlliance == defenderAlliance {
 		return false
@@ -666,28 +1083,28 @@ func (s *WorldService) CanAttack(attackerId, defenderId PlayerID, attackerAllian
 		return false
 	}
 
+	// 停战和互不侵犯期间不能攻击
+	if atPeace(world, attackerAlliance, defenderAlliance, now) {
+		return false
+	}
+
 	return true
 }
 
 // 和驻防军队进行战斗，需要玩家主动设置驻防军队
-func (s *WorldService) startBattle(ctx actor.Context, w *WorldActor, world *entity.WorldEntity, attacker entity.ArmyState, defender entity.CellState) {
+func (s *WorldService) startBattle(ctx actor.Context, w *WorldActor, world *entity.WorldEntity, attacker entity.ArmyState, defender entity.CellState) messages.BattleResult {
 	// 略过打建筑，目前主流的slg游戏没有这种玩法
-	defenderArmy := s.defenderArmies(world, defender)
-	if hasArmyState(defenderArmy) {
-		// 有驻防军时走完整战斗结算
-		battleContext := initBattleContext(world, attacker, defenderArmy)
-		report := s.battle(world, defender, battleContext)
-		s.pushWarReport(ctx, w, report, attacker.PlayerId, PlayerID(defender.Occupancy.Owner))
-		s.pushBattleResult(ctx, w, *battleContext.Attacker)
-		s.pushBattleResult(ctx, w, *battleContext.Defender)
-		return
+	defenderArmies := s.defenderArmies(world, defender)
+	if len(defenderArmies) > 0 {
+		// 有增援或驻防军时走完整战斗结算
+		return s.defendInTurn(ctx, w, world, attacker, defender, defenderArmies)
 	}
 
 	// 没有驻防军，直接按破坏力扣减耐久并生成战报。
 	begAttackArmy := cloneArmyState(attacker)
-	destroy := s.Destroy(attacker)
-	DurableChange(world, defender, -destroy)
 	now := time.Now()
+	destroy := warDestroy(world, attacker, defender, s.Destroy(attacker), now)
+	DurableChange(world, defender, -destroy)
 	attacker.FromX = defender.Pos.X
 	attacker.FromY = defender.Pos.Y
 	attacker.ToX = begAttackArmy.FromX
@@ -698,9 +1115,23 @@ func (s *WorldService) startBattle(ctx actor.Context, w *WorldActor, world *enti
 	attacker.EndTime = now.Add(time.Second * 10)
 	s.replaceArmyState(world, attacker)
 	s.dispatchArmyMarch(world, attacker)
-	report := s.createWarReport(begAttackArmy, attacker, defenderArmy, defenderArmy, defender, messages.WIN, destroy, 0, nil)
+	report := s.createWarReport(begAttackArmy, attacker, entity.ArmyState{}, entity.ArmyState{}, defender, messages.WIN, destroy, 0, nil)
 	s.pushWarReport(ctx, w, report, attacker.PlayerId, PlayerID(defender.Occupancy.Owner))
 	s.pushBattleResult(ctx, w, attacker)
+	return messages.WIN
+}
+
+// marchBack 军队从目标格子原路返回
+func (s *WorldService) marchBack(sender messageSender, w *WorldActor, army entity.ArmyState, from entity.CellState, now time.Time) {
+	army.ToX, army.ToY = army.FromX, army.FromY
+	army.FromX, army.FromY = from.Pos.X, from.Pos.Y
+	army.Cmd = entity.ArmyCmdBack
+	army.State = entity.ArmyRunning
+	army.StartTime = now
+	army.EndTime = now.Add(time.Second * 10)
+	s.replaceArmyState(w.Entity(), army)
+	s.dispatchArmyMarch(w.Entity(), army)
+	s.pushArmySync(sender, w, army)
 }
 
 // 初始化战斗数据  军队和武将属性、兵种、加成等
@@ -1289,17 +1720,84 @@ func (s *WorldService) pushArmySync(sender messageSender, w *WorldActor, army en
 	})
 }
 
-func (s *WorldService) defenderArmies(world *entity.WorldEntity, defender entity.CellState) entity.ArmyState {
+// pushReclamationYield 屯田的领地还是自己的、没有在放弃中，按格子的资源产量通知玩家结算
+func (s *WorldService) pushReclamationYield(sender messageSender, w *WorldActor, army entity.ArmyState) {
+	if sender == nil || w == nil || !hasArmyState(army) || army.PlayerId <= 0 {
+		return
+	}
+	cell, ok := w.Entity().GetWorldMap(_map.ToPosition(army.ToX, army.ToY))
+	if !ok || PlayerID(cell.Occupancy.Owner) != army.PlayerId || !cell.GiveUpTime.IsZero() {
+		return
+	}
+	playerManagerPID, ok := w.ResolveManagerPID(sharedactor.ManagerPIDPlayer)
+	if !ok || playerManagerPID == nil {
+		logs.Warn("player manager actor pid is nil, skip reclamation yield")
+		return
+	}
+	worldID := 0
+	if wid := w.WorldID(); wid != nil {
+		worldID = int(*wid)
+	}
+	sender.Send(playerManagerPID, &messages.WHReclamationYield{
+		PlayerBaseMessage: messages.PlayerBaseMessage{
+			WorldId:  worldID,
+			PlayerId: int(army.PlayerId),
+		},
+		ArmyId: army.Id,
+		Pos:    messages.Pos{X: cell.Pos.X, Y: cell.Pos.Y},
+		Wood:   cell.Wood,
+		Iron:   cell.Iron,
+		Stone:  cell.Stone,
+		Grain:  cell.Grain,
+	})
+}
+
+// reportAllianceLog 领地得失上报给联盟，目标为格子的领主，系统建筑没有领主时记建筑名
+func (s *WorldService) reportAllianceLog(sender messageSender, w *WorldActor, allianceID int, kind messages.AllianceLogKind, cell entity.CellState) {
+	if sender == nil || w == nil || allianceID <= 0 {
+		return
+	}
+	targetName := cell.Occupancy.RoleNick
+	if cell.Occupancy.Owner <= 0 {
+		targetName = cell.Name
+	}
+	alliancePID, ok := w.ResolveManagerPID(sharedactor.ManagerPIDAlliance)
+	if !ok || alliancePID == nil {
+		return
+	}
+	worldID := 0
+	if wid := w.WorldID(); wid != nil {
+		worldID = int(*wid)
+	}
+	sender.Send(alliancePID, &messages.WAAllianceLog{
+		AllianceBaseMessage: messages.AllianceBaseMessage{
+			WorldId:    worldID,
+			AllianceId: allianceID,
+		},
+		Kind:       kind,
+		TargetId:   cell.Occupancy.Owner,
+		TargetName: targetName,
+		Pos:        messages.Pos{X: cell.Pos.X, Y: cell.Pos.Y},
+	})
+}
+
+// defenderArmies 防守顺序：先是按到达顺序的盟友增援，最后是领主的驻军
+func (s *WorldService) defenderArmies(world *entity.WorldEntity, defender entity.CellState) []entity.ArmyState {
+	out := make([]entity.ArmyState, 0, len(defender.Occupancy.Reinforces)+1)
+	for _, v := range defender.Occupancy.Reinforces {
+		if army, ok := GetArmy(world, v.PlayerId, v.ArmyId); ok && armySoldiers(army) > 0 {
+			out = append(out, army)
+		}
+	}
 	armyId := defender.Occupancy.Garrison.ArmyId
 	garrisons, b := world.GetArmies(PlayerID(defender.Occupancy.Owner))
 	if !b || garrisons == nil {
-		return entity.ArmyState{}
+		return out
 	}
-	if army, ok := garrisons[armyId]; ok {
-		return army
+	if army, ok := garrisons[armyId]; ok && hasArmyState(army) {
+		out = append(out, army)
 	}
-
-	return entity.ArmyState{}
+	return out
 }
 
 func (s *WorldService) Destroy(attacker entity.ArmyState) int {
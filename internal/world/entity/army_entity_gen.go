@@ -27,6 +27,7 @@ const (
 	FieldArmy_conscriptCounts   Field = "conscriptCounts"
 	FieldArmy_cellX             Field = "cellX"
 	FieldArmy_cellY             Field = "cellY"
+	FieldArmy_rallyId           Field = "rallyId"
 )
 
 var emptyArmyEntity = &ArmyEntity{}
@@ -181,6 +182,7 @@ type ArmyState struct {
 	ConscriptCounts   []int
 	CellX             int
 	CellY             int
+	RallyId           int
 }
 
 type ArmyEntitySnap struct {
@@ -210,6 +212,7 @@ type ArmyEntity struct {
 	conscriptCounts   []int
 	cellX             int
 	cellY             int
+	rallyId           int
 	_dt               ArmyEntityTrace
 }
 
@@ -333,6 +336,7 @@ func HydrateArmyEntity(s ArmyState) *ArmyEntity {
 		conscriptCounts:   append([]int(nil), s.ConscriptCounts...),
 		cellX:             s.CellX,
 		cellY:             s.CellY,
+		rallyId:           s.RallyId,
 	}
 }
 
@@ -471,6 +475,7 @@ func (e *ArmyEntity) Save() ArmyState {
 	s.ConscriptCounts = append([]int(nil), e.conscriptCounts...)
 	s.CellX = e.cellX
 	s.CellY = e.cellY
+	s.RallyId = e.rallyId
 	return s
 }
 
@@ -1309,3 +1314,23 @@ func (e *ArmyEntity) SetCellY(v int) bool {
 	e._dt.mark(FieldArmy_cellY)
 	return true
 }
+
+func (e *ArmyEntity) RallyId() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.rallyId
+}
+
+func (e *ArmyEntity) SetRallyId(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.rallyId == v {
+		return false
+	}
+	e.rallyId = v
+	e._dt.mark(FieldArmy_rallyId)
+	return true
+}
@@ -19,6 +19,7 @@ const (
 	FieldCity_curDurable   Field = "curDurable"
 	FieldCity_maxDurable   Field = "maxDurable"
 	FieldCity_occupyTime   Field = "occupyTime"
+	FieldCity_vassalEnd    Field = "vassalEnd"
 	FieldCity_facility     Field = "facility"
 )
 
@@ -166,6 +167,7 @@ type CityState struct {
 	CurDurable   int
 	MaxDurable   int
 	OccupyTime   time.Time
+	VassalEnd    time.Time
 	Facility     []FacilityState
 }
 
@@ -188,6 +190,7 @@ type CityEntity struct {
 	curDurable   int
 	maxDurable   int
 	occupyTime   time.Time
+	vassalEnd    time.Time
 	facility     []*FacilityEntity
 	_dt          CityEntityTrace
 }
@@ -250,6 +253,7 @@ func HydrateCityEntity(s CityState) *CityEntity {
 		curDurable:   s.CurDurable,
 		maxDurable:   s.MaxDurable,
 		occupyTime:   s.OccupyTime,
+		vassalEnd:    s.VassalEnd,
 		facility:     emptyCityEntity.hydrateSliceFacility(s.Facility),
 	}
 }
@@ -395,6 +399,7 @@ func (e *CityEntity) Save() CityState {
 	s.CurDurable = e.curDurable
 	s.MaxDurable = e.maxDurable
 	s.OccupyTime = e.occupyTime
+	s.VassalEnd = e.vassalEnd
 	s.Facility = e.snapshotSliceFacility(e.facility)
 	return s
 }
@@ -674,6 +679,26 @@ func (e *CityEntity) SetOccupyTime(v time.Time) bool {
 	return true
 }
 
+func (e *CityEntity) VassalEnd() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.vassalEnd
+}
+
+func (e *CityEntity) SetVassalEnd(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.vassalEnd.Equal(v) {
+		return false
+	}
+	e.vassalEnd = v
+	e._dt.mark(FieldCity_vassalEnd)
+	return true
+}
+
 func (e *CityEntity) LenFacility() int {
 	if e == nil {
 		return 0
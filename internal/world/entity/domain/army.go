@@ -10,6 +10,8 @@ const (
 	ArmyCmdBack        = 4 //撤退
 	ArmyCmdConscript   = 5 //征兵
 	ArmyCmdTransfer    = 6 //调动
+	ArmyCmdRally       = 7 //集结
+	ArmyCmdReinforce   = 8 //增援盟友
 )
 
 const (
@@ -39,4 +41,5 @@ type Army struct {
 	conscriptCounts   []int      //征兵数量
 	cellX             int
 	cellY             int
+	rallyId           int // 随集结出征时的集结 id，返回后清零
 }
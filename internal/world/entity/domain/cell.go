@@ -33,8 +33,15 @@ type Occupancy struct {
 	roleNick     string
 	allianceId   int
 	allianceName string
-	parentId     int       // 上级联盟 ID
-	garrison     *Garrison // 持久化驻军信息
+	parentId     int          // 上级联盟 ID
+	garrison     *Garrison    // 持久化驻军信息
+	reinforces   []*Reinforce // 盟友增援的军队，按到达顺序在驻军之前防守
+}
+
+// entity
+type Reinforce struct {
+	playerId PlayerID
+	armyId   ArmyID
 }
 
 // entity
@@ -15,6 +15,7 @@ type City struct {
 	curDurable   int        // 当前耐久
 	maxDurable   int        // 最大耐久
 	occupyTime   time.Time  // 占领时间
+	vassalEnd    time.Time  // 沦陷到期时间，沦陷期间 parentId 为上级联盟
 
 	facility []*Facility
 }
@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// 联盟之间的外交状态，由联盟同步过来，过了 until 视为中立
+// entity
+type Diplomacy struct {
+	state int8 // 外交状态，见 messages.DiplomacyState
+	until time.Time
+}
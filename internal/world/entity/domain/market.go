@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// 集市行情，每个世界一份
+// entity
+type Market struct {
+	prices    []int     // 资源价格，千分比，下标 0 木 1 铁 2 石 3 粮
+	lastDrift time.Time // 上次价格回归的时间
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// 联盟集结，只在集结等待期间存在，出发后删除
+// entity
+type Rally struct {
+	id         int
+	allianceId AllianceID
+	leaderId   PlayerID
+	targetX    int
+	targetY    int
+	rallyX     int // 集结点，发起人主城
+	rallyY     int
+	deadline   time.Time // 集结截止，到时一起出发
+	joined     []*RallyMember
+}
+
+// entity
+type RallyMember struct {
+	playerId PlayerID
+	armyId   ArmyID
+}
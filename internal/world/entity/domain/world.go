@@ -14,7 +14,10 @@ type World struct {
 	worldId      WorldID
 	cityByPlayer map[PlayerID]map[CityID]*City
 	worldMap     map[int]*Cell
-	armies       map[PlayerID]map[ArmyID]*Army  // 地图上的军队池
-	marches      map[PlayerID]map[ArmyID]*March // 行军数据（高频更新）
-	cellToMarch  map[int][]March                // 空间索引
+	armies       map[PlayerID]map[ArmyID]*Army            // 地图上的军队池
+	marches      map[PlayerID]map[ArmyID]*March           // 行军数据（高频更新）
+	cellToMarch  map[int][]March                          // 空间索引
+	market       *Market                                  // 集市行情
+	rallies      map[int]*Rally                           // 等待中的联盟集结
+	diplomacies  map[AllianceID]map[AllianceID]*Diplomacy // 联盟外交状态，双向各存一份
 }
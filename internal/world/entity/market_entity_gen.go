@@ -0,0 +1,475 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	FieldMarket_prices    Field = "prices"
+	FieldMarket_lastDrift Field = "lastDrift"
+)
+
+var emptyMarketEntity = &MarketEntity{}
+
+type MarketEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type MarketEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type MarketEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*MarketEntityCollectionChangeInner
+}
+
+func (t *MarketEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *MarketEntityTrace) ensureChange(f Field) *MarketEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*MarketEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &MarketEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *MarketEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *MarketEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *MarketEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *MarketEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *MarketEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *MarketEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *MarketEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type MarketState struct {
+	Prices    []int
+	LastDrift time.Time
+}
+
+type MarketEntitySnap struct {
+	Version     uint64
+	State       MarketState
+	DirtyFields []Field
+	Changes     map[Field]MarketEntityCollectionChange
+}
+
+type MarketEntity struct {
+	prices    []int
+	lastDrift time.Time
+	_dt       MarketEntityTrace
+}
+
+func (e *MarketEntity) slicesEqualPrices(a, b []int) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func HydrateMarketEntity(s MarketState) *MarketEntity {
+	return &MarketEntity{
+		prices:    append([]int(nil), s.Prices...),
+		lastDrift: s.LastDrift,
+	}
+}
+
+func (e *MarketEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *MarketEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = MarketEntityTrace{}
+}
+
+func (e *MarketEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *MarketEntity) DirtyChanges() map[Field]MarketEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]MarketEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := MarketEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneMarketEntityCollectionChange(in MarketEntityCollectionChange) MarketEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *MarketEntity) Save() MarketState {
+	var s MarketState
+	if e == nil {
+		return s
+	}
+	s.Prices = append([]int(nil), e.prices...)
+	s.LastDrift = e.lastDrift
+	return s
+}
+
+func NewMarketEntitySnap(version uint64, e *MarketEntity) *MarketEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &MarketEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *MarketEntitySnap) Clone() *MarketEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &MarketEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]MarketEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneMarketEntityCollectionChange(ch)
+		}
+	}
+	out.State.Prices = append([]int(nil), s.State.Prices...)
+	return out
+}
+
+func (e *MarketEntity) LenPrices() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.prices)
+}
+
+func (e *MarketEntity) AtPrices(index int) (int, bool) {
+	var z int
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.prices) {
+		return z, false
+	}
+	return e.prices[index], true
+}
+
+func (e *MarketEntity) ForEachPrices(fn func(index int, value int)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.prices {
+		fn(i, v)
+	}
+}
+
+func (e *MarketEntity) RangePrices(fn func(index int, value int) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.prices {
+		if !fn(i, v) {
+			return
+		}
+	}
+}
+
+func (e *MarketEntity) ReplacePrices(v []int) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualPrices(e.prices, v) {
+		return false
+	}
+	e.prices = append([]int(nil), v...)
+	e._dt.markFullReplace(FieldMarket_prices)
+	return true
+}
+
+func (e *MarketEntity) AppendPrices(values ...int) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	e.prices = append(e.prices, values...)
+	for _, v := range values {
+		e._dt.markSliceAppend(FieldMarket_prices, v)
+	}
+	return true
+}
+
+func (e *MarketEntity) SetPricesAt(index int, value int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.prices) {
+		return false
+	}
+	if e.prices[index] == value {
+		return false
+	}
+	e.prices[index] = value
+	e._dt.markSliceSet(FieldMarket_prices, index, value)
+	return true
+}
+
+func (e *MarketEntity) RemovePricesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.prices) {
+		return false
+	}
+	e.prices = append(e.prices[:index], e.prices[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldMarket_prices, index)
+	return true
+}
+
+func (e *MarketEntity) SwapRemovePricesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.prices) {
+		return false
+	}
+	last := len(e.prices) - 1
+	if index != last {
+		e.prices[index] = e.prices[last]
+	}
+	e.prices = e.prices[:last]
+	e._dt.markSliceSwapRemoveAt(FieldMarket_prices, index)
+	return true
+}
+
+func (e *MarketEntity) ClearPrices() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.prices) == 0 {
+		return false
+	}
+	e.prices = nil
+	e._dt.markFullReplace(FieldMarket_prices)
+	return true
+}
+
+func (e *MarketEntity) LastDrift() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.lastDrift
+}
+
+func (e *MarketEntity) SetLastDrift(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.lastDrift.Equal(v) {
+		return false
+	}
+	e.lastDrift = v
+	e._dt.mark(FieldMarket_lastDrift)
+	return true
+}
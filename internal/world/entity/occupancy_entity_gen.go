@@ -2,6 +2,7 @@
 package entity
 
 import (
+	"reflect"
 	"sort"
 )
 
@@ -14,6 +15,7 @@ const (
 	FieldOccupancy_allianceName Field = "allianceName"
 	FieldOccupancy_parentId     Field = "parentId"
 	FieldOccupancy_garrison     Field = "garrison"
+	FieldOccupancy_reinforces   Field = "reinforces"
 )
 
 var emptyOccupancyEntity = &OccupancyEntity{}
@@ -157,6 +159,7 @@ type OccupancyState struct {
 	AllianceName string
 	ParentId     int
 	Garrison     GarrisonState
+	Reinforces   []ReinforceState
 }
 
 type OccupancyEntitySnap struct {
@@ -175,9 +178,55 @@ type OccupancyEntity struct {
 	allianceName string
 	parentId     int
 	garrison     *GarrisonEntity
+	reinforces   []*ReinforceEntity
 	_dt          OccupancyEntityTrace
 }
 
+func (e *OccupancyEntity) hydrateSliceReinforces(in []ReinforceState) []*ReinforceEntity {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ReinforceEntity, len(in))
+	for i, v := range in {
+		out[i] = HydrateReinforceEntity(v)
+	}
+	return out
+}
+
+func (e *OccupancyEntity) snapshotSliceReinforces(in []*ReinforceEntity) []ReinforceState {
+	if in == nil {
+		return nil
+	}
+	out := make([]ReinforceState, len(in))
+	for i, v := range in {
+		if v == nil {
+			var z ReinforceState
+			out[i] = z
+			continue
+		}
+		out[i] = v.Save()
+	}
+	return out
+}
+
+func (e *OccupancyEntity) slicesEqualReinforces(a, b []ReinforceState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func HydrateOccupancyEntity(s OccupancyState) *OccupancyEntity {
 	return &OccupancyEntity{
 		kind:         s.Kind,
@@ -188,6 +237,7 @@ func HydrateOccupancyEntity(s OccupancyState) *OccupancyEntity {
 		allianceName: s.AllianceName,
 		parentId:     s.ParentId,
 		garrison:     HydrateGarrisonEntity(s.Garrison),
+		reinforces:   emptyOccupancyEntity.hydrateSliceReinforces(s.Reinforces),
 	}
 }
 
@@ -329,6 +379,7 @@ func (e *OccupancyEntity) Save() OccupancyState {
 		var z GarrisonState
 		s.Garrison = z
 	}
+	s.Reinforces = e.snapshotSliceReinforces(e.reinforces)
 	return s
 }
 
@@ -359,6 +410,7 @@ func (s *OccupancyEntitySnap) Clone() *OccupancyEntitySnap {
 			out.Changes[f] = cloneOccupancyEntityCollectionChange(ch)
 		}
 	}
+	out.State.Reinforces = append([]ReinforceState(nil), s.State.Reinforces...)
 	return out
 }
 
@@ -545,3 +597,157 @@ func (e *OccupancyEntity) UpdateGarrison(fn func(value *GarrisonEntity)) bool {
 	e._dt.mark(FieldOccupancy_garrison)
 	return true
 }
+
+func (e *OccupancyEntity) LenReinforces() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.reinforces)
+}
+
+func (e *OccupancyEntity) AtReinforces(index int) (ReinforceState, bool) {
+	var z ReinforceState
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.reinforces) {
+		return z, false
+	}
+	v := e.reinforces[index]
+	if v == nil {
+		return z, true
+	}
+	return v.Save(), true
+}
+
+func (e *OccupancyEntity) ForEachReinforces(fn func(index int, value ReinforceState)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.reinforces {
+		var state ReinforceState
+		if v != nil {
+			state = v.Save()
+		}
+		fn(i, state)
+	}
+}
+
+func (e *OccupancyEntity) RangeReinforces(fn func(index int, value ReinforceState) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.reinforces {
+		var state ReinforceState
+		if v != nil {
+			state = v.Save()
+		}
+		if !fn(i, state) {
+			return
+		}
+	}
+}
+
+func (e *OccupancyEntity) ReplaceReinforces(v []ReinforceState) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualReinforces(e.snapshotSliceReinforces(e.reinforces), v) {
+		return false
+	}
+	e.reinforces = e.hydrateSliceReinforces(v)
+	e._dt.markFullReplace(FieldOccupancy_reinforces)
+	return true
+}
+
+func (e *OccupancyEntity) AppendReinforces(values ...ReinforceState) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		rv := HydrateReinforceEntity(v)
+		e.reinforces = append(e.reinforces, rv)
+		e._dt.markSliceAppend(FieldOccupancy_reinforces, v)
+	}
+	return true
+}
+
+func (e *OccupancyEntity) SetReinforcesAt(index int, value ReinforceState) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.reinforces) {
+		return false
+	}
+	var oldState ReinforceState
+	if e.reinforces[index] != nil {
+		oldState = e.reinforces[index].Save()
+	}
+	if reflect.DeepEqual(oldState, value) {
+		return false
+	}
+	e.reinforces[index] = HydrateReinforceEntity(value)
+	e._dt.markSliceSet(FieldOccupancy_reinforces, index, value)
+	return true
+}
+
+func (e *OccupancyEntity) UpdateReinforcesAt(index int, fn func(value *ReinforceEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.reinforces) {
+		return false
+	}
+	v := e.reinforces[index]
+	if v == nil {
+		return false
+	}
+	before := v.Save()
+	fn(v)
+	after := v.Save()
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markSliceSet(FieldOccupancy_reinforces, index, after)
+	return true
+}
+
+func (e *OccupancyEntity) RemoveReinforcesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.reinforces) {
+		return false
+	}
+	e.reinforces = append(e.reinforces[:index], e.reinforces[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldOccupancy_reinforces, index)
+	return true
+}
+
+func (e *OccupancyEntity) SwapRemoveReinforcesAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.reinforces) {
+		return false
+	}
+	last := len(e.reinforces) - 1
+	if index != last {
+		e.reinforces[index] = e.reinforces[last]
+	}
+	e.reinforces = e.reinforces[:last]
+	e._dt.markSliceSwapRemoveAt(FieldOccupancy_reinforces, index)
+	return true
+}
+
+func (e *OccupancyEntity) ClearReinforces() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.reinforces) == 0 {
+		return false
+	}
+	e.reinforces = nil
+	e._dt.markFullReplace(FieldOccupancy_reinforces)
+	return true
+}
@@ -0,0 +1,716 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+const (
+	FieldRally_id         Field = "id"
+	FieldRally_allianceId Field = "allianceId"
+	FieldRally_leaderId   Field = "leaderId"
+	FieldRally_targetX    Field = "targetX"
+	FieldRally_targetY    Field = "targetY"
+	FieldRally_rallyX     Field = "rallyX"
+	FieldRally_rallyY     Field = "rallyY"
+	FieldRally_deadline   Field = "deadline"
+	FieldRally_joined     Field = "joined"
+)
+
+var emptyRallyEntity = &RallyEntity{}
+
+type RallyEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type RallyEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type RallyEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*RallyEntityCollectionChangeInner
+}
+
+func (t *RallyEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *RallyEntityTrace) ensureChange(f Field) *RallyEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*RallyEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &RallyEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *RallyEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *RallyEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *RallyEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *RallyEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *RallyEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *RallyEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *RallyEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type RallyState struct {
+	Id         int
+	AllianceId AllianceID
+	LeaderId   PlayerID
+	TargetX    int
+	TargetY    int
+	RallyX     int
+	RallyY     int
+	Deadline   time.Time
+	Joined     []RallyMemberState
+}
+
+type RallyEntitySnap struct {
+	Version     uint64
+	State       RallyState
+	DirtyFields []Field
+	Changes     map[Field]RallyEntityCollectionChange
+}
+
+type RallyEntity struct {
+	id         int
+	allianceId AllianceID
+	leaderId   PlayerID
+	targetX    int
+	targetY    int
+	rallyX     int
+	rallyY     int
+	deadline   time.Time
+	joined     []*RallyMemberEntity
+	_dt        RallyEntityTrace
+}
+
+func (e *RallyEntity) hydrateSliceJoined(in []RallyMemberState) []*RallyMemberEntity {
+	if in == nil {
+		return nil
+	}
+	out := make([]*RallyMemberEntity, len(in))
+	for i, v := range in {
+		out[i] = HydrateRallyMemberEntity(v)
+	}
+	return out
+}
+
+func (e *RallyEntity) snapshotSliceJoined(in []*RallyMemberEntity) []RallyMemberState {
+	if in == nil {
+		return nil
+	}
+	out := make([]RallyMemberState, len(in))
+	for i, v := range in {
+		if v == nil {
+			var z RallyMemberState
+			out[i] = z
+			continue
+		}
+		out[i] = v.Save()
+	}
+	return out
+}
+
+func (e *RallyEntity) slicesEqualJoined(a, b []RallyMemberState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func HydrateRallyEntity(s RallyState) *RallyEntity {
+	return &RallyEntity{
+		id:         s.Id,
+		allianceId: s.AllianceId,
+		leaderId:   s.LeaderId,
+		targetX:    s.TargetX,
+		targetY:    s.TargetY,
+		rallyX:     s.RallyX,
+		rallyY:     s.RallyY,
+		deadline:   s.Deadline,
+		joined:     emptyRallyEntity.hydrateSliceJoined(s.Joined),
+	}
+}
+
+func (e *RallyEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *RallyEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = RallyEntityTrace{}
+}
+
+func (e *RallyEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *RallyEntity) DirtyChanges() map[Field]RallyEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]RallyEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := RallyEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneRallyEntityCollectionChange(in RallyEntityCollectionChange) RallyEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *RallyEntity) Save() RallyState {
+	var s RallyState
+	if e == nil {
+		return s
+	}
+	s.Id = e.id
+	s.AllianceId = e.allianceId
+	s.LeaderId = e.leaderId
+	s.TargetX = e.targetX
+	s.TargetY = e.targetY
+	s.RallyX = e.rallyX
+	s.RallyY = e.rallyY
+	s.Deadline = e.deadline
+	s.Joined = e.snapshotSliceJoined(e.joined)
+	return s
+}
+
+func NewRallyEntitySnap(version uint64, e *RallyEntity) *RallyEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &RallyEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *RallyEntitySnap) Clone() *RallyEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &RallyEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]RallyEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneRallyEntityCollectionChange(ch)
+		}
+	}
+	out.State.Joined = append([]RallyMemberState(nil), s.State.Joined...)
+	return out
+}
+
+func (e *RallyEntity) Id() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.id
+}
+
+func (e *RallyEntity) SetId(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.id == v {
+		return false
+	}
+	e.id = v
+	e._dt.mark(FieldRally_id)
+	return true
+}
+
+func (e *RallyEntity) AllianceId() AllianceID {
+	if e == nil {
+		var z AllianceID
+		return z
+	}
+	return e.allianceId
+}
+
+func (e *RallyEntity) SetAllianceId(v AllianceID) bool {
+	if e == nil {
+		return false
+	}
+	if e.allianceId == v {
+		return false
+	}
+	e.allianceId = v
+	e._dt.mark(FieldRally_allianceId)
+	return true
+}
+
+func (e *RallyEntity) LeaderId() PlayerID {
+	if e == nil {
+		var z PlayerID
+		return z
+	}
+	return e.leaderId
+}
+
+func (e *RallyEntity) SetLeaderId(v PlayerID) bool {
+	if e == nil {
+		return false
+	}
+	if e.leaderId == v {
+		return false
+	}
+	e.leaderId = v
+	e._dt.mark(FieldRally_leaderId)
+	return true
+}
+
+func (e *RallyEntity) TargetX() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.targetX
+}
+
+func (e *RallyEntity) SetTargetX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.targetX == v {
+		return false
+	}
+	e.targetX = v
+	e._dt.mark(FieldRally_targetX)
+	return true
+}
+
+func (e *RallyEntity) TargetY() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.targetY
+}
+
+func (e *RallyEntity) SetTargetY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.targetY == v {
+		return false
+	}
+	e.targetY = v
+	e._dt.mark(FieldRally_targetY)
+	return true
+}
+
+func (e *RallyEntity) RallyX() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.rallyX
+}
+
+func (e *RallyEntity) SetRallyX(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.rallyX == v {
+		return false
+	}
+	e.rallyX = v
+	e._dt.mark(FieldRally_rallyX)
+	return true
+}
+
+func (e *RallyEntity) RallyY() int {
+	if e == nil {
+		var z int
+		return z
+	}
+	return e.rallyY
+}
+
+func (e *RallyEntity) SetRallyY(v int) bool {
+	if e == nil {
+		return false
+	}
+	if e.rallyY == v {
+		return false
+	}
+	e.rallyY = v
+	e._dt.mark(FieldRally_rallyY)
+	return true
+}
+
+func (e *RallyEntity) Deadline() time.Time {
+	if e == nil {
+		var z time.Time
+		return z
+	}
+	return e.deadline
+}
+
+func (e *RallyEntity) SetDeadline(v time.Time) bool {
+	if e == nil {
+		return false
+	}
+	if e.deadline.Equal(v) {
+		return false
+	}
+	e.deadline = v
+	e._dt.mark(FieldRally_deadline)
+	return true
+}
+
+func (e *RallyEntity) LenJoined() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.joined)
+}
+
+func (e *RallyEntity) AtJoined(index int) (RallyMemberState, bool) {
+	var z RallyMemberState
+	if e == nil {
+		return z, false
+	}
+	if index < 0 || index >= len(e.joined) {
+		return z, false
+	}
+	v := e.joined[index]
+	if v == nil {
+		return z, true
+	}
+	return v.Save(), true
+}
+
+func (e *RallyEntity) ForEachJoined(fn func(index int, value RallyMemberState)) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.joined {
+		var state RallyMemberState
+		if v != nil {
+			state = v.Save()
+		}
+		fn(i, state)
+	}
+}
+
+func (e *RallyEntity) RangeJoined(fn func(index int, value RallyMemberState) bool) {
+	if e == nil || fn == nil {
+		return
+	}
+	for i, v := range e.joined {
+		var state RallyMemberState
+		if v != nil {
+			state = v.Save()
+		}
+		if !fn(i, state) {
+			return
+		}
+	}
+}
+
+func (e *RallyEntity) ReplaceJoined(v []RallyMemberState) bool {
+	if e == nil {
+		return false
+	}
+	if e.slicesEqualJoined(e.snapshotSliceJoined(e.joined), v) {
+		return false
+	}
+	e.joined = e.hydrateSliceJoined(v)
+	e._dt.markFullReplace(FieldRally_joined)
+	return true
+}
+
+func (e *RallyEntity) AppendJoined(values ...RallyMemberState) bool {
+	if e == nil || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		rv := HydrateRallyMemberEntity(v)
+		e.joined = append(e.joined, rv)
+		e._dt.markSliceAppend(FieldRally_joined, v)
+	}
+	return true
+}
+
+func (e *RallyEntity) SetJoinedAt(index int, value RallyMemberState) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.joined) {
+		return false
+	}
+	var oldState RallyMemberState
+	if e.joined[index] != nil {
+		oldState = e.joined[index].Save()
+	}
+	if reflect.DeepEqual(oldState, value) {
+		return false
+	}
+	e.joined[index] = HydrateRallyMemberEntity(value)
+	e._dt.markSliceSet(FieldRally_joined, index, value)
+	return true
+}
+
+func (e *RallyEntity) UpdateJoinedAt(index int, fn func(value *RallyMemberEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.joined) {
+		return false
+	}
+	v := e.joined[index]
+	if v == nil {
+		return false
+	}
+	before := v.Save()
+	fn(v)
+	after := v.Save()
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markSliceSet(FieldRally_joined, index, after)
+	return true
+}
+
+func (e *RallyEntity) RemoveJoinedAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.joined) {
+		return false
+	}
+	e.joined = append(e.joined[:index], e.joined[index+1:]...)
+	e._dt.markSliceRemoveAt(FieldRally_joined, index)
+	return true
+}
+
+func (e *RallyEntity) SwapRemoveJoinedAt(index int) bool {
+	if e == nil {
+		return false
+	}
+	if index < 0 || index >= len(e.joined) {
+		return false
+	}
+	last := len(e.joined) - 1
+	if index != last {
+		e.joined[index] = e.joined[last]
+	}
+	e.joined = e.joined[:last]
+	e._dt.markSliceSwapRemoveAt(FieldRally_joined, index)
+	return true
+}
+
+func (e *RallyEntity) ClearJoined() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.joined) == 0 {
+		return false
+	}
+	e.joined = nil
+	e._dt.markFullReplace(FieldRally_joined)
+	return true
+}
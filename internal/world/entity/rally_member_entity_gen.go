@@ -0,0 +1,359 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package entity
+
+import (
+	"sort"
+)
+
+const (
+	FieldRallyMember_playerId Field = "playerId"
+	FieldRallyMember_armyId   Field = "armyId"
+)
+
+var emptyRallyMemberEntity = &RallyMemberEntity{}
+
+type RallyMemberEntityCollectionChange struct {
+	FullReplace       bool
+	MapSet            map[string]any
+	MapDeleteKeys     []string
+	SliceSet          map[int]any
+	SliceAppend       []any
+	SliceRemoveAt     []int
+	SliceSwapRemoveAt []int
+}
+
+type RallyMemberEntityCollectionChangeInner struct {
+	fullReplace       bool
+	mapSet            map[string]any
+	mapDelete         map[string]struct{}
+	sliceSet          map[int]any
+	sliceAppend       []any
+	sliceRemoveAt     []int
+	sliceSwapRemoveAt []int
+}
+
+type RallyMemberEntityTrace struct {
+	dirty   bool
+	trace   map[Field]bool
+	changes map[Field]*RallyMemberEntityCollectionChangeInner
+}
+
+func (t *RallyMemberEntityTrace) mark(f Field) {
+	t.dirty = true
+	if t.trace == nil {
+		t.trace = make(map[Field]bool, 8)
+	}
+	t.trace[f] = true
+}
+
+func (t *RallyMemberEntityTrace) ensureChange(f Field) *RallyMemberEntityCollectionChangeInner {
+	if t.changes == nil {
+		t.changes = make(map[Field]*RallyMemberEntityCollectionChangeInner, 4)
+	}
+	ch, ok := t.changes[f]
+	if !ok || ch == nil {
+		ch = &RallyMemberEntityCollectionChangeInner{}
+		t.changes[f] = ch
+	}
+	return ch
+}
+
+func (t *RallyMemberEntityTrace) markFullReplace(f Field) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	ch.fullReplace = true
+	ch.mapSet = nil
+	ch.mapDelete = nil
+	ch.sliceSet = nil
+	ch.sliceAppend = nil
+	ch.sliceRemoveAt = nil
+	ch.sliceSwapRemoveAt = nil
+}
+
+func (t *RallyMemberEntityTrace) markMapSet(f Field, key string, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapSet == nil {
+		ch.mapSet = make(map[string]any, 4)
+	}
+	ch.mapSet[key] = value
+	if ch.mapDelete != nil {
+		delete(ch.mapDelete, key)
+	}
+}
+
+func (t *RallyMemberEntityTrace) markMapDelete(f Field, key string) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.mapDelete == nil {
+		ch.mapDelete = make(map[string]struct{}, 4)
+	}
+	ch.mapDelete[key] = struct{}{}
+	if ch.mapSet != nil {
+		delete(ch.mapSet, key)
+	}
+}
+
+func (t *RallyMemberEntityTrace) markSliceAppend(f Field, values ...any) {
+	if len(values) == 0 {
+		return
+	}
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceAppend = append(ch.sliceAppend, values...)
+}
+
+func (t *RallyMemberEntityTrace) markSliceSet(f Field, index int, value any) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	if ch.sliceSet == nil {
+		ch.sliceSet = make(map[int]any, 4)
+	}
+	ch.sliceSet[index] = value
+}
+
+func (t *RallyMemberEntityTrace) markSliceRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceRemoveAt = append(ch.sliceRemoveAt, index)
+}
+
+func (t *RallyMemberEntityTrace) markSliceSwapRemoveAt(f Field, index int) {
+	t.mark(f)
+	ch := t.ensureChange(f)
+	if ch.fullReplace {
+		return
+	}
+	ch.sliceSwapRemoveAt = append(ch.sliceSwapRemoveAt, index)
+}
+
+type RallyMemberState struct {
+	PlayerId PlayerID
+	ArmyId   ArmyID
+}
+
+type RallyMemberEntitySnap struct {
+	Version     uint64
+	State       RallyMemberState
+	DirtyFields []Field
+	Changes     map[Field]RallyMemberEntityCollectionChange
+}
+
+type RallyMemberEntity struct {
+	playerId PlayerID
+	armyId   ArmyID
+	_dt      RallyMemberEntityTrace
+}
+
+func HydrateRallyMemberEntity(s RallyMemberState) *RallyMemberEntity {
+	return &RallyMemberEntity{
+		playerId: s.PlayerId,
+		armyId:   s.ArmyId,
+	}
+}
+
+func (e *RallyMemberEntity) Dirty() bool {
+	if e == nil {
+		return false
+	}
+	if e._dt.dirty {
+		return true
+	}
+	return false
+}
+
+func (e *RallyMemberEntity) ClearDirty() {
+	if e == nil {
+		return
+	}
+	e._dt = RallyMemberEntityTrace{}
+}
+
+func (e *RallyMemberEntity) DirtyFields() []Field {
+	if e == nil {
+		return nil
+	}
+	trace := make(map[Field]bool, len(e._dt.trace)+4)
+	for k := range e._dt.trace {
+		trace[k] = true
+	}
+	if len(trace) == 0 {
+		return nil
+	}
+	out := make([]Field, 0, len(trace))
+	for k := range trace {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func (e *RallyMemberEntity) DirtyChanges() map[Field]RallyMemberEntityCollectionChange {
+	if e == nil || len(e._dt.changes) == 0 {
+		return nil
+	}
+	out := make(map[Field]RallyMemberEntityCollectionChange, len(e._dt.changes))
+	for f, ch := range e._dt.changes {
+		if ch == nil {
+			continue
+		}
+		item := RallyMemberEntityCollectionChange{
+			FullReplace: ch.fullReplace,
+		}
+		if len(ch.mapSet) > 0 {
+			item.MapSet = make(map[string]any, len(ch.mapSet))
+			for k, v := range ch.mapSet {
+				item.MapSet[k] = v
+			}
+		}
+		if len(ch.mapDelete) > 0 {
+			keys := make([]string, 0, len(ch.mapDelete))
+			for k := range ch.mapDelete {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			item.MapDeleteKeys = keys
+		}
+		if len(ch.sliceSet) > 0 {
+			item.SliceSet = make(map[int]any, len(ch.sliceSet))
+			for idx, v := range ch.sliceSet {
+				item.SliceSet[idx] = v
+			}
+		}
+		if len(ch.sliceAppend) > 0 {
+			item.SliceAppend = append([]any(nil), ch.sliceAppend...)
+		}
+		if len(ch.sliceRemoveAt) > 0 {
+			item.SliceRemoveAt = append([]int(nil), ch.sliceRemoveAt...)
+		}
+		if len(ch.sliceSwapRemoveAt) > 0 {
+			item.SliceSwapRemoveAt = append([]int(nil), ch.sliceSwapRemoveAt...)
+		}
+		out[f] = item
+	}
+	return out
+}
+
+func cloneRallyMemberEntityCollectionChange(in RallyMemberEntityCollectionChange) RallyMemberEntityCollectionChange {
+	out := in
+	if in.MapSet != nil {
+		out.MapSet = make(map[string]any, len(in.MapSet))
+		for k, v := range in.MapSet {
+			out.MapSet[k] = v
+		}
+	}
+	if in.MapDeleteKeys != nil {
+		out.MapDeleteKeys = append([]string(nil), in.MapDeleteKeys...)
+	}
+	if in.SliceSet != nil {
+		out.SliceSet = make(map[int]any, len(in.SliceSet))
+		for idx, v := range in.SliceSet {
+			out.SliceSet[idx] = v
+		}
+	}
+	if in.SliceAppend != nil {
+		out.SliceAppend = append([]any(nil), in.SliceAppend...)
+	}
+	if in.SliceRemoveAt != nil {
+		out.SliceRemoveAt = append([]int(nil), in.SliceRemoveAt...)
+	}
+	if in.SliceSwapRemoveAt != nil {
+		out.SliceSwapRemoveAt = append([]int(nil), in.SliceSwapRemoveAt...)
+	}
+	return out
+}
+
+func (e *RallyMemberEntity) Save() RallyMemberState {
+	var s RallyMemberState
+	if e == nil {
+		return s
+	}
+	s.PlayerId = e.playerId
+	s.ArmyId = e.armyId
+	return s
+}
+
+func NewRallyMemberEntitySnap(version uint64, e *RallyMemberEntity) *RallyMemberEntitySnap {
+	if e == nil {
+		return nil
+	}
+	dirtyFields := e.DirtyFields()
+	changes := e.DirtyChanges()
+	return &RallyMemberEntitySnap{
+		Version:     version,
+		State:       e.Save(),
+		DirtyFields: dirtyFields,
+		Changes:     changes,
+	}
+}
+
+func (s *RallyMemberEntitySnap) Clone() *RallyMemberEntitySnap {
+	if s == nil {
+		return nil
+	}
+	out := &RallyMemberEntitySnap{Version: s.Version}
+	out.State = s.State
+	out.DirtyFields = append([]Field(nil), s.DirtyFields...)
+	if len(s.Changes) > 0 {
+		out.Changes = make(map[Field]RallyMemberEntityCollectionChange, len(s.Changes))
+		for f, ch := range s.Changes {
+			out.Changes[f] = cloneRallyMemberEntityCollectionChange(ch)
+		}
+	}
+	return out
+}
+
+func (e *RallyMemberEntity) PlayerId() PlayerID {
+	if e == nil {
+		var z PlayerID
+		return z
+	}
+	return e.playerId
+}
+
+func (e *RallyMemberEntity) SetPlayerId(v PlayerID) bool {
+	if e == nil {
+		return false
+	}
+	if e.playerId == v {
+		return false
+	}
+	e.playerId = v
+	e._dt.mark(FieldRallyMember_playerId)
+	return true
+}
+
+func (e *RallyMemberEntity) ArmyId() ArmyID {
+	if e == nil {
+		var z ArmyID
+		return z
+	}
+	return e.armyId
+}
+
+func (e *RallyMemberEntity) SetArmyId(v ArmyID) bool {
+	if e == nil {
+		return false
+	}
+	if e.armyId == v {
+		return false
+	}
+	e.armyId = v
+	e._dt.mark(FieldRallyMember_armyId)
+	return true
+}
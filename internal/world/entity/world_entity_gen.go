@@ -14,6 +14,9 @@ const (
 	FieldWorld_armies       Field = "armies"
 	FieldWorld_marches      Field = "marches"
 	FieldWorld_cellToMarch  Field = "cellToMarch"
+	FieldWorld_market       Field = "market"
+	FieldWorld_rallies      Field = "rallies"
+	FieldWorld_diplomacies  Field = "diplomacies"
 )
 
 var emptyWorldEntity = &WorldEntity{}
@@ -43,6 +46,7 @@ type WorldEntityTrace struct {
 	trace               map[Field]bool
 	changes             map[Field]*WorldEntityCollectionChangeInner
 	childDirty_worldMap map[int]struct{}
+	childDirty_rallies  map[int]struct{}
 }
 
 func (t *WorldEntityTrace) mark(f Field) {
@@ -176,6 +180,33 @@ func (t *WorldEntityTrace) childDirtyKeys_worldMap() []int {
 	return out
 }
 
+func (t *WorldEntityTrace) markChildDirty_rallies(f Field, key int) {
+	t.mark(f)
+	if t.childDirty_rallies == nil {
+		t.childDirty_rallies = make(map[int]struct{}, 8)
+	}
+	t.childDirty_rallies[key] = struct{}{}
+}
+
+func (t *WorldEntityTrace) clearChildDirty_rallies(key int) {
+	if t.childDirty_rallies == nil {
+		return
+	}
+	delete(t.childDirty_rallies, key)
+}
+
+func (t *WorldEntityTrace) childDirtyKeys_rallies() []int {
+	if len(t.childDirty_rallies) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(t.childDirty_rallies))
+	for key := range t.childDirty_rallies {
+		out = append(out, key)
+	}
+	sort.Slice(out, func(i, j int) bool { return fmt.Sprint(out[i]) < fmt.Sprint(out[j]) })
+	return out
+}
+
 type WorldState struct {
 	WorldId      WorldID
 	CityByPlayer map[PlayerID]map[CityID]CityState
@@ -183,6 +214,9 @@ type WorldState struct {
 	Armies       map[PlayerID]map[ArmyID]ArmyState
 	Marches      map[PlayerID]map[ArmyID]MarchState
 	CellToMarch  map[int][]MarchState
+	Market       MarketState
+	Rallies      map[int]RallyState
+	Diplomacies  map[AllianceID]map[AllianceID]DiplomacyState
 }
 
 type WorldEntitySnap struct {
@@ -191,6 +225,7 @@ type WorldEntitySnap struct {
 	DirtyFields       []Field
 	Changes           map[Field]WorldEntityCollectionChange
 	WorldMapDirtyKeys []int
+	RalliesDirtyKeys  []int
 }
 
 type WorldEntity struct {
@@ -200,6 +235,9 @@ type WorldEntity struct {
 	armies       map[PlayerID]map[ArmyID]*ArmyEntity
 	marches      map[PlayerID]map[ArmyID]*MarchEntity
 	cellToMarch  map[int][]*MarchEntity
+	market       *MarketEntity
+	rallies      map[int]*RallyEntity
+	diplomacies  map[AllianceID]map[AllianceID]*DiplomacyEntity
 	_dt          WorldEntityTrace
 }
 
@@ -592,6 +630,137 @@ func (e *WorldEntity) snapshotMapCellToMarch(in map[int][]*MarchEntity) map[int]
 	return out
 }
 
+func (e *WorldEntity) copyMapRallies(in map[int]RallyState) map[int]RallyState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]RallyState, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func (e *WorldEntity) mapsEqualRallies(a, b map[int]RallyState) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	return false
+}
+
+func (e *WorldEntity) hydrateMapRallies(in map[int]RallyState) map[int]*RallyEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]*RallyEntity, len(in))
+	for k, v := range in {
+		out[k] = HydrateRallyEntity(v)
+	}
+	return out
+}
+
+func (e *WorldEntity) snapshotMapRallies(in map[int]*RallyEntity) map[int]RallyState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]RallyState, len(in))
+	for k, v := range in {
+		if v == nil {
+			var z RallyState
+			out[k] = z
+			continue
+		}
+		out[k] = v.Save()
+	}
+	return out
+}
+
+func (e *WorldEntity) copyMapValueDiplomacies(in map[AllianceID]DiplomacyState) map[AllianceID]DiplomacyState {
+	var out map[AllianceID]DiplomacyState
+	if in == nil {
+		out = nil
+	} else {
+		out0 := make(map[AllianceID]DiplomacyState, len(in))
+		for k1, v2 := range in {
+			out0[k1] = v2
+		}
+		out = out0
+	}
+	return out
+}
+
+func (e *WorldEntity) hydrateMapValueDiplomacies(in map[AllianceID]DiplomacyState) map[AllianceID]*DiplomacyEntity {
+	var out map[AllianceID]*DiplomacyEntity
+	if in == nil {
+		out = nil
+	} else {
+		out0 := make(map[AllianceID]*DiplomacyEntity, len(in))
+		for k1, v2 := range in {
+			out0[k1] = HydrateDiplomacyEntity(v2)
+		}
+		out = out0
+	}
+	return out
+}
+
+func (e *WorldEntity) snapshotMapValueDiplomacies(in map[AllianceID]*DiplomacyEntity) map[AllianceID]DiplomacyState {
+	var out map[AllianceID]DiplomacyState
+	if in == nil {
+		out = nil
+	} else {
+		out0 := make(map[AllianceID]DiplomacyState, len(in))
+		for k1, v2 := range in {
+			if v2 == nil {
+				var z DiplomacyState
+				out0[k1] = z
+			} else {
+				out0[k1] = v2.Save()
+			}
+		}
+		out = out0
+	}
+	return out
+}
+
+func (e *WorldEntity) copyMapDiplomacies(in map[AllianceID]map[AllianceID]DiplomacyState) map[AllianceID]map[AllianceID]DiplomacyState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]map[AllianceID]DiplomacyState, len(in))
+	for k, v := range in {
+		out[k] = e.copyMapValueDiplomacies(v)
+	}
+	return out
+}
+
+func (e *WorldEntity) mapsEqualDiplomacies(a, b map[AllianceID]map[AllianceID]DiplomacyState) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func (e *WorldEntity) hydrateMapDiplomacies(in map[AllianceID]map[AllianceID]DiplomacyState) map[AllianceID]map[AllianceID]*DiplomacyEntity {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]map[AllianceID]*DiplomacyEntity, len(in))
+	for k, v := range in {
+		out[k] = e.hydrateMapValueDiplomacies(v)
+	}
+	return out
+}
+
+func (e *WorldEntity) snapshotMapDiplomacies(in map[AllianceID]map[AllianceID]*DiplomacyEntity) map[AllianceID]map[AllianceID]DiplomacyState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[AllianceID]map[AllianceID]DiplomacyState, len(in))
+	for k, v := range in {
+		var sv map[AllianceID]DiplomacyState
+		sv = e.snapshotMapValueDiplomacies(v)
+		out[k] = sv
+	}
+	return out
+}
+
 func HydrateWorldEntity(s WorldState) *WorldEntity {
 	return &WorldEntity{
 		worldId:      s.WorldId,
@@ -600,6 +769,9 @@ func HydrateWorldEntity(s WorldState) *WorldEntity {
 		armies:       emptyWorldEntity.hydrateMapArmies(s.Armies),
 		marches:      emptyWorldEntity.hydrateMapMarches(s.Marches),
 		cellToMarch:  emptyWorldEntity.hydrateMapCellToMarch(s.CellToMarch),
+		market:       HydrateMarketEntity(s.Market),
+		rallies:      emptyWorldEntity.hydrateMapRallies(s.Rallies),
+		diplomacies:  emptyWorldEntity.hydrateMapDiplomacies(s.Diplomacies),
 	}
 }
 
@@ -610,6 +782,9 @@ func (e *WorldEntity) Dirty() bool {
 	if e._dt.dirty {
 		return true
 	}
+	if e.market != nil && e.market.Dirty() {
+		return true
+	}
 	return false
 }
 
@@ -618,6 +793,9 @@ func (e *WorldEntity) ClearDirty() {
 		return
 	}
 	e._dt = WorldEntityTrace{}
+	if e.market != nil {
+		e.market.ClearDirty()
+	}
 }
 
 func (e *WorldEntity) DirtyFields() []Field {
@@ -628,6 +806,9 @@ func (e *WorldEntity) DirtyFields() []Field {
 	for k := range e._dt.trace {
 		trace[k] = true
 	}
+	if e.market != nil && e.market.Dirty() {
+		trace[FieldWorld_market] = true
+	}
 	if len(trace) == 0 {
 		return nil
 	}
@@ -725,6 +906,14 @@ func (e *WorldEntity) Save() WorldState {
 	s.Armies = e.snapshotMapArmies(e.armies)
 	s.Marches = e.snapshotMapMarches(e.marches)
 	s.CellToMarch = e.snapshotMapCellToMarch(e.cellToMarch)
+	if e.market != nil {
+		s.Market = e.market.Save()
+	} else {
+		var z MarketState
+		s.Market = z
+	}
+	s.Rallies = e.snapshotMapRallies(e.rallies)
+	s.Diplomacies = e.snapshotMapDiplomacies(e.diplomacies)
 	return s
 }
 
@@ -740,6 +929,7 @@ func NewWorldEntitySnap(version uint64, e *WorldEntity) *WorldEntitySnap {
 		DirtyFields:       dirtyFields,
 		Changes:           changes,
 		WorldMapDirtyKeys: e._dt.childDirtyKeys_worldMap(),
+		RalliesDirtyKeys:  e._dt.childDirtyKeys_rallies(),
 	}
 }
 
@@ -757,11 +947,14 @@ func (s *WorldEntitySnap) Clone() *WorldEntitySnap {
 		}
 	}
 	out.WorldMapDirtyKeys = append([]int(nil), s.WorldMapDirtyKeys...)
+	out.RalliesDirtyKeys = append([]int(nil), s.RalliesDirtyKeys...)
 	out.State.CityByPlayer = emptyWorldEntity.copyMapCityByPlayer(s.State.CityByPlayer)
 	out.State.WorldMap = emptyWorldEntity.copyMapWorldMap(s.State.WorldMap)
 	out.State.Armies = emptyWorldEntity.copyMapArmies(s.State.Armies)
 	out.State.Marches = emptyWorldEntity.copyMapMarches(s.State.Marches)
 	out.State.CellToMarch = emptyWorldEntity.copyMapCellToMarch(s.State.CellToMarch)
+	out.State.Rallies = emptyWorldEntity.copyMapRallies(s.State.Rallies)
+	out.State.Diplomacies = emptyWorldEntity.copyMapDiplomacies(s.State.Diplomacies)
 	return out
 }
 
@@ -1506,3 +1699,340 @@ func (e *WorldEntity) ClearCellToMarch() bool {
 	e._dt.markFullReplace(FieldWorld_cellToMarch)
 	return true
 }
+
+func (e *WorldEntity) Market() *MarketEntity {
+	if e == nil {
+		return nil
+	}
+	return e.market
+}
+
+func (e *WorldEntity) SetMarket(v MarketState) bool {
+	if e == nil {
+		return false
+	}
+	next := HydrateMarketEntity(v)
+	if e.market == next {
+		return false
+	}
+	e.market = next
+	e._dt.mark(FieldWorld_market)
+	return true
+}
+
+func (e *WorldEntity) SetMarketEntity(v *MarketEntity) bool {
+	if e == nil {
+		return false
+	}
+	if e.market == v {
+		return false
+	}
+	e.market = v
+	e._dt.mark(FieldWorld_market)
+	return true
+}
+
+func (e *WorldEntity) UpdateMarket(fn func(value *MarketEntity)) bool {
+	if e == nil || fn == nil {
+		return false
+	}
+	if e.market == nil {
+		e.market = &MarketEntity{}
+	}
+	fn(e.market)
+	e._dt.mark(FieldWorld_market)
+	return true
+}
+
+func (e *WorldEntity) GetRallies(key int) (RallyState, bool) {
+	var z RallyState
+	if e == nil || e.rallies == nil {
+		return z, false
+	}
+	v, ok := e.rallies[key]
+	if !ok || v == nil {
+		return z, false
+	}
+	return v.Save(), true
+}
+
+func (e *WorldEntity) LenRallies() int {
+	if e == nil || e.rallies == nil {
+		return 0
+	}
+	return len(e.rallies)
+}
+
+func (e *WorldEntity) ForEachRallies(fn func(key int, value RallyState)) {
+	if e == nil || e.rallies == nil || fn == nil {
+		return
+	}
+	for k, v := range e.rallies {
+		if v == nil {
+			continue
+		}
+		fn(k, v.Save())
+	}
+}
+
+func (e *WorldEntity) RangeRallies(fn func(key int, value RallyState) bool) {
+	if e == nil || e.rallies == nil || fn == nil {
+		return
+	}
+	for k, v := range e.rallies {
+		if v == nil {
+			continue
+		}
+		if !fn(k, v.Save()) {
+			return
+		}
+	}
+}
+
+func (e *WorldEntity) DirtyRalliesKeys() []int {
+	if e == nil {
+		return nil
+	}
+	return e._dt.childDirtyKeys_rallies()
+}
+
+func (e *WorldEntity) ReplaceRallies(v map[int]RallyState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualRallies(e.snapshotMapRallies(e.rallies), v) {
+		return false
+	}
+	e.rallies = e.hydrateMapRallies(v)
+	e._dt.markFullReplace(FieldWorld_rallies)
+	return true
+}
+
+func (e *WorldEntity) PutRallies(key int, value RallyState) bool {
+	if e == nil {
+		return false
+	}
+	if e.rallies == nil {
+		e.rallies = make(map[int]*RallyEntity)
+	}
+	e.rallies[key] = HydrateRallyEntity(value)
+	e._dt.markMapSet(FieldWorld_rallies, fmt.Sprint(key), value)
+	e._dt.markChildDirty_rallies(FieldWorld_rallies, key)
+	return true
+}
+
+func (e *WorldEntity) PutRalliesMany(entries map[int]RallyState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.rallies == nil {
+		e.rallies = make(map[int]*RallyEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		e.rallies[k] = HydrateRallyEntity(v)
+		e._dt.markMapSet(FieldWorld_rallies, fmt.Sprint(k), v)
+		e._dt.markChildDirty_rallies(FieldWorld_rallies, k)
+		changed = true
+	}
+	return changed
+}
+
+func (e *WorldEntity) UpdateRallies(key int, fn func(value *RallyEntity)) bool {
+	if e == nil || fn == nil || e.rallies == nil {
+		return false
+	}
+	v, ok := e.rallies[key]
+	if !ok || v == nil {
+		return false
+	}
+	fn(v)
+	e._dt.markChildDirty_rallies(FieldWorld_rallies, key)
+	return true
+}
+
+func (e *WorldEntity) DelRallies(key int) bool {
+	if e == nil || e.rallies == nil {
+		return false
+	}
+	if _, ok := e.rallies[key]; !ok {
+		return false
+	}
+	delete(e.rallies, key)
+	e._dt.markMapDelete(FieldWorld_rallies, fmt.Sprint(key))
+	e._dt.clearChildDirty_rallies(key)
+	return true
+}
+
+func (e *WorldEntity) DelRalliesMany(keys []int) bool {
+	if e == nil || e.rallies == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.rallies[key]; !ok {
+			continue
+		}
+		delete(e.rallies, key)
+		e._dt.markMapDelete(FieldWorld_rallies, fmt.Sprint(key))
+		e._dt.clearChildDirty_rallies(key)
+		changed = true
+	}
+	return changed
+}
+
+func (e *WorldEntity) ClearRallies() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.rallies) == 0 {
+		return false
+	}
+	e.rallies = nil
+	e._dt.markFullReplace(FieldWorld_rallies)
+	e._dt.childDirty_rallies = nil
+	return true
+}
+
+func (e *WorldEntity) GetDiplomacies(key AllianceID) (map[AllianceID]DiplomacyState, bool) {
+	var z map[AllianceID]DiplomacyState
+	if e == nil || e.diplomacies == nil {
+		return z, false
+	}
+	v, ok := e.diplomacies[key]
+	if !ok {
+		return z, false
+	}
+	return e.snapshotMapValueDiplomacies(v), true
+}
+
+func (e *WorldEntity) LenDiplomacies() int {
+	if e == nil || e.diplomacies == nil {
+		return 0
+	}
+	return len(e.diplomacies)
+}
+
+func (e *WorldEntity) ForEachDiplomacies(fn func(key AllianceID, value map[AllianceID]DiplomacyState)) {
+	if e == nil || e.diplomacies == nil || fn == nil {
+		return
+	}
+	for k, v := range e.diplomacies {
+		fn(k, e.snapshotMapValueDiplomacies(v))
+	}
+}
+
+func (e *WorldEntity) RangeDiplomacies(fn func(key AllianceID, value map[AllianceID]DiplomacyState) bool) {
+	if e == nil || e.diplomacies == nil || fn == nil {
+		return
+	}
+	for k, v := range e.diplomacies {
+		if !fn(k, e.snapshotMapValueDiplomacies(v)) {
+			return
+		}
+	}
+}
+
+func (e *WorldEntity) ReplaceDiplomacies(v map[AllianceID]map[AllianceID]DiplomacyState) bool {
+	if e == nil {
+		return false
+	}
+	if e.mapsEqualDiplomacies(e.snapshotMapDiplomacies(e.diplomacies), v) {
+		return false
+	}
+	e.diplomacies = e.hydrateMapDiplomacies(v)
+	e._dt.markFullReplace(FieldWorld_diplomacies)
+	return true
+}
+
+func (e *WorldEntity) PutDiplomacies(key AllianceID, value map[AllianceID]DiplomacyState) bool {
+	if e == nil {
+		return false
+	}
+	if e.diplomacies == nil {
+		e.diplomacies = make(map[AllianceID]map[AllianceID]*DiplomacyEntity)
+	}
+	if old, ok := e.diplomacies[key]; ok && reflect.DeepEqual(e.snapshotMapValueDiplomacies(old), value) {
+		return false
+	}
+	e.diplomacies[key] = e.hydrateMapValueDiplomacies(value)
+	e._dt.markMapSet(FieldWorld_diplomacies, fmt.Sprint(key), e.copyMapValueDiplomacies(value))
+	return true
+}
+
+func (e *WorldEntity) PutDiplomaciesMany(entries map[AllianceID]map[AllianceID]DiplomacyState) bool {
+	if e == nil || len(entries) == 0 {
+		return false
+	}
+	if e.diplomacies == nil {
+		e.diplomacies = make(map[AllianceID]map[AllianceID]*DiplomacyEntity, len(entries))
+	}
+	changed := false
+	for k, v := range entries {
+		if old, ok := e.diplomacies[k]; ok && reflect.DeepEqual(e.snapshotMapValueDiplomacies(old), v) {
+			continue
+		}
+		e.diplomacies[k] = e.hydrateMapValueDiplomacies(v)
+		e._dt.markMapSet(FieldWorld_diplomacies, fmt.Sprint(k), e.copyMapValueDiplomacies(v))
+		changed = true
+	}
+	return changed
+}
+
+func (e *WorldEntity) UpdateDiplomacies(key AllianceID, fn func(value map[AllianceID]*DiplomacyEntity)) bool {
+	if e == nil || fn == nil || e.diplomacies == nil {
+		return false
+	}
+	v, ok := e.diplomacies[key]
+	if !ok {
+		return false
+	}
+	before := e.snapshotMapValueDiplomacies(v)
+	fn(v)
+	after := e.snapshotMapValueDiplomacies(v)
+	if reflect.DeepEqual(before, after) {
+		return false
+	}
+	e._dt.markMapSet(FieldWorld_diplomacies, fmt.Sprint(key), e.copyMapValueDiplomacies(after))
+	return true
+}
+
+func (e *WorldEntity) DelDiplomacies(key AllianceID) bool {
+	if e == nil || e.diplomacies == nil {
+		return false
+	}
+	if _, ok := e.diplomacies[key]; !ok {
+		return false
+	}
+	delete(e.diplomacies, key)
+	e._dt.markMapDelete(FieldWorld_diplomacies, fmt.Sprint(key))
+	return true
+}
+
+func (e *WorldEntity) DelDiplomaciesMany(keys []AllianceID) bool {
+	if e == nil || e.diplomacies == nil || len(keys) == 0 {
+		return false
+	}
+	changed := false
+	for _, key := range keys {
+		if _, ok := e.diplomacies[key]; !ok {
+			continue
+		}
+		delete(e.diplomacies, key)
+		e._dt.markMapDelete(FieldWorld_diplomacies, fmt.Sprint(key))
+		changed = true
+	}
+	return changed
+}
+
+func (e *WorldEntity) ClearDiplomacies() bool {
+	if e == nil {
+		return false
+	}
+	if len(e.diplomacies) == 0 {
+		return false
+	}
+	e.diplomacies = nil
+	e._dt.markFullReplace(FieldWorld_diplomacies)
+	return true
+}
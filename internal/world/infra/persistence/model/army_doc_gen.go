@@ -26,6 +26,7 @@ type ArmyDoc struct {
 	ConscriptCounts   []int        `bson:"conscript_counts"`
 	CellX             int          `bson:"cell_x"`
 	CellY             int          `bson:"cell_y"`
+	RallyId           int          `bson:"rally_id"`
 }
 
 func toDocSlice_generals(in []entity.GeneralState) []GeneralDoc {
@@ -72,6 +73,7 @@ func ArmyStateToDoc(s entity.ArmyState) ArmyDoc {
 		ConscriptCounts:   state.ConscriptCounts,
 		CellX:             state.CellX,
 		CellY:             state.CellY,
+		RallyId:           state.RallyId,
 	}
 }
 
@@ -96,6 +98,7 @@ func ArmyDocToState(d ArmyDoc) entity.ArmyState {
 		ConscriptCounts:   d.ConscriptCounts,
 		CellX:             d.CellX,
 		CellY:             d.CellY,
+		RallyId:           d.RallyId,
 	}
 	return entity.HydrateArmyEntity(state).Save()
 }
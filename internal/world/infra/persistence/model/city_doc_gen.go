@@ -18,6 +18,7 @@ type CityDoc struct {
 	CurDurable   int           `bson:"cur_durable"`
 	MaxDurable   int           `bson:"max_durable"`
 	OccupyTime   time.Time     `bson:"occupy_time"`
+	VassalEnd    time.Time     `bson:"vassal_end"`
 	Facility     []FacilityDoc `bson:"facility"`
 }
 
@@ -57,6 +58,7 @@ func CityStateToDoc(s entity.CityState) CityDoc {
 		CurDurable:   state.CurDurable,
 		MaxDurable:   state.MaxDurable,
 		OccupyTime:   state.OccupyTime,
+		VassalEnd:    state.VassalEnd,
 		Facility:     toDocSlice_facility(state.Facility),
 	}
 }
@@ -74,6 +76,7 @@ func CityDocToState(d CityDoc) entity.CityState {
 		CurDurable:   d.CurDurable,
 		MaxDurable:   d.MaxDurable,
 		OccupyTime:   d.OccupyTime,
+		VassalEnd:    d.VassalEnd,
 		Facility:     toStateSlice_facility(d.Facility),
 	}
 	return entity.HydrateCityEntity(state).Save()
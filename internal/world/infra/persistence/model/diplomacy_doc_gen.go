@@ -0,0 +1,28 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+type DiplomacyDoc struct {
+	State int8      `bson:"state"`
+	Until time.Time `bson:"until"`
+}
+
+func DiplomacyStateToDoc(s entity.DiplomacyState) DiplomacyDoc {
+	state := entity.HydrateDiplomacyEntity(s).Save()
+	return DiplomacyDoc{
+		State: state.State,
+		Until: state.Until,
+	}
+}
+
+func DiplomacyDocToState(d DiplomacyDoc) entity.DiplomacyState {
+	state := entity.DiplomacyState{
+		State: d.State,
+		Until: d.Until,
+	}
+	return entity.HydrateDiplomacyEntity(state).Save()
+}
@@ -0,0 +1,28 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+type MarketDoc struct {
+	Prices    []int     `bson:"prices"`
+	LastDrift time.Time `bson:"last_drift"`
+}
+
+func MarketStateToDoc(s entity.MarketState) MarketDoc {
+	state := entity.HydrateMarketEntity(s).Save()
+	return MarketDoc{
+		Prices:    state.Prices,
+		LastDrift: state.LastDrift,
+	}
+}
+
+func MarketDocToState(d MarketDoc) entity.MarketState {
+	state := entity.MarketState{
+		Prices:    d.Prices,
+		LastDrift: d.LastDrift,
+	}
+	return entity.HydrateMarketEntity(state).Save()
+}
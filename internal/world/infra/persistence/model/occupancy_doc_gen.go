@@ -6,14 +6,37 @@ import (
 )
 
 type OccupancyDoc struct {
-	Kind         int8        `bson:"kind"`
-	RefId        int         `bson:"ref_id"`
-	Owner        int         `bson:"owner"`
-	RoleNick     string      `bson:"role_nick"`
-	AllianceId   int         `bson:"alliance_id"`
-	AllianceName string      `bson:"alliance_name"`
-	ParentId     int         `bson:"parent_id"`
-	Garrison     GarrisonDoc `bson:"garrison"`
+	Kind         int8           `bson:"kind"`
+	RefId        int            `bson:"ref_id"`
+	Owner        int            `bson:"owner"`
+	RoleNick     string         `bson:"role_nick"`
+	AllianceId   int            `bson:"alliance_id"`
+	AllianceName string         `bson:"alliance_name"`
+	ParentId     int            `bson:"parent_id"`
+	Garrison     GarrisonDoc    `bson:"garrison"`
+	Reinforces   []ReinforceDoc `bson:"reinforces"`
+}
+
+func toDocSlice_reinforces(in []entity.ReinforceState) []ReinforceDoc {
+	if in == nil {
+		return nil
+	}
+	out := make([]ReinforceDoc, len(in))
+	for i, v := range in {
+		out[i] = ReinforceStateToDoc(v)
+	}
+	return out
+}
+
+func toStateSlice_reinforces(in []ReinforceDoc) []entity.ReinforceState {
+	if in == nil {
+		return nil
+	}
+	out := make([]entity.ReinforceState, len(in))
+	for i, v := range in {
+		out[i] = ReinforceDocToState(v)
+	}
+	return out
 }
 
 func OccupancyStateToDoc(s entity.OccupancyState) OccupancyDoc {
@@ -27,6 +50,7 @@ func OccupancyStateToDoc(s entity.OccupancyState) OccupancyDoc {
 		AllianceName: state.AllianceName,
 		ParentId:     state.ParentId,
 		Garrison:     GarrisonStateToDoc(state.Garrison),
+		Reinforces:   toDocSlice_reinforces(state.Reinforces),
 	}
 }
 
@@ -40,6 +64,7 @@ func OccupancyDocToState(d OccupancyDoc) entity.OccupancyState {
 		AllianceName: d.AllianceName,
 		ParentId:     d.ParentId,
 		Garrison:     GarrisonDocToState(d.Garrison),
+		Reinforces:   toStateSlice_reinforces(d.Reinforces),
 	}
 	return entity.HydrateOccupancyEntity(state).Save()
 }
@@ -0,0 +1,71 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/world/entity"
+	"time"
+)
+
+type RallyDoc struct {
+	Id         int              `bson:"id"`
+	AllianceId AllianceID       `bson:"alliance_id"`
+	LeaderId   PlayerID         `bson:"leader_id"`
+	TargetX    int              `bson:"target_x"`
+	TargetY    int              `bson:"target_y"`
+	RallyX     int              `bson:"rally_x"`
+	RallyY     int              `bson:"rally_y"`
+	Deadline   time.Time        `bson:"deadline"`
+	Joined     []RallyMemberDoc `bson:"joined"`
+}
+
+func toDocSlice_joined(in []entity.RallyMemberState) []RallyMemberDoc {
+	if in == nil {
+		return nil
+	}
+	out := make([]RallyMemberDoc, len(in))
+	for i, v := range in {
+		out[i] = RallyMemberStateToDoc(v)
+	}
+	return out
+}
+
+func toStateSlice_joined(in []RallyMemberDoc) []entity.RallyMemberState {
+	if in == nil {
+		return nil
+	}
+	out := make([]entity.RallyMemberState, len(in))
+	for i, v := range in {
+		out[i] = RallyMemberDocToState(v)
+	}
+	return out
+}
+
+func RallyStateToDoc(s entity.RallyState) RallyDoc {
+	state := entity.HydrateRallyEntity(s).Save()
+	return RallyDoc{
+		Id:         state.Id,
+		AllianceId: state.AllianceId,
+		LeaderId:   state.LeaderId,
+		TargetX:    state.TargetX,
+		TargetY:    state.TargetY,
+		RallyX:     state.RallyX,
+		RallyY:     state.RallyY,
+		Deadline:   state.Deadline,
+		Joined:     toDocSlice_joined(state.Joined),
+	}
+}
+
+func RallyDocToState(d RallyDoc) entity.RallyState {
+	state := entity.RallyState{
+		Id:         d.Id,
+		AllianceId: d.AllianceId,
+		LeaderId:   d.LeaderId,
+		TargetX:    d.TargetX,
+		TargetY:    d.TargetY,
+		RallyX:     d.RallyX,
+		RallyY:     d.RallyY,
+		Deadline:   d.Deadline,
+		Joined:     toStateSlice_joined(d.Joined),
+	}
+	return entity.HydrateRallyEntity(state).Save()
+}
@@ -0,0 +1,27 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/world/entity"
+)
+
+type RallyMemberDoc struct {
+	PlayerId PlayerID `bson:"player_id"`
+	ArmyId   ArmyID   `bson:"army_id"`
+}
+
+func RallyMemberStateToDoc(s entity.RallyMemberState) RallyMemberDoc {
+	state := entity.HydrateRallyMemberEntity(s).Save()
+	return RallyMemberDoc{
+		PlayerId: state.PlayerId,
+		ArmyId:   state.ArmyId,
+	}
+}
+
+func RallyMemberDocToState(d RallyMemberDoc) entity.RallyMemberState {
+	state := entity.RallyMemberState{
+		PlayerId: d.PlayerId,
+		ArmyId:   d.ArmyId,
+	}
+	return entity.HydrateRallyMemberEntity(state).Save()
+}
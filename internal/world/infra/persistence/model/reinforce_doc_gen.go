@@ -0,0 +1,27 @@
+// Code generated by gen_entities; DO NOT EDIT.
+package model
+
+import (
+	entity "ThreeKingdoms/internal/world/entity"
+)
+
+type ReinforceDoc struct {
+	PlayerId PlayerID `bson:"player_id"`
+	ArmyId   ArmyID   `bson:"army_id"`
+}
+
+func ReinforceStateToDoc(s entity.ReinforceState) ReinforceDoc {
+	state := entity.HydrateReinforceEntity(s).Save()
+	return ReinforceDoc{
+		PlayerId: state.PlayerId,
+		ArmyId:   state.ArmyId,
+	}
+}
+
+func ReinforceDocToState(d ReinforceDoc) entity.ReinforceState {
+	state := entity.ReinforceState{
+		PlayerId: d.PlayerId,
+		ArmyId:   d.ArmyId,
+	}
+	return entity.HydrateReinforceEntity(state).Save()
+}
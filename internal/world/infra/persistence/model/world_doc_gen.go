@@ -6,12 +6,15 @@ import (
 )
 
 type WorldDoc struct {
-	WorldId      WorldID                          `bson:"world_id"`
-	CityByPlayer map[PlayerID]map[CityID]CityDoc  `bson:"city_by_player"`
-	WorldMap     map[int]CellDoc                  `bson:"world_map"`
-	Armies       map[PlayerID]map[ArmyID]ArmyDoc  `bson:"armies"`
-	Marches      map[PlayerID]map[ArmyID]MarchDoc `bson:"marches"`
-	CellToMarch  map[int][]MarchDoc               `bson:"cell_to_march"`
+	WorldId      WorldID                                    `bson:"world_id"`
+	CityByPlayer map[PlayerID]map[CityID]CityDoc            `bson:"city_by_player"`
+	WorldMap     map[int]CellDoc                            `bson:"world_map"`
+	Armies       map[PlayerID]map[ArmyID]ArmyDoc            `bson:"armies"`
+	Marches      map[PlayerID]map[ArmyID]MarchDoc           `bson:"marches"`
+	CellToMarch  map[int][]MarchDoc                         `bson:"cell_to_march"`
+	Market       MarketDoc                                  `bson:"market"`
+	Rallies      map[int]RallyDoc                           `bson:"rallies"`
+	Diplomacies  map[AllianceID]map[AllianceID]DiplomacyDoc `bson:"diplomacies"`
 }
 
 func toDoc_cityByPlayer(in map[PlayerID]map[CityID]entity.CityState) map[PlayerID]map[CityID]CityDoc {
@@ -212,6 +215,72 @@ func toState_cellToMarch(in map[int][]MarchDoc) map[int][]entity.MarchState {
 	return out
 }
 
+func toDocMap_rallies(in map[int]entity.RallyState) map[int]RallyDoc {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]RallyDoc, len(in))
+	for k, v := range in {
+		out[k] = RallyStateToDoc(v)
+	}
+	return out
+}
+
+func toStateMap_rallies(in map[int]RallyDoc) map[int]entity.RallyState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[int]entity.RallyState, len(in))
+	for k, v := range in {
+		out[k] = RallyDocToState(v)
+	}
+	return out
+}
+
+func toDoc_diplomacies(in map[AllianceID]map[AllianceID]entity.DiplomacyState) map[AllianceID]map[AllianceID]DiplomacyDoc {
+	var out map[AllianceID]map[AllianceID]DiplomacyDoc
+	if in == nil {
+		out = nil
+	} else {
+		out0 := make(map[AllianceID]map[AllianceID]DiplomacyDoc, len(in))
+		for k1, v2 := range in {
+			if v2 == nil {
+				out0[k1] = nil
+			} else {
+				out3 := make(map[AllianceID]DiplomacyDoc, len(v2))
+				for k4, v5 := range v2 {
+					out3[k4] = DiplomacyStateToDoc(v5)
+				}
+				out0[k1] = out3
+			}
+		}
+		out = out0
+	}
+	return out
+}
+
+func toState_diplomacies(in map[AllianceID]map[AllianceID]DiplomacyDoc) map[AllianceID]map[AllianceID]entity.DiplomacyState {
+	var out map[AllianceID]map[AllianceID]entity.DiplomacyState
+	if in == nil {
+		out = nil
+	} else {
+		out0 := make(map[AllianceID]map[AllianceID]entity.DiplomacyState, len(in))
+		for k1, v2 := range in {
+			if v2 == nil {
+				out0[k1] = nil
+			} else {
+				out3 := make(map[AllianceID]entity.DiplomacyState, len(v2))
+				for k4, v5 := range v2 {
+					out3[k4] = DiplomacyDocToState(v5)
+				}
+				out0[k1] = out3
+			}
+		}
+		out = out0
+	}
+	return out
+}
+
 func WorldStateToDoc(s entity.WorldState) WorldDoc {
 	state := entity.HydrateWorldEntity(s).Save()
 	return WorldDoc{
@@ -221,6 +290,9 @@ func WorldStateToDoc(s entity.WorldState) WorldDoc {
 		Armies:       toDoc_armies(state.Armies),
 		Marches:      toDoc_marches(state.Marches),
 		CellToMarch:  toDoc_cellToMarch(state.CellToMarch),
+		Market:       MarketStateToDoc(state.Market),
+		Rallies:      toDocMap_rallies(state.Rallies),
+		Diplomacies:  toDoc_diplomacies(state.Diplomacies),
 	}
 }
 
@@ -232,6 +304,9 @@ func WorldDocToState(d WorldDoc) entity.WorldState {
 		Armies:       toState_armies(d.Armies),
 		Marches:      toState_marches(d.Marches),
 		CellToMarch:  toState_cellToMarch(d.CellToMarch),
+		Market:       MarketDocToState(d.Market),
+		Rallies:      toStateMap_rallies(d.Rallies),
+		Diplomacies:  toState_diplomacies(d.Diplomacies),
 	}
 	return entity.HydrateWorldEntity(state).Save()
 }